@@ -95,11 +95,11 @@ func New(code, message string, origErr error) Error {
 	if e, ok := origErr.(Error); ok && e != nil {
 		return e
 	}
-	return newBaseError(code, message, origErr)
+	return NewBaseError(code, message, origErr)
 }
 
 // NewRequestFailure returns a new request error wrapper for the given Error
 // provided.
 func NewRequestFailure(err Error, statusCode int, reqID string) RequestFailure {
-	return newRequestError(err, statusCode, reqID)
+	return NewRequestError(err, statusCode, reqID)
 }
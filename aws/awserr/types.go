@@ -17,12 +17,27 @@ func SprintError(code, message, extra string, origErr error) string {
 	return msg
 }
 
-// A baseError wraps the code and message which defines an error. It also
+// An ErrorCode is an error code used as the target of errors.Is, matching
+// the errors of that code.
+//
+// Example:
+//
+//	if errors.Is(err, s3.ErrNoSuchKey) {
+//	    // the object does not exist
+//	}
+type ErrorCode string
+
+// Error returns the error code.
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+// A BaseError wraps the code and message which defines an error. It also
 // can be used to wrap an original error object.
 //
 // Should be used as the root for errors satisfying the awserr.Error. Also
 // for any error which does not fit into a specific error wrapper type.
-type baseError struct {
+type BaseError struct {
 	// Classification of error
 	code string
 
@@ -34,7 +49,7 @@ type baseError struct {
 	origErr error
 }
 
-// newBaseError returns an error object for the code, message, and err.
+// NewBaseError returns an error object for the code, message, and err.
 //
 // code is a short no whitespace phrase depicting the classification of
 // the error that is being created.
@@ -42,8 +57,8 @@ type baseError struct {
 // message is the free flow string containing detailed information about the error.
 //
 // origErr is the error object which will be nested under the new error to be returned.
-func newBaseError(code, message string, origErr error) *baseError {
-	return &baseError{
+func NewBaseError(code, message string, origErr error) *BaseError {
+	return &BaseError{
 		code:    code,
 		message: message,
 		origErr: origErr,
@@ -55,64 +70,105 @@ func newBaseError(code, message string, origErr error) *baseError {
 // See ErrorWithExtra for formatting.
 //
 // Satisfies the error interface.
-func (b baseError) Error() string {
-	return SprintError(b.code, b.message, "", b.origErr)
+func (b *BaseError) Error() string {
+	return b.ErrorWithExtra("")
 }
 
 // String returns the string representation of the error.
 // Alias for Error to satisfy the stringer interface.
-func (b baseError) String() string {
+func (b *BaseError) String() string {
 	return b.Error()
 }
 
 // Code returns the short phrase depicting the classification of the error.
-func (b baseError) Code() string {
+func (b *BaseError) Code() string {
 	return b.code
 }
 
 // Message returns the error details message.
-func (b baseError) Message() string {
+func (b *BaseError) Message() string {
 	return b.message
 }
 
 // OrigErr returns the original error if one was set. Nil is returned if no error
 // was set.
-func (b baseError) OrigErr() error {
+func (b *BaseError) OrigErr() error {
+	return b.origErr
+}
+
+// Unwrap returns the original error, so that errors.Is and errors.As look
+// into it.
+func (b *BaseError) Unwrap() error {
 	return b.origErr
 }
 
+// Is reports whether target is the ErrorCode of the error.
+func (b *BaseError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && string(code) == b.code
+}
+
+// ErrorWithExtra is a helper method to add an extra string to the stratified
+// error message. The extra message will be added on the next line below the
+// error message like the following:
+//
+//	<error code>: <error message>
+//	    <extra message>
+//
+// If there is a original error the error will be included on a new line.
+//
+//	<error code>: <error message>
+//	    <extra message>
+//	caused by: <original error>
+func (b *BaseError) ErrorWithExtra(extra string) string {
+	return SprintError(b.code, b.message, extra, b.origErr)
+}
+
 // So that the Error interface type can be included as an anonymous field
-// in the requestError struct and not conflict with the error.Error() method.
+// in the RequestError struct and not conflict with the error.Error() method.
 type awsError Error
 
-// A requestError wraps a request or service error.
+// A RequestError wraps a request or service error with the status code of
+// the response, and the identifiers the service returned for the request.
 //
-// Composed of baseError for code, message, and original error.
-type requestError struct {
+// Composed of an Error for code, message, and original error.
+type RequestError struct {
 	awsError
 	statusCode int
 	requestID  string
+	hostID     string
+	resource   string
 }
 
-// newRequestError returns a wrapped error with additional information for request
+// NewRequestError returns a wrapped error with additional information for request
 // status code, and service requestID.
 //
 // Should be used to wrap all request which involve service requests. Even if
 // the request failed without a service response, but had an HTTP status code
 // that may be meaningful.
 //
-// Also wraps original errors via the baseError.
-func newRequestError(err Error, statusCode int, requestID string) *requestError {
-	return &requestError{
+// Also wraps original errors via the Error.
+func NewRequestError(err Error, statusCode int, requestID string) *RequestError {
+	return &RequestError{
 		awsError:   err,
 		statusCode: statusCode,
 		requestID:  requestID,
 	}
 }
 
+// NewServiceError returns a RequestError for an error response of the
+// service, which also identifies the host that served the request and the
+// resource it was made on.
+func NewServiceError(err Error, statusCode int, requestID, hostID, resource string) *RequestError {
+	r := NewRequestError(err, statusCode, requestID)
+	r.hostID = hostID
+	r.resource = resource
+	return r
+}
+
 // Error returns the string representation of the error.
 // Satisfies the error interface.
-func (r requestError) Error() string {
+func (r *RequestError) Error() string {
 	extra := fmt.Sprintf("status code: %d, request id: [%s]",
 		r.statusCode, r.requestID)
 	return SprintError(r.Code(), r.Message(), extra, r.OrigErr())
@@ -120,16 +176,39 @@ func (r requestError) Error() string {
 
 // String returns the string representation of the error.
 // Alias for Error to satisfy the stringer interface.
-func (r requestError) String() string {
+func (r *RequestError) String() string {
 	return r.Error()
 }
 
 // StatusCode returns the wrapped status code for the error
-func (r requestError) StatusCode() int {
+func (r *RequestError) StatusCode() int {
 	return r.statusCode
 }
 
 // RequestID returns the wrapped requestID
-func (r requestError) RequestID() string {
+func (r *RequestError) RequestID() string {
 	return r.requestID
 }
+
+// HostID returns the identifier of the host which served the request, empty
+// if the service did not return one.
+func (r *RequestError) HostID() string {
+	return r.hostID
+}
+
+// Resource returns the bucket or object the failed request was made on, empty
+// if the service did not return one.
+func (r *RequestError) Resource() string {
+	return r.resource
+}
+
+// Unwrap returns the wrapped Error.
+func (r *RequestError) Unwrap() error {
+	return r.awsError
+}
+
+// Is reports whether target is the ErrorCode of the error.
+func (r *RequestError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && string(code) == r.Code()
+}
@@ -0,0 +1,45 @@
+package awserr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorIs(t *testing.T) {
+	const noSuchKey = ErrorCode("NoSuchKey")
+
+	err := NewServiceError(NewBaseError("NoSuchKey", "The specified key does not exist.", nil),
+		404, "req-1", "host-1", "/bucket/key")
+	assert.True(t, errors.Is(err, noSuchKey))
+	assert.True(t, errors.Is(fmt.Errorf("get object: %w", err), noSuchKey))
+	assert.False(t, errors.Is(err, ErrorCode("NoSuchBucket")))
+
+	var reqErr *RequestError
+	if assert.True(t, errors.As(fmt.Errorf("get object: %w", err), &reqErr)) {
+		assert.Equal(t, 404, reqErr.StatusCode())
+		assert.Equal(t, "req-1", reqErr.RequestID())
+		assert.Equal(t, "host-1", reqErr.HostID())
+		assert.Equal(t, "/bucket/key", reqErr.Resource())
+	}
+	assert.Equal(t, "NoSuchKey: The specified key does not exist.\n\tstatus code: 404, request id: [req-1]", err.Error())
+
+	var failure RequestFailure = err
+	assert.Equal(t, "NoSuchKey", failure.Code())
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	err := NewRequestError(NewBaseError("RequestError", "send request failed", io.ErrUnexpectedEOF), 0, "")
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	assert.True(t, errors.Is(err, ErrorCode("RequestError")))
+
+	var base *BaseError
+	assert.True(t, errors.As(err, &base))
+	assert.Equal(t, io.ErrUnexpectedEOF, base.OrigErr())
+
+	// New keeps returning an Error it is given unwrapped.
+	assert.Equal(t, Error(base), New("Other", "", base))
+}
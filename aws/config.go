@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
 	"io"
@@ -10,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/aws/telemetry"
 )
 
 // DefaultChainCredentials is a Credentials which will find the first available
@@ -17,12 +17,34 @@ import (
 //
 // This should be used in the default case. Once the type of credentials are
 // known switching to the specific Credentials will be more efficient.
-var DefaultChainCredentials = credentials.NewChainCredentials(
-	[]credentials.Provider{
-		&credentials.EnvProvider{},
-		&credentials.SharedCredentialsProvider{Filename: "", Profile: ""},
-		&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
-	})
+//
+// The providers are tried in order: environment variables, shared
+// credentials file, container credentials, the Ksyun instance metadata
+// service of KEC instances and KCE nodes, probed once with a short timeout,
+// then EC2 role credentials. The temporary credentials of the last three are
+// refreshed in the background before they expire, so that requests do not
+// wait for them to be retrieved.
+var DefaultChainCredentials = newDefaultChainCredentials()
+
+// newDefaultChainCredentials returns the Credentials of
+// DefaultChainCredentials.
+func newDefaultChainCredentials() *credentials.Credentials {
+	return credentials.NewRefreshingCredentials(
+		&credentials.ChainProvider{Providers: []credentials.Provider{
+			&credentials.EnvProvider{},
+			&credentials.SharedCredentialsProvider{Filename: "", Profile: ""},
+			&credentials.ContainerProvider{ExpiryWindow: 5 * time.Minute},
+			// Probed once with a short timeout, so that looking up the
+			// credentials off Ksyun does not wait for the retries of the
+			// metadata service.
+			&credentials.KsyunMetadataProvider{
+				ExpiryWindow: 5 * time.Minute,
+				Client:       &http.Client{Timeout: 500 * time.Millisecond},
+				MaxRetries:   -1,
+			},
+			&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
+		}}, credentials.RefreshOptions{})
+}
 
 // DefaultMaxRetries is the default number of retries for a service.
 const DefaultMaxRetries = 3
@@ -35,13 +57,14 @@ var DefaultConfig = &Config{
 	Region:                         "",
 	DisableSSL:                     false,
 	ManualSend:                     false,
-	HTTPClient:                     http.DefaultClient,
+	HTTPClient:                     nil,
 	LogHTTPBody:                    false,
 	LogLevel:                       Off,
 	Logger:                         os.Stdout,
 	MaxRetries:                     DefaultMaxRetries,
 	RetryRule:                      retry.DefaultExponentialRetryRule,
 	ShouldRetry:                    retry.ShouldRetry,
+	RetryMode:                      retry.ModeLegacy,
 	DisableParamValidation:         false,
 	DisableComputeChecksums:        false,
 	S3ForcePathStyle:               false,
@@ -50,31 +73,70 @@ var DefaultConfig = &Config{
 	CrcCheckEnabled:                false,
 	DisableRestProtocolURICleaning: true,
 	DisableDnsCache:                false,
+	DisableLogRedaction:            false,
+	AttemptTimeout:                 0,
+	OperationTimeout:               0,
 }
 
 // A Config provides service configuration
 type Config struct {
 	Credentials                    *credentials.Credentials
 	Endpoint                       string
+	Endpoints                      []string              // 按优先级排列的多个Endpoint，如内网Endpoint及外网Endpoint，设置后Endpoint不生效。连接失败或返回5xx时切换到下一个可用的Endpoint重新发送，未开启重试时也会切换，每个Endpoint最多切换一次
+	EndpointHealth                 *EndpointHealthConfig // 多Endpoint的健康检查配置，为空时使用默认值
 	Ks3BillEndpoint                string
 	Region                         string
+	EndpointResolver               EndpointResolver // 自定义Endpoint解析器，未设置Endpoint时生效，可按bucket返回不同的Endpoint，为空时使用内置的KS3 Endpoint表
+	UseInternalEndpoint            bool             // 未设置Endpoint时使用内网Endpoint，仅可在对应地域的VPC内访问，默认为false
+	UseAccelerateEndpoint          bool             // 未设置Endpoint时使用传输加速Endpoint，默认为false
 	DisableSSL                     bool
 	ManualSend                     bool
 	HTTPClient                     *http.Client
 	LogHTTPBody                    bool
 	LogLevel                       uint
 	Logger                         io.Writer
-	MaxRetries                     int              // 重试次数
-	RetryRule                      retry.RetryRule  // 重试规则
-	ShouldRetry                    func(error) bool // 是否需要重试
+	LeveledLogger                  LeveledLogger            // 结构化日志接口，设置后日志输出到该接口而非Logger
+	DisableLogRedaction            bool                     // 禁用日志脱敏，默认为false，即日志中的签名、SSE-C密钥等敏感信息会被隐藏
+	MaxRetries                     int                      // 重试次数
+	RetryRule                      retry.RetryRule          // 重试规则
+	ShouldRetry                    func(error) bool         // 是否需要重试
+	RetryMode                      retry.Mode               // 重试模式，默认为retry.ModeLegacy
+	RetryQuota                     *retry.RetryQuota        // 重试配额，standard及adaptive模式生效，为空时每个客户端单独创建，多个客户端可共享同一配额
+	RateLimiter                    *retry.ClientRateLimiter // 客户端发送速率限制器，adaptive模式生效，为空时每个客户端单独创建
 	DisableParamValidation         bool
 	DisableComputeChecksums        bool
 	S3ForcePathStyle               bool
 	DomainMode                     bool
 	SignerVersion                  string
-	CrcCheckEnabled                bool // 允许crc64校验，默认为false
-	DisableRestProtocolURICleaning bool // 禁用path clean，默认为true
-	DisableDnsCache                bool // 禁用DNS缓存，默认为false
+	CrcCheckEnabled                bool             // 允许crc64校验，默认为false
+	StreamingSignThreshold         int64            // V4签名时，长度不小于该值的请求体在发送过程中按块签名，只读取一次，默认为0，即发送前先读取请求体计算SHA256（UploadFile在读取分块时一并计算）
+	DisableRestProtocolURICleaning bool             // 禁用path clean，默认为true
+	DisableDnsCache                bool             // 禁用DNS缓存，默认为false
+	DnsCache                       *DnsCache        // DNS缓存，为空时每个客户端单独创建，可通过aws.NewDnsCache设置TTL、静态解析等，多个客户端可共享同一缓存
+	Transport                      *TransportConfig // HTTP传输层配置，未设置HTTPClient时生效，每个客户端单独创建http.Transport
+
+	// Tracer receives a span for each operation, with a child span for each
+	// of its attempts. Transfers of the uploader, downloader and copier are
+	// reported as spans too, parents of the spans of their parts.
+	Tracer telemetry.Tracer
+
+	// Meter records the latency, retries and bytes transferred of the
+	// operations. See the telemetry package for the metrics recorded.
+	Meter telemetry.Meter
+
+	// AttemptTimeout bounds a single HTTP attempt, including reading the
+	// response body when it is unmarshaled. An attempt that runs out of time
+	// fails with the AttemptTimeout error code and is retried. Once the
+	// operation succeeded it no longer applies: a body returned to the
+	// caller, such as the one of GetObject, is read without this limit, but
+	// is still bounded by OperationTimeout and the context of the request.
+	// 0 means no limit.
+	AttemptTimeout time.Duration
+
+	// OperationTimeout bounds the whole operation, all retries and backoff
+	// delays included. An operation that runs out of time fails with the
+	// OperationTimeout error code and is not retried. 0 means no limit.
+	OperationTimeout time.Duration
 }
 
 // Copy will return a shallow copy of the Config object.
@@ -82,25 +144,42 @@ func (c Config) Copy() Config {
 	dst := Config{}
 	dst.Credentials = c.Credentials
 	dst.Endpoint = c.Endpoint
+	dst.Endpoints = c.Endpoints
+	dst.EndpointHealth = c.EndpointHealth
 	dst.Ks3BillEndpoint = c.Ks3BillEndpoint
 	dst.Region = c.Region
+	dst.EndpointResolver = c.EndpointResolver
+	dst.UseInternalEndpoint = c.UseInternalEndpoint
+	dst.UseAccelerateEndpoint = c.UseAccelerateEndpoint
 	dst.DisableSSL = c.DisableSSL
 	dst.ManualSend = c.ManualSend
 	dst.HTTPClient = c.HTTPClient
 	dst.LogHTTPBody = c.LogHTTPBody
 	dst.LogLevel = c.LogLevel
 	dst.Logger = c.Logger
+	dst.LeveledLogger = c.LeveledLogger
+	dst.DisableLogRedaction = c.DisableLogRedaction
 	dst.MaxRetries = c.MaxRetries
 	dst.RetryRule = c.RetryRule
 	dst.ShouldRetry = c.ShouldRetry
+	dst.RetryMode = c.RetryMode
+	dst.RetryQuota = c.RetryQuota
+	dst.RateLimiter = c.RateLimiter
 	dst.DisableParamValidation = c.DisableParamValidation
 	dst.DisableComputeChecksums = c.DisableComputeChecksums
 	dst.S3ForcePathStyle = c.S3ForcePathStyle
 	dst.DomainMode = c.DomainMode
 	dst.SignerVersion = c.SignerVersion
 	dst.CrcCheckEnabled = c.CrcCheckEnabled
+	dst.StreamingSignThreshold = c.StreamingSignThreshold
 	dst.DisableRestProtocolURICleaning = c.DisableRestProtocolURICleaning
 	dst.DisableDnsCache = c.DisableDnsCache
+	dst.DnsCache = c.DnsCache
+	dst.Transport = c.Transport
+	dst.AttemptTimeout = c.AttemptTimeout
+	dst.OperationTimeout = c.OperationTimeout
+	dst.Tracer = c.Tracer
+	dst.Meter = c.Meter
 	return dst
 }
 
@@ -128,6 +207,18 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Endpoint = c.Endpoint
 	}
 
+	if len(newcfg.Endpoints) > 0 {
+		cfg.Endpoints = newcfg.Endpoints
+	} else {
+		cfg.Endpoints = c.Endpoints
+	}
+
+	if newcfg.EndpointHealth != nil {
+		cfg.EndpointHealth = newcfg.EndpointHealth
+	} else {
+		cfg.EndpointHealth = c.EndpointHealth
+	}
+
 	if newcfg.Ks3BillEndpoint != "" {
 		cfg.Ks3BillEndpoint = newcfg.Ks3BillEndpoint
 	} else {
@@ -140,6 +231,24 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Region = c.Region
 	}
 
+	if newcfg.EndpointResolver != nil {
+		cfg.EndpointResolver = newcfg.EndpointResolver
+	} else {
+		cfg.EndpointResolver = c.EndpointResolver
+	}
+
+	if newcfg.UseInternalEndpoint {
+		cfg.UseInternalEndpoint = newcfg.UseInternalEndpoint
+	} else {
+		cfg.UseInternalEndpoint = c.UseInternalEndpoint
+	}
+
+	if newcfg.UseAccelerateEndpoint {
+		cfg.UseAccelerateEndpoint = newcfg.UseAccelerateEndpoint
+	} else {
+		cfg.UseAccelerateEndpoint = c.UseAccelerateEndpoint
+	}
+
 	if newcfg.DisableSSL {
 		cfg.DisableSSL = newcfg.DisableSSL
 	} else {
@@ -158,15 +267,27 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.DisableDnsCache = c.DisableDnsCache
 	}
 
+	if newcfg.DnsCache != nil {
+		cfg.DnsCache = newcfg.DnsCache
+	} else {
+		cfg.DnsCache = c.DnsCache
+	}
+
+	if newcfg.Transport != nil {
+		cfg.Transport = newcfg.Transport
+	} else {
+		cfg.Transport = c.Transport
+	}
+
+	// Without an HTTPClient, the service builds its own from Transport.
 	if newcfg.HTTPClient != nil {
 		cfg.HTTPClient = newcfg.HTTPClient
 	} else {
 		cfg.HTTPClient = c.HTTPClient
-		if !cfg.DisableDnsCache {
-			cfg.HTTPClient.Transport = DnsCacheTransport
-		}
 	}
-	defaultHTTPRedirect(cfg.HTTPClient)
+	if cfg.HTTPClient != nil {
+		defaultHTTPRedirect(cfg.HTTPClient)
+	}
 
 	if newcfg.LogHTTPBody {
 		cfg.LogHTTPBody = newcfg.LogHTTPBody
@@ -186,6 +307,18 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Logger = c.Logger
 	}
 
+	if newcfg.LeveledLogger != nil {
+		cfg.LeveledLogger = newcfg.LeveledLogger
+	} else {
+		cfg.LeveledLogger = c.LeveledLogger
+	}
+
+	if newcfg.DisableLogRedaction {
+		cfg.DisableLogRedaction = newcfg.DisableLogRedaction
+	} else {
+		cfg.DisableLogRedaction = c.DisableLogRedaction
+	}
+
 	if newcfg.MaxRetries != 0 {
 		cfg.MaxRetries = newcfg.MaxRetries
 	} else {
@@ -204,6 +337,24 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.ShouldRetry = c.ShouldRetry
 	}
 
+	if newcfg.RetryMode != "" {
+		cfg.RetryMode = newcfg.RetryMode
+	} else {
+		cfg.RetryMode = c.RetryMode
+	}
+
+	if newcfg.RetryQuota != nil {
+		cfg.RetryQuota = newcfg.RetryQuota
+	} else {
+		cfg.RetryQuota = c.RetryQuota
+	}
+
+	if newcfg.RateLimiter != nil {
+		cfg.RateLimiter = newcfg.RateLimiter
+	} else {
+		cfg.RateLimiter = c.RateLimiter
+	}
+
 	if newcfg.DisableParamValidation {
 		cfg.DisableParamValidation = newcfg.DisableParamValidation
 	} else {
@@ -237,11 +388,36 @@ func (c Config) Merge(newcfg *Config) *Config {
 	} else {
 		cfg.CrcCheckEnabled = c.CrcCheckEnabled
 	}
+	if newcfg.StreamingSignThreshold != 0 {
+		cfg.StreamingSignThreshold = newcfg.StreamingSignThreshold
+	} else {
+		cfg.StreamingSignThreshold = c.StreamingSignThreshold
+	}
 	if newcfg.DisableRestProtocolURICleaning {
 		cfg.DisableRestProtocolURICleaning = newcfg.DisableRestProtocolURICleaning
 	} else {
 		cfg.DisableRestProtocolURICleaning = c.DisableRestProtocolURICleaning
 	}
+	if newcfg.AttemptTimeout != 0 {
+		cfg.AttemptTimeout = newcfg.AttemptTimeout
+	} else {
+		cfg.AttemptTimeout = c.AttemptTimeout
+	}
+	if newcfg.OperationTimeout != 0 {
+		cfg.OperationTimeout = newcfg.OperationTimeout
+	} else {
+		cfg.OperationTimeout = c.OperationTimeout
+	}
+	if newcfg.Tracer != nil {
+		cfg.Tracer = newcfg.Tracer
+	} else {
+		cfg.Tracer = c.Tracer
+	}
+	if newcfg.Meter != nil {
+		cfg.Meter = newcfg.Meter
+	} else {
+		cfg.Meter = c.Meter
+	}
 
 	return &cfg
 }
@@ -262,16 +438,22 @@ func (c Config) writeLog(level uint, format string, a ...interface{}) {
 	if c.LogLevel < level {
 		return
 	}
+	c.logger().Log(level, fmt.Sprintf(format, a...))
+}
 
-	var logBuffer bytes.Buffer
-	logBuffer.WriteString(time.Now().Format("2006/01/02 15:04:05"))
-	logBuffer.WriteString(" ")
-	logBuffer.WriteString(LogTag[level-1])
-	logBuffer.WriteString(fmt.Sprintf(format, a...))
-	if logBuffer.Bytes()[logBuffer.Len()-1] != '\n' {
-		logBuffer.WriteString("\n")
+// logger returns the LeveledLogger entries are written to, falling back to
+// writing text lines to Logger.
+func (c Config) logger() LeveledLogger {
+	if c.LeveledLogger != nil {
+		return c.LeveledLogger
 	}
-	fmt.Fprintf(c.Logger, "%s", logBuffer.String())
+	return writerLogger{w: c.Logger}
+}
+
+// RedactLog returns if secrets must be redacted from the logged requests,
+// responses and URLs.
+func (c Config) RedactLog() bool {
+	return !c.DisableLogRedaction
 }
 
 func (c Config) LogError(format string, a ...interface{}) {
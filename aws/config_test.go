@@ -1,13 +1,17 @@
 package aws
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
 )
 
 var testCredentials = credentials.NewChainCredentials([]credentials.Provider{
@@ -90,3 +94,52 @@ func TestMerge(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaultChainCredentialsMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case credentials.DefaultMetadataTokenPath:
+			fmt.Fprint(w, "token")
+		case credentials.DefaultMetadataCredentialsPath:
+			fmt.Fprint(w, "ks3-uploader\n")
+		case credentials.DefaultMetadataCredentialsPath + "ks3-uploader":
+			fmt.Fprintf(w, `{"Code":"Success","AccessKeyId":"AKID","SecretAccessKey":"SECRET",`+
+				`"SecurityToken":"TOKEN","Expiration":"%s"}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// No credentials in the environment, the shared file or a container.
+	for _, name := range []string{"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY",
+		"AWS_SECRET_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", credentials.EnvContainerCredentialsFullURI} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(credentials.EnvMetadataEndpoint, server.URL)
+
+	v, err := newDefaultChainCredentials().Get()
+	assert.NoError(t, err)
+	assert.Equal(t, credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}, v)
+}
+
+func TestDefaultChainCredentialsMetadataProbedOnce(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	for _, name := range []string{"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY",
+		"AWS_SECRET_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", credentials.EnvContainerCredentialsFullURI} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(credentials.EnvMetadataEndpoint, server.URL)
+
+	_, err := newDefaultChainCredentials().Get()
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "the metadata service is not retried")
+}
@@ -2,11 +2,31 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
 	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 )
 
 type Context = context.Context
 
+const (
+	// ErrCodeRequestCanceled is the error code returned when the context set
+	// on the request is canceled or reaches its own deadline.
+	ErrCodeRequestCanceled = "RequestCanceled"
+
+	// ErrCodeAttemptTimeout is the error code returned when a single HTTP
+	// attempt runs longer than Config.AttemptTimeout.
+	ErrCodeAttemptTimeout = "AttemptTimeout"
+
+	// ErrCodeOperationTimeout is the error code returned when the operation,
+	// including all of its retries and backoff delays, runs longer than
+	// Config.OperationTimeout.
+	ErrCodeOperationTimeout = "OperationTimeout"
+)
+
 // SetContext adds a Context to the current request that can be used to cancel
 func (r *Request) SetContext(ctx Context) {
 	if ctx == nil {
@@ -16,7 +36,160 @@ func (r *Request) SetContext(ctx Context) {
 	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
 }
 
+// Context returns the context of the request. If the operation has been
+// started and Config.OperationTimeout is set, the returned context carries
+// the operation deadline. BackgroundContext is returned if no context was set.
+func (r *Request) Context() Context {
+	if r.operationContext != nil {
+		return r.operationContext
+	}
+	if r.context != nil {
+		return r.context
+	}
+	return BackgroundContext()
+}
+
 // BackgroundContext returns a context that will never be canceled
 func BackgroundContext() Context {
 	return context.Background()
 }
+
+// startOperation derives the operation context from the request's context,
+// applying Config.OperationTimeout. It is a no-op after the first call.
+func (r *Request) startOperation() {
+	if r.operationContext != nil {
+		return
+	}
+
+	ctx := r.context
+	if ctx == nil {
+		ctx = BackgroundContext()
+	}
+	if r.Config.OperationTimeout > 0 {
+		ctx, r.operationCancel = context.WithTimeout(ctx, r.Config.OperationTimeout)
+	}
+	r.operationContext = r.startOperationSpan(ctx)
+}
+
+// startAttempt binds the HTTP request to a context for the upcoming attempt,
+// applying Config.AttemptTimeout on top of the operation context.
+//
+// The attempt timeout is a timer rather than a context deadline, so that it
+// can be stopped once the attempt succeeded: the body of the response, such
+// as the one of GetObject, is then read by the caller without time limit.
+func (r *Request) startAttempt() {
+	r.cancelAttempt()
+
+	ctx := r.Context()
+	if r.attemptSpanContext != nil {
+		ctx = r.attemptSpanContext
+	}
+	if r.Config.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		timedOut := new(int32)
+		r.attemptCancel = cancel
+		r.attemptTimedOut = timedOut
+		r.attemptTimer = time.AfterFunc(r.Config.AttemptTimeout, func() {
+			atomic.StoreInt32(timedOut, 1)
+			cancel()
+		})
+	}
+	r.attemptContext = ctx
+	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+}
+
+// cancelAttempt releases the context of the previous attempt.
+func (r *Request) cancelAttempt() {
+	r.stopAttemptTimer()
+	if r.attemptCancel != nil {
+		r.attemptCancel()
+		r.attemptCancel = nil
+	}
+}
+
+// stopAttemptTimer stops the timeout of the current attempt.
+func (r *Request) stopAttemptTimer() {
+	if r.attemptTimer != nil {
+		r.attemptTimer.Stop()
+		r.attemptTimer = nil
+	}
+}
+
+// releaseAttemptOnClose makes closing the response body release the context
+// of the attempt, which is not canceled when the operation succeeds since the
+// body is read from it.
+func (r *Request) releaseAttemptOnClose() {
+	if r.attemptCancel == nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+	r.HTTPResponse.Body = &cancelOnClose{ReadCloser: r.HTTPResponse.Body, cancel: r.attemptCancel}
+}
+
+// cancelOnClose is a response body canceling a context once closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// finishOperation ends the operation span, and releases the contexts of an
+// operation that ended with an error. Successful operations keep their
+// contexts alive, since the caller may still be reading the response body;
+// closing the body releases the attempt context, and the deadline of
+// OperationTimeout the operation one.
+func (r *Request) finishOperation() {
+	r.endOperationSpan()
+	if r.Error == nil {
+		return
+	}
+	r.cancelAttempt()
+	if r.operationCancel != nil {
+		r.operationCancel()
+		r.operationCancel = nil
+	}
+}
+
+// contextError returns the error describing why the request was stopped by
+// one of its contexts, or nil if none of them is done. The caller's context
+// is checked first, then the operation budget, then the attempt budget, so
+// the error names the budget that actually ran out.
+func (r *Request) contextError() error {
+	if r.context != nil && r.context.Err() != nil {
+		return apierr.New(ErrCodeRequestCanceled, "request context canceled", r.context.Err())
+	}
+	if r.operationContext != nil && r.operationContext.Err() != nil {
+		return apierr.New(ErrCodeOperationTimeout,
+			fmt.Sprintf("operation exceeded OperationTimeout of %s", r.Config.OperationTimeout),
+			r.operationContext.Err())
+	}
+	if r.attemptTimedOut != nil && atomic.LoadInt32(r.attemptTimedOut) == 1 {
+		return apierr.New(ErrCodeAttemptTimeout,
+			fmt.Sprintf("attempt exceeded AttemptTimeout of %s", r.Config.AttemptTimeout),
+			context.DeadlineExceeded)
+	}
+	return nil
+}
+
+// sleepWithContext blocks for the given delay, returning early with the
+// context's error if the context is done first.
+func sleepWithContext(ctx Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
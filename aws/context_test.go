@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTimeoutTestService returns a service sending to server, which answers
+// the n-th request (starting at 1) using handler.
+func newTimeoutTestService(cfg *Config, handler func(n int32, w http.ResponseWriter, r *http.Request)) (*Service, *httptest.Server) {
+	var n int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(atomic.AddInt32(&n, 1), w, r)
+	}))
+
+	cfg.Endpoint = server.URL
+	cfg.Region = "test"
+	cfg.HTTPClient = &http.Client{}
+	cfg.ShouldRetry = retry.ShouldRetry
+	if cfg.RetryRule == nil {
+		cfg.RetryRule = retry.DefaultNoDelayRetryRule
+	}
+	s := NewService(cfg)
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	return s, server
+}
+
+func slowResponse(w http.ResponseWriter, r *http.Request, delay time.Duration) {
+	select {
+	case <-time.After(delay):
+		w.Write([]byte(`{}`))
+	case <-r.Context().Done():
+	}
+}
+
+func errorResponse(w http.ResponseWriter) {
+	w.WriteHeader(500)
+	w.Write([]byte(`{"__type":"InternalError","message":"An error occurred."}`))
+}
+
+func TestAttemptTimeoutRetried(t *testing.T) {
+	s, server := newTimeoutTestService(&Config{MaxRetries: 2, AttemptTimeout: 50 * time.Millisecond},
+		func(n int32, w http.ResponseWriter, r *http.Request) {
+			if n == 1 {
+				slowResponse(w, r, time.Second)
+				return
+			}
+			w.Write([]byte(`{}`))
+		})
+	defer server.Close()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, int(r.RetryCount))
+}
+
+func TestAttemptTimeoutExhausted(t *testing.T) {
+	s, server := newTimeoutTestService(&Config{MaxRetries: 1, AttemptTimeout: 50 * time.Millisecond},
+		func(n int32, w http.ResponseWriter, r *http.Request) {
+			slowResponse(w, r, time.Second)
+		})
+	defer server.Close()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeAttemptTimeout, err.(awserr.Error).Code())
+	assert.Equal(t, 1, int(r.RetryCount))
+}
+
+func TestAttemptTimeoutNotAppliedToBodyRead(t *testing.T) {
+	s, server := newTimeoutTestService(&Config{AttemptTimeout: 50 * time.Millisecond},
+		func(n int32, w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("first part,"))
+			w.(http.Flusher).Flush()
+			time.Sleep(150 * time.Millisecond)
+			w.Write([]byte(" second part"))
+		})
+	defer server.Close()
+
+	r := NewRequest(s, &Operation{Name: "GetObject"}, nil, nil)
+	assert.NoError(t, r.Send())
+	body, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "first part, second part", string(body))
+
+	assert.NoError(t, r.HTTPResponse.Body.Close())
+	assert.Error(t, r.attemptContext.Err(), "closing the body releases the attempt context")
+}
+
+func TestOperationTimeoutDuringBackoff(t *testing.T) {
+	s, server := newTimeoutTestService(&Config{
+		MaxRetries:       3,
+		RetryRule:        retry.NewFixedRetryRule(10 * time.Second),
+		OperationTimeout: 100 * time.Millisecond,
+	}, func(n int32, w http.ResponseWriter, r *http.Request) {
+		errorResponse(w)
+	})
+	defer server.Close()
+
+	start := time.Now()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeOperationTimeout, err.(awserr.Error).Code())
+	assert.True(t, time.Since(start) < 5*time.Second, "expect backoff to stop at the operation deadline")
+}
+
+func TestOperationTimeoutDuringAttempt(t *testing.T) {
+	s, server := newTimeoutTestService(&Config{
+		MaxRetries:       3,
+		AttemptTimeout:   time.Second,
+		OperationTimeout: 50 * time.Millisecond,
+	}, func(n int32, w http.ResponseWriter, r *http.Request) {
+		slowResponse(w, r, 5*time.Second)
+	})
+	defer server.Close()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeOperationTimeout, err.(awserr.Error).Code())
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestContextCanceledDuringBackoff(t *testing.T) {
+	s, server := newTimeoutTestService(&Config{
+		MaxRetries: 3,
+		RetryRule:  retry.NewFixedRetryRule(10 * time.Second),
+	}, func(n int32, w http.ResponseWriter, r *http.Request) {
+		errorResponse(w)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.True(t, time.Since(start) < 5*time.Second, "expect backoff to stop when the context is canceled")
+}
@@ -1,6 +1,8 @@
 package credentials
 
 import (
+	"time"
+
 	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 )
 
@@ -79,3 +81,13 @@ func (c *ChainProvider) IsExpired() bool {
 
 	return true
 }
+
+// ExpiresAt returns the expiry of the currently cached provider if it is an
+// Expirer, and the zero time otherwise.
+func (c *ChainProvider) ExpiresAt() time.Time {
+	if e, ok := c.curr.(Expirer); ok {
+		return e.ExpiresAt()
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,164 @@
+package credentials
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+)
+
+// Environment variables of the ContainerProvider, set by the container
+// runtime.
+const (
+	EnvContainerCredentialsFullURI     = "KS3_CONTAINER_CREDENTIALS_FULL_URI"
+	EnvContainerAuthorizationToken     = "KS3_CONTAINER_AUTHORIZATION_TOKEN"
+	EnvContainerAuthorizationTokenFile = "KS3_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+)
+
+// ErrContainerCredentialsURINotFound is returned by a ContainerProvider
+// without URI.
+var ErrContainerCredentialsURINotFound = apierr.New("ContainerCredentialsURINotFound",
+	EnvContainerCredentialsFullURI+" not found in environment", nil)
+
+// A ContainerProvider retrieves credentials from an endpoint of the container
+// runtime, given by the KS3_CONTAINER_CREDENTIALS_FULL_URI environment
+// variable. The request is authorized with the token of the
+// KS3_CONTAINER_AUTHORIZATION_TOKEN variable, or read from the file of the
+// KS3_CONTAINER_AUTHORIZATION_TOKEN_FILE variable, which is read again on
+// each retrieval so that it can be rotated.
+//
+// The endpoint answers with the same JSON document as the metadata service
+// of the KsyunMetadataProvider. As the token is sent to it, the URI must be
+// either https, or http to a loopback address.
+type ContainerProvider struct {
+	// URI of the credentials. EnvContainerCredentialsFullURI if empty.
+	FullURI string
+
+	// Value of the Authorization header. EnvContainerAuthorizationToken,
+	// then the content of the file of EnvContainerAuthorizationTokenFile if
+	// empty.
+	AuthorizationToken string
+
+	// HTTP client of the requests, a client with a Timeout of
+	// DefaultMetadataTimeout if nil.
+	Client *http.Client
+
+	// Number of retries of the requests failing with a network error or a
+	// 5xx or 429 status. DefaultMetadataMaxRetries if zero, none if negative.
+	MaxRetries int
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	expiresOn time.Time
+}
+
+// NewContainerCredentials returns a pointer to a new Credentials object
+// wrapping a ContainerProvider configured by the environment, refreshed in
+// the background before they expire.
+func NewContainerCredentials() *Credentials {
+	return NewRefreshingCredentials(&ContainerProvider{ExpiryWindow: 5 * time.Minute}, RefreshOptions{})
+}
+
+// Retrieve retrieves the credentials from the container endpoint.
+func (p *ContainerProvider) Retrieve() (Value, error) {
+	uri := p.FullURI
+	if uri == "" {
+		uri = os.Getenv(EnvContainerCredentialsFullURI)
+	}
+	if uri == "" {
+		return Value{}, ErrContainerCredentialsURINotFound
+	}
+	if err := checkContainerURI(uri); err != nil {
+		return Value{}, apierr.New("InvalidContainerCredentialsURI", fmt.Sprintf("invalid container credentials URI %s", uri), err)
+	}
+	token, err := p.authorizationToken()
+	if err != nil {
+		return Value{}, apierr.New("ContainerAuthorizationToken", "failed to read the container authorization token", err)
+	}
+
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", token)
+	}
+	client := metadataClient{client: p.Client, maxRetries: p.MaxRetries}
+	status, body, err := client.do("GET", uri, header)
+	if err == nil && status != http.StatusOK {
+		err = statusError(status, body)
+	}
+	if err != nil {
+		return Value{}, apierr.New("GetContainerCredentials", "failed to get container credentials", err)
+	}
+	creds, expiration, err := parseMetadataCredentials(body)
+	if err != nil {
+		return Value{}, apierr.New("DecodeContainerCredentials", "failed to decode container credentials", err)
+	}
+
+	p.expiresOn = expiration
+	if p.ExpiryWindow > 0 {
+		// Offset based on expiry window if set.
+		p.expiresOn = p.expiresOn.Add(-p.ExpiryWindow)
+	}
+	return creds, nil
+}
+
+// IsExpired returns if the credentials are expired.
+func (p *ContainerProvider) IsExpired() bool {
+	return p.expiresOn.Before(sdk.NowTime())
+}
+
+// ExpiresAt returns the time at which the credentials expire, offset by the
+// ExpiryWindow.
+func (p *ContainerProvider) ExpiresAt() time.Time {
+	return p.expiresOn
+}
+
+func (p *ContainerProvider) authorizationToken() (string, error) {
+	if p.AuthorizationToken != "" {
+		return p.AuthorizationToken, nil
+	}
+	if token := os.Getenv(EnvContainerAuthorizationToken); token != "" {
+		return token, nil
+	}
+	if filename := os.Getenv(EnvContainerAuthorizationTokenFile); filename != "" {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return "", nil
+}
+
+// checkContainerURI returns an error unless uri is https, or http to a
+// loopback address.
+func checkContainerURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		host := u.Hostname()
+		if host == "localhost" {
+			return nil
+		}
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			return nil
+		}
+		return fmt.Errorf("http is only allowed to a loopback address")
+	}
+	return fmt.Errorf("unsupported scheme %q", u.Scheme)
+}
@@ -0,0 +1,75 @@
+package credentials
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerProvider(t *testing.T) {
+	os.Clearenv()
+	defer os.Clearenv()
+
+	var authorization string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/credentials", r.URL.Path)
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprint(w, metadataCredentialsDocument)
+	}))
+	defer s.Close()
+
+	p := &ContainerProvider{}
+	_, err := p.Retrieve()
+	assert.Equal(t, ErrContainerCredentialsURINotFound, err)
+
+	os.Setenv(EnvContainerCredentialsFullURI, s.URL+"/v1/credentials")
+	os.Setenv(EnvContainerAuthorizationToken, "Bearer env-token")
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}, creds)
+	assert.Equal(t, "Bearer env-token", authorization)
+	assert.False(t, p.ExpiresAt().IsZero())
+
+	// The token file is read on each retrieval.
+	os.Unsetenv(EnvContainerAuthorizationToken)
+	filename := filepath.Join(t.TempDir(), "token")
+	os.Setenv(EnvContainerAuthorizationTokenFile, filename)
+	for _, token := range []string{"file-token1", "file-token2"} {
+		assert.NoError(t, ioutil.WriteFile(filename, []byte(token+"\n"), 0600))
+		_, err = p.Retrieve()
+		assert.NoError(t, err)
+		assert.Equal(t, token, authorization)
+	}
+
+	assert.NoError(t, os.Remove(filename))
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "ContainerAuthorizationToken", err.(awserr.Error).Code())
+	}
+}
+
+func TestContainerProviderURI(t *testing.T) {
+	for uri, valid := range map[string]bool{
+		"https://credentials.example.com/v1": true,
+		"http://127.0.0.1:8080/v1":           true,
+		"http://[::1]/v1":                    true,
+		"http://localhost/v1":                true,
+		"http://169.254.170.2/v1":            false,
+		"http://credentials.example.com/v1":  false,
+		"file:///etc/credentials":            false,
+	} {
+		assert.Equal(t, valid, checkContainerURI(uri) == nil, uri)
+	}
+
+	_, err := (&ContainerProvider{FullURI: "http://10.0.0.1/v1"}).Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "InvalidContainerCredentialsURI", err.(awserr.Error).Code())
+	}
+}
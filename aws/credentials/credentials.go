@@ -31,6 +31,14 @@
 //     credsValue, err := creds.Get()
 //     // New credentials will be retrieved instead of from cache.
 //
+// Example of credentials refreshed in the background before they expire, so
+// that concurrent requests do not wait on the provider. The provider is called
+// by one goroutine at a time, and failures are retried with a backoff.
+//
+//     creds := NewRefreshingCredentials(&EC2RoleProvider{}, RefreshOptions{
+//         OnRefreshError: func(err error) { log.Println(err) },
+//     })
+//
 //
 // Custom Provider
 //
@@ -50,7 +58,8 @@ package credentials
 
 import (
 	"sync"
-	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
 )
 
 // Create an empty Credential object that can be used as dummy placeholder
@@ -110,6 +119,9 @@ type Credentials struct {
 	m            sync.Mutex
 
 	provider Provider
+
+	// refresh is set for the Credentials of NewRefreshingCredentials.
+	refresh *refresher
 }
 
 // NewCredentials returns a pointer to a new Credentials with the provider set.
@@ -130,6 +142,10 @@ func NewCredentials(provider Provider) *Credentials {
 // If Credentials.Expire() was called the credentials Value will be force
 // expired, and the next call to Get() will cause them to be refreshed.
 func (c *Credentials) Get() (Value, error) {
+	if c.refresh != nil {
+		return c.getRefreshing()
+	}
+
 	c.m.Lock()
 	defer c.m.Unlock()
 
@@ -171,8 +187,8 @@ func (c *Credentials) IsExpired() bool {
 
 // isExpired helper method wrapping the definition of expired credentials.
 func (c *Credentials) isExpired() bool {
+	if c.refresh != nil {
+		return c.forceRefresh || c.refreshExpired(sdk.NowTime())
+	}
 	return c.forceRefresh || c.provider.IsExpired()
 }
-
-// Provide a stub-able time.Now for unit tests so expiry can be tested.
-var currentTime = time.Now
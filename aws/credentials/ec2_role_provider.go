@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
 )
 
 const metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam/security-credentials/"
@@ -115,7 +116,13 @@ func (m *EC2RoleProvider) Retrieve() (Value, error) {
 
 // IsExpired returns if the credentials are expired.
 func (m *EC2RoleProvider) IsExpired() bool {
-	return m.expiresOn.Before(currentTime())
+	return m.expiresOn.Before(sdk.NowTime())
+}
+
+// ExpiresAt returns the time at which the credentials expire, offset by the
+// ExpiryWindow.
+func (m *EC2RoleProvider) ExpiresAt() time.Time {
+	return m.expiresOn
 }
 
 // A ec2RoleCredRespBody provides the shape for deserializing credential
@@ -2,6 +2,7 @@ package credentials
 
 import (
 	"fmt"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
@@ -46,9 +47,9 @@ func TestEC2RoleProviderIsExpired(t *testing.T) {
 
 	p := &EC2RoleProvider{Client: http.DefaultClient, Endpoint: server.URL}
 	defer func() {
-		currentTime = time.Now
+		sdk.NowTime = time.Now
 	}()
-	currentTime = func() time.Time {
+	sdk.NowTime = func() time.Time {
 		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
 	}
 
@@ -59,7 +60,7 @@ func TestEC2RoleProviderIsExpired(t *testing.T) {
 
 	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
 
-	currentTime = func() time.Time {
+	sdk.NowTime = func() time.Time {
 		return time.Date(3014, 12, 15, 21, 26, 0, 0, time.UTC)
 	}
 
@@ -72,9 +73,9 @@ func TestEC2RoleProviderExpiryWindowIsExpired(t *testing.T) {
 
 	p := &EC2RoleProvider{Client: http.DefaultClient, Endpoint: server.URL, ExpiryWindow: time.Hour * 1}
 	defer func() {
-		currentTime = time.Now
+		sdk.NowTime = time.Now
 	}()
-	currentTime = func() time.Time {
+	sdk.NowTime = func() time.Time {
 		return time.Date(2014, 12, 15, 0, 51, 37, 0, time.UTC)
 	}
 
@@ -85,7 +86,7 @@ func TestEC2RoleProviderExpiryWindowIsExpired(t *testing.T) {
 
 	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
 
-	currentTime = func() time.Time {
+	sdk.NowTime = func() time.Time {
 		return time.Date(2014, 12, 16, 0, 55, 37, 0, time.UTC)
 	}
 
@@ -0,0 +1,155 @@
+package credentials
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+)
+
+// DefaultFilePollInterval is the PollInterval of a FileProvider when it has
+// none.
+const DefaultFilePollInterval = time.Second
+
+// A FileProvider retrieves credentials from a file which is replaced when
+// they are rotated, such as a Kubernetes secret mounted in a volume, and
+// reloads the file when it changes.
+//
+// The file is either a JSON document of the form written by the command of a
+// ProcessProvider, or an ini file in the format of the shared credentials
+// file, from which the section Profile is read. The credentials of a JSON
+// document with an Expiration also expire then.
+//
+// The file is checked for changes, by its modification time and size, at
+// most once per PollInterval.
+type FileProvider struct {
+	// Path to the credentials file.
+	Filename string
+
+	// Section of an ini credentials file. "default" if empty.
+	Profile string
+
+	// Minimum time between two checks of the file. DefaultFilePollInterval if
+	// zero, checked on each call to IsExpired if negative.
+	PollInterval time.Duration
+
+	retrieved bool
+	watch     fileWatch
+	expiresOn time.Time
+}
+
+// NewFileCredentials returns a pointer to a new Credentials object wrapping
+// a FileProvider of filename.
+func NewFileCredentials(filename string) *Credentials {
+	return NewCredentials(&FileProvider{Filename: filename})
+}
+
+// Retrieve reads the credentials file.
+func (p *FileProvider) Retrieve() (Value, error) {
+	p.retrieved = false
+
+	// The file is stamped before being read, so that a change while it is
+	// read is seen by the next check.
+	stamp, err := statFile(p.Filename)
+	if err != nil {
+		return Value{}, apierr.New("FileCredentialsLoad", "failed to load credentials file", err)
+	}
+	data, err := ioutil.ReadFile(p.Filename)
+	if err != nil {
+		return Value{}, apierr.New("FileCredentialsLoad", "failed to load credentials file", err)
+	}
+
+	var creds Value
+	var expiration time.Time
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		creds, expiration, err = parseCredentialsJSON(trimmed)
+		if err != nil {
+			return Value{}, apierr.New("FileCredentialsParse",
+				fmt.Sprintf("invalid credentials file %s", p.Filename), err)
+		}
+	} else {
+		profile := p.Profile
+		if profile == "" {
+			profile = "default"
+		}
+		if creds, err = loadProfile(p.Filename, profile); err != nil {
+			return Value{}, err
+		}
+	}
+
+	p.watch.reset(stamp)
+	p.expiresOn = expiration
+	p.retrieved = true
+	return creds, nil
+}
+
+// IsExpired returns if the credentials file changed since it was read, or the
+// credentials it contains expired.
+func (p *FileProvider) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+	if !p.expiresOn.IsZero() && p.expiresOn.Before(sdk.NowTime()) {
+		return true
+	}
+	return p.watch.changed(p.Filename, p.PollInterval)
+}
+
+// ExpiresAt returns the Expiration of a JSON credentials file, or the zero
+// time.
+func (p *FileProvider) ExpiresAt() time.Time {
+	return p.expiresOn
+}
+
+// A fileStamp identifies a version of a file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(filename string) (fileStamp, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// A fileWatch tells if a file changed since it was read.
+type fileWatch struct {
+	stamp    fileStamp
+	checked  time.Time
+	modified bool
+}
+
+// reset records the stamp of the file read.
+func (w *fileWatch) reset(stamp fileStamp) {
+	w.stamp = stamp
+	w.checked = sdk.NowTime()
+	w.modified = false
+}
+
+// changed returns if the file changed since it was read, checking it at most
+// once per interval, DefaultFilePollInterval if zero.
+func (w *fileWatch) changed(filename string, interval time.Duration) bool {
+	if w.modified {
+		return true
+	}
+	if interval == 0 {
+		interval = DefaultFilePollInterval
+	}
+	now := sdk.NowTime()
+	if now.Sub(w.checked) < interval {
+		return false
+	}
+	w.checked = now
+	stamp, err := statFile(filename)
+	// A file being replaced may be missing for a moment: the credentials
+	// read are kept until it is back.
+	w.modified = err == nil && (!stamp.modTime.Equal(w.stamp.modTime) || stamp.size != w.stamp.size)
+	return w.modified
+}
@@ -0,0 +1,108 @@
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeFile writes a file with a modification time distinct from the
+// previous version of the file.
+func writeFile(t *testing.T, filename, content string, modTime time.Time) {
+	assert.NoError(t, ioutil.WriteFile(filename, []byte(content), 0600))
+	assert.NoError(t, os.Chtimes(filename, modTime, modTime))
+}
+
+func TestFileProviderJSON(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials.json")
+	modTime := time.Now().Add(-time.Hour)
+	writeFile(t, filename, `{"AccessKeyId": "AKID1", "SecretAccessKey": "SECRET"}`, modTime)
+
+	c := NewCredentials(&FileProvider{Filename: filename, PollInterval: -1})
+	creds, err := c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, Value{AccessKeyID: "AKID1", SecretAccessKey: "SECRET"}, creds)
+	assert.False(t, c.IsExpired())
+
+	// The rotated keys are read on the next Get.
+	writeFile(t, filename, `{"AccessKeyId": "AKID2", "SecretAccessKey": "SECRET",
+		"Expiration": "2000-01-01T00:00:00Z"}`, modTime.Add(time.Minute))
+	assert.True(t, c.IsExpired())
+	creds, err = c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID2", creds.AccessKeyID)
+
+	// The credentials of the file expired.
+	assert.True(t, c.IsExpired())
+}
+
+func TestFileProviderINI(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	modTime := time.Now().Add(-time.Hour)
+	writeFile(t, filename, "[tenant]\naws_access_key_id = AKID1\naws_secret_access_key = SECRET\n", modTime)
+
+	p := &FileProvider{Filename: filename, Profile: "tenant", PollInterval: time.Hour}
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, Value{AccessKeyID: "AKID1", SecretAccessKey: "SECRET"}, creds)
+
+	// Not checked again before the PollInterval.
+	writeFile(t, filename, "[tenant]\naws_access_key_id = AKID2\naws_secret_access_key = SECRET\n", modTime.Add(time.Minute))
+	assert.False(t, p.IsExpired())
+
+	sdk.NowTime = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	defer func() { sdk.NowTime = time.Now }()
+	assert.True(t, p.IsExpired())
+	creds, err = p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID2", creds.AccessKeyID)
+}
+
+// A Kubernetes secret volume replaces the files by swapping the ..data
+// symbolic link to a new directory.
+func TestFileProviderSymlinkSwap(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	for i, key := range []string{"AKID1", "AKID2"} {
+		version := filepath.Join(dir, "..v"+key)
+		assert.NoError(t, os.Mkdir(version, 0700))
+		writeFile(t, filepath.Join(version, "credentials.json"),
+			`{"AccessKeyId": "`+key+`", "SecretAccessKey": "SECRET"}`, modTime.Add(time.Duration(i)*time.Minute))
+	}
+	assert.NoError(t, os.Symlink("..vAKID1", filepath.Join(dir, "..data")))
+	filename := filepath.Join(dir, "credentials.json")
+	assert.NoError(t, os.Symlink(filepath.Join("..data", "credentials.json"), filename))
+
+	p := &FileProvider{Filename: filename, PollInterval: -1}
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID1", creds.AccessKeyID)
+	assert.False(t, p.IsExpired())
+
+	assert.NoError(t, os.Symlink("..vAKID2", filepath.Join(dir, "..data_tmp")))
+	assert.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+	assert.True(t, p.IsExpired())
+	creds, err = p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID2", creds.AccessKeyID)
+}
+
+func TestFileProviderMissing(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials.json")
+	p := &FileProvider{Filename: filename, PollInterval: -1}
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.True(t, p.IsExpired())
+
+	// A file missing after it was read keeps the credentials.
+	writeFile(t, filename, `{"AccessKeyId": "AKID", "SecretAccessKey": "SECRET"}`, time.Now())
+	_, err = p.Retrieve()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Remove(filename))
+	assert.False(t, p.IsExpired())
+}
@@ -0,0 +1,343 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+)
+
+// Defaults of the KsyunMetadataProvider. The endpoint is also read from the
+// KS3_METADATA_ENDPOINT environment variable.
+const (
+	DefaultMetadataEndpoint        = "http://169.254.169.254"
+	DefaultMetadataCredentialsPath = "/latest/meta-data/iam/security-credentials/"
+	DefaultMetadataTokenPath       = "/latest/api/token"
+	DefaultMetadataTokenTTL        = 6 * time.Hour
+	DefaultMetadataTimeout         = 2 * time.Second
+	DefaultMetadataMaxRetries      = 3
+
+	// EnvMetadataEndpoint is the environment variable of the metadata
+	// endpoint.
+	EnvMetadataEndpoint = "KS3_METADATA_ENDPOINT"
+)
+
+// Headers of the session token handshake of the metadata service.
+const (
+	MetadataTokenHeader    = "X-Ksc-Metadata-Token"
+	MetadataTokenTTLHeader = "X-Ksc-Metadata-Token-Ttl-Seconds"
+)
+
+// A KsyunMetadataProvider retrieves the credentials of the role attached to a
+// Ksyun KEC instance or KCE node from the instance metadata service.
+//
+// A session token is first requested with a PUT to TokenPath, and sent with
+// the requests for the credentials; it is renewed before its TTL elapses, or
+// when the service rejects it. Metadata services which do not support
+// session tokens, answering 404, 405 or 501, are queried without one unless
+// DisableTokenFallback is set.
+//
+// Example of a provider of a given role, with a custom endpoint:
+//
+//	creds := credentials.NewCredentials(&credentials.KsyunMetadataProvider{
+//	    Endpoint:     "http://100.64.0.1",
+//	    RoleName:     "ks3-uploader",
+//	    ExpiryWindow: 5 * time.Minute,
+//	})
+type KsyunMetadataProvider struct {
+	// Base URL of the metadata service. The KS3_METADATA_ENDPOINT
+	// environment variable, then DefaultMetadataEndpoint if empty.
+	Endpoint string
+
+	// Path of the credentials, to which the role name is appended.
+	// DefaultMetadataCredentialsPath if empty.
+	CredentialsPath string
+
+	// Path of the session token. DefaultMetadataTokenPath if empty.
+	TokenPath string
+
+	// Role whose credentials are retrieved. The first role listed at
+	// CredentialsPath if empty.
+	RoleName string
+
+	// Lifetime of the session tokens requested. DefaultMetadataTokenTTL if
+	// zero.
+	TokenTTL time.Duration
+
+	// Do not query the service without session token when it does not
+	// support them.
+	DisableTokenFallback bool
+
+	// HTTP client of the requests, a client with a Timeout of
+	// DefaultMetadataTimeout if nil.
+	Client *http.Client
+
+	// Number of retries of the requests failing with a network error or a
+	// 5xx or 429 status. DefaultMetadataMaxRetries if zero, none if negative.
+	MaxRetries int
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	expiresOn time.Time
+
+	// The session token, reused by the retrievals until it expires.
+	token          string
+	tokenExpiresOn time.Time
+	tokenless      bool
+}
+
+// NewKsyunMetadataCredentials returns a pointer to a new Credentials object
+// wrapping a KsyunMetadataProvider of the default endpoint, refreshed in the
+// background before they expire.
+func NewKsyunMetadataCredentials() *Credentials {
+	return NewRefreshingCredentials(&KsyunMetadataProvider{ExpiryWindow: 5 * time.Minute}, RefreshOptions{})
+}
+
+// Retrieve retrieves the credentials of the role from the metadata service.
+func (p *KsyunMetadataProvider) Retrieve() (Value, error) {
+	role := p.RoleName
+	if role == "" {
+		body, err := p.get(p.credentialsPath())
+		if err != nil {
+			return Value{}, apierr.New("ListMetadataRoles", "failed to list the roles of the instance", err)
+		}
+		role = strings.TrimSpace(strings.SplitN(string(body), "\n", 2)[0])
+		if role == "" {
+			return Value{}, apierr.New("EmptyMetadataRoleList", "no role attached to the instance", nil)
+		}
+	}
+
+	body, err := p.get(p.credentialsPath() + role)
+	if err != nil {
+		return Value{}, apierr.New("GetMetadataCredentials",
+			fmt.Sprintf("failed to get the credentials of role %s", role), err)
+	}
+	creds, expiration, err := parseMetadataCredentials(body)
+	if err != nil {
+		return Value{}, apierr.New("DecodeMetadataCredentials",
+			fmt.Sprintf("failed to decode the credentials of role %s", role), err)
+	}
+
+	p.expiresOn = expiration
+	if p.ExpiryWindow > 0 {
+		// Offset based on expiry window if set.
+		p.expiresOn = p.expiresOn.Add(-p.ExpiryWindow)
+	}
+	return creds, nil
+}
+
+// IsExpired returns if the credentials are expired.
+func (p *KsyunMetadataProvider) IsExpired() bool {
+	return p.expiresOn.Before(sdk.NowTime())
+}
+
+// ExpiresAt returns the time at which the credentials expire, offset by the
+// ExpiryWindow.
+func (p *KsyunMetadataProvider) ExpiresAt() time.Time {
+	return p.expiresOn
+}
+
+func (p *KsyunMetadataProvider) endpoint() string {
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = os.Getenv(EnvMetadataEndpoint)
+	}
+	if endpoint == "" {
+		endpoint = DefaultMetadataEndpoint
+	}
+	return strings.TrimRight(endpoint, "/")
+}
+
+func (p *KsyunMetadataProvider) credentialsPath() string {
+	if p.CredentialsPath != "" {
+		return p.CredentialsPath
+	}
+	return DefaultMetadataCredentialsPath
+}
+
+func (p *KsyunMetadataProvider) client() metadataClient {
+	return metadataClient{client: p.Client, maxRetries: p.MaxRetries}
+}
+
+// get returns the body of path, with a session token.
+func (p *KsyunMetadataProvider) get(path string) ([]byte, error) {
+	for renewed := false; ; renewed = true {
+		token, err := p.sessionToken()
+		if err != nil {
+			return nil, err
+		}
+		header := http.Header{}
+		if token != "" {
+			header.Set(MetadataTokenHeader, token)
+		}
+		status, body, err := p.client().do("GET", p.endpoint()+path, header)
+		if err != nil {
+			return nil, err
+		}
+		if status == http.StatusUnauthorized && token != "" && !renewed {
+			p.token = ""
+			continue
+		}
+		if status != http.StatusOK {
+			return nil, statusError(status, body)
+		}
+		return body, nil
+	}
+}
+
+// sessionToken returns a valid session token, requesting one if needed, or
+// an empty one if the service does not support them.
+func (p *KsyunMetadataProvider) sessionToken() (string, error) {
+	now := sdk.NowTime()
+	if p.tokenless || (p.token != "" && now.Before(p.tokenExpiresOn)) {
+		return p.token, nil
+	}
+
+	ttl := p.TokenTTL
+	if ttl <= 0 {
+		ttl = DefaultMetadataTokenTTL
+	}
+	path := p.TokenPath
+	if path == "" {
+		path = DefaultMetadataTokenPath
+	}
+	header := http.Header{}
+	header.Set(MetadataTokenTTLHeader, strconv.Itoa(int(ttl/time.Second)))
+	status, body, err := p.client().do("PUT", p.endpoint()+path, header)
+	if err != nil {
+		return "", apierr.New("GetMetadataToken", "failed to get a metadata session token", err)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		if !p.DisableTokenFallback {
+			p.tokenless = true
+			return "", nil
+		}
+		fallthrough
+	default:
+		return "", apierr.New("GetMetadataToken", "failed to get a metadata session token", statusError(status, body))
+	}
+
+	p.token = strings.TrimSpace(string(body))
+	// Renewed a minute early, so that it does not expire in flight.
+	p.tokenExpiresOn = now.Add(ttl - time.Minute)
+	return p.token, nil
+}
+
+// A metadataClient sends the requests of the metadata and container
+// providers, retrying on network errors and throttling or server errors.
+type metadataClient struct {
+	client     *http.Client
+	maxRetries int
+}
+
+// metadataRetryDelay is the delay before the first retry, doubled on each
+// retry.
+var metadataRetryDelay = 100 * time.Millisecond
+
+func (c metadataClient) do(method, url string, header http.Header) (int, []byte, error) {
+	client := c.client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultMetadataTimeout}
+	}
+	retries := c.maxRetries
+	if retries == 0 {
+		retries = DefaultMetadataMaxRetries
+	}
+
+	delay := metadataRetryDelay
+	for attempt := 0; ; attempt++ {
+		status, body, err := c.send(client, method, url, header)
+		retryable := err != nil || status == http.StatusTooManyRequests || status >= 500
+		if !retryable || attempt >= retries {
+			return status, body, err
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (c metadataClient) send(client *http.Client, method, url string, header http.Header) (int, []byte, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	// Credentials documents are small, a larger body is not one.
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+func statusError(status int, body []byte) error {
+	msg := strings.TrimSpace(string(body))
+	if len(msg) > 256 {
+		msg = msg[:256]
+	}
+	if msg == "" {
+		return fmt.Errorf("status %d", status)
+	}
+	return fmt.Errorf("status %d: %s", status, msg)
+}
+
+// metadataCredentials is the credentials document of the metadata service and
+// of container endpoints. The session token is named Token, SecurityToken or
+// SessionToken depending on the service.
+type metadataCredentials struct {
+	Code            string
+	Message         string
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	Token           string
+	SecurityToken   string
+	SessionToken    string
+	Expiration      time.Time
+}
+
+func parseMetadataCredentials(body []byte) (Value, time.Time, error) {
+	var doc metadataCredentials
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return Value{}, time.Time{}, err
+	}
+	if doc.Code != "" && doc.Code != "Success" {
+		return Value{}, time.Time{}, fmt.Errorf("%s: %s", doc.Code, doc.Message)
+	}
+	if doc.AccessKeyID == "" || doc.SecretAccessKey == "" || doc.Expiration.IsZero() {
+		return Value{}, time.Time{}, fmt.Errorf("AccessKeyId, SecretAccessKey or Expiration missing")
+	}
+
+	token := doc.Token
+	if token == "" {
+		token = doc.SecurityToken
+	}
+	if token == "" {
+		token = doc.SessionToken
+	}
+	return Value{
+		AccessKeyID:     doc.AccessKeyID,
+		SecretAccessKey: doc.SecretAccessKey,
+		SessionToken:    token,
+	}, doc.Expiration, nil
+}
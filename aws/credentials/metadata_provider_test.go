@@ -0,0 +1,146 @@
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+const metadataCredentialsDocument = `{
+  "Code": "Success",
+  "AccessKeyId": "AKID",
+  "SecretAccessKey": "SECRET",
+  "SecurityToken": "TOKEN",
+  "Expiration": "2026-10-18T12:00:00Z"
+}`
+
+// metadataServer is a stand-in metadata service issuing session tokens
+// "token1", "token2"... and failing the first failures requests.
+type metadataServer struct {
+	*httptest.Server
+	tokens   int32
+	failures int32
+	requests int32
+	revoked  string
+}
+
+func newMetadataServer(t *testing.T, tokens bool) *metadataServer {
+	s := &metadataServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.requests, 1)
+		if atomic.AddInt32(&s.failures, -1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == DefaultMetadataTokenPath {
+			if !tokens {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "21600", r.Header.Get(MetadataTokenTTLHeader))
+			fmt.Fprintf(w, "token%d", atomic.AddInt32(&s.tokens, 1))
+			return
+		}
+		token := r.Header.Get(MetadataTokenHeader)
+		if tokens && (token == "" || token == s.revoked) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case DefaultMetadataCredentialsPath:
+			fmt.Fprint(w, "ks3-uploader\nother-role\n")
+		case DefaultMetadataCredentialsPath + "ks3-uploader":
+			fmt.Fprint(w, metadataCredentialsDocument)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func TestKsyunMetadataProvider(t *testing.T) {
+	s := newMetadataServer(t, true)
+	defer s.Close()
+
+	p := &KsyunMetadataProvider{Endpoint: s.URL + "/", ExpiryWindow: 5 * time.Minute}
+	assert.True(t, p.IsExpired())
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}, creds)
+	assert.Equal(t, time.Date(2026, 10, 18, 11, 55, 0, 0, time.UTC), p.ExpiresAt())
+	assert.Equal(t, "token1", p.token)
+
+	// The session token is reused, and renewed when it is rejected.
+	_, err = p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "token1", p.token)
+	s.revoked = "token1"
+	_, err = p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "token2", p.token)
+}
+
+func TestKsyunMetadataProviderFallback(t *testing.T) {
+	s := newMetadataServer(t, false)
+	defer s.Close()
+
+	p := &KsyunMetadataProvider{Endpoint: s.URL, RoleName: "ks3-uploader"}
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID", creds.AccessKeyID)
+
+	p = &KsyunMetadataProvider{Endpoint: s.URL, RoleName: "ks3-uploader", DisableTokenFallback: true}
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "GetMetadataCredentials", err.(awserr.Error).Code())
+		assert.Equal(t, "GetMetadataToken", err.(awserr.Error).OrigErr().(awserr.Error).Code())
+	}
+}
+
+func TestKsyunMetadataProviderRetries(t *testing.T) {
+	metadataRetryDelay = time.Millisecond
+	defer func() { metadataRetryDelay = 100 * time.Millisecond }()
+
+	s := newMetadataServer(t, true)
+	defer s.Close()
+	s.failures = 2
+	os.Setenv(EnvMetadataEndpoint, s.URL)
+	defer os.Unsetenv(EnvMetadataEndpoint)
+
+	p := &KsyunMetadataProvider{RoleName: "ks3-uploader"}
+	_, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, atomic.LoadInt32(&s.requests))
+
+	s.failures = 10
+	p = &KsyunMetadataProvider{RoleName: "ks3-uploader", MaxRetries: -1}
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "status 503")
+	}
+}
+
+func TestKsyunMetadataProviderInvalidDocument(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"Code": "RoleNotFound", "Message": "no such role"}`)
+	}))
+	defer s.Close()
+
+	_, err := (&KsyunMetadataProvider{Endpoint: s.URL, RoleName: "missing"}).Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "DecodeMetadataCredentials", err.(awserr.Error).Code())
+		assert.Contains(t, err.Error(), "no such role")
+	}
+}
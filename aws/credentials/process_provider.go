@@ -0,0 +1,152 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+)
+
+// DefaultProcessTimeout is the time a ProcessProvider waits for its command
+// when it has no Timeout.
+const DefaultProcessTimeout = time.Minute
+
+// ErrProcessCommandEmpty is returned by a ProcessProvider without command.
+var ErrProcessCommandEmpty = apierr.New("ProcessCredentialsCommand", "no command to retrieve credentials", nil)
+
+// A ProcessProvider retrieves credentials from the output of a command, such
+// as a helper fetching them from a secret store. The command writes to its
+// standard output a JSON document of the form:
+//
+//	{
+//	    "Version": 1,
+//	    "AccessKeyId": "AKID",
+//	    "SecretAccessKey": "SECRET",
+//	    "SessionToken": "TOKEN",
+//	    "Expiration": "2026-10-18T12:00:00Z"
+//	}
+//
+// SessionToken and Expiration are optional. Credentials without Expiration
+// never expire, and the command is run once.
+type ProcessProvider struct {
+	// Command and its arguments. The command is run directly, not by a
+	// shell.
+	Command []string
+
+	// Time to wait for the command, after which it is killed.
+	// DefaultProcessTimeout if zero.
+	Timeout time.Duration
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	retrieved bool
+	expiresOn time.Time
+}
+
+// NewProcessCredentials returns a pointer to a new Credentials object
+// wrapping a ProcessProvider running command with args. The command is run
+// again in the background before the credentials expire.
+func NewProcessCredentials(command string, args ...string) *Credentials {
+	return NewRefreshingCredentials(&ProcessProvider{
+		Command: append([]string{command}, args...),
+	}, RefreshOptions{})
+}
+
+// Retrieve runs the command and returns the credentials it writes.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	p.retrieved = false
+	if len(p.Command) == 0 || p.Command[0] == "" {
+		return Value{}, ErrProcessCommandEmpty
+	}
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultProcessTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command[0], p.Command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+		msg := fmt.Sprintf("failed to run credentials command %s", p.Command[0])
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			msg += ": " + s
+		}
+		return Value{}, apierr.New("ProcessCredentialsRun", msg, err)
+	}
+
+	creds, expiration, err := parseCredentialsJSON(stdout.Bytes())
+	if err != nil {
+		return Value{}, apierr.New("ProcessCredentialsParse",
+			fmt.Sprintf("invalid output of credentials command %s", p.Command[0]), err)
+	}
+
+	p.expiresOn = expiration
+	if !expiration.IsZero() && p.ExpiryWindow > 0 {
+		// Offset based on expiry window if set.
+		p.expiresOn = p.expiresOn.Add(-p.ExpiryWindow)
+	}
+	p.retrieved = true
+	return creds, nil
+}
+
+// IsExpired returns if the credentials are expired.
+func (p *ProcessProvider) IsExpired() bool {
+	return !p.retrieved || (!p.expiresOn.IsZero() && p.expiresOn.Before(sdk.NowTime()))
+}
+
+// ExpiresAt returns the time at which the credentials expire, offset by the
+// ExpiryWindow, or the zero time if they do not expire.
+func (p *ProcessProvider) ExpiresAt() time.Time {
+	return p.expiresOn
+}
+
+// credentialsJSON is the JSON document of the ProcessProvider and the
+// FileProvider.
+type credentialsJSON struct {
+	Version         int
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+// parseCredentialsJSON returns the credentials of a credentialsJSON document,
+// and their expiration, zero if they do not expire.
+func parseCredentialsJSON(data []byte) (Value, time.Time, error) {
+	var doc credentialsJSON
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return Value{}, time.Time{}, err
+	}
+	if doc.Version > 1 {
+		return Value{}, time.Time{}, fmt.Errorf("unsupported version %d", doc.Version)
+	}
+	if doc.AccessKeyID == "" || doc.SecretAccessKey == "" {
+		return Value{}, time.Time{}, fmt.Errorf("AccessKeyId or SecretAccessKey missing")
+	}
+
+	var expiration time.Time
+	if doc.Expiration != nil {
+		expiration = *doc.Expiration
+	}
+	return Value{
+		AccessKeyID:     doc.AccessKeyID,
+		SecretAccessKey: doc.SecretAccessKey,
+		SessionToken:    doc.SessionToken,
+	}, expiration, nil
+}
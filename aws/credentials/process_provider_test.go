@@ -0,0 +1,96 @@
+package credentials
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHelperProcess is the command of the ProcessProvider tests: it writes
+// its arguments to the standard output, or to the standard error and fails
+// if the first one is "fail".
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("KS3_CREDENTIALS_HELPER") != "1" {
+		return
+	}
+	args := os.Args
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[i+1:]
+			break
+		}
+	}
+	switch args[0] {
+	case "fail":
+		fmt.Fprint(os.Stderr, "secret store unavailable")
+		os.Exit(1)
+	case "sleep":
+		time.Sleep(time.Minute)
+	}
+	fmt.Print(args[0])
+	os.Exit(0)
+}
+
+func helperProvider(t *testing.T, output string) *ProcessProvider {
+	os.Setenv("KS3_CREDENTIALS_HELPER", "1")
+	t.Cleanup(func() { os.Unsetenv("KS3_CREDENTIALS_HELPER") })
+	return &ProcessProvider{Command: []string{os.Args[0], "-test.run=TestHelperProcess", "--", output}}
+}
+
+func TestProcessProvider(t *testing.T) {
+	p := helperProvider(t, `{"Version": 1, "AccessKeyId": "AKID", "SecretAccessKey": "SECRET",
+		"SessionToken": "TOKEN", "Expiration": "2026-10-18T12:00:00Z"}`)
+	p.ExpiryWindow = time.Minute
+	assert.True(t, p.IsExpired())
+
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}, creds)
+	assert.Equal(t, time.Date(2026, 10, 18, 11, 59, 0, 0, time.UTC), p.ExpiresAt())
+
+	sdk.NowTime = func() time.Time { return time.Date(2026, 10, 18, 11, 58, 0, 0, time.UTC) }
+	defer func() { sdk.NowTime = time.Now }()
+	assert.False(t, p.IsExpired())
+	sdk.NowTime = func() time.Time { return time.Date(2026, 10, 18, 11, 59, 30, 0, time.UTC) }
+	assert.True(t, p.IsExpired())
+}
+
+func TestProcessProviderNoExpiration(t *testing.T) {
+	p := helperProvider(t, `{"AccessKeyId": "AKID", "SecretAccessKey": "SECRET"}`)
+	creds, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, creds)
+	assert.False(t, p.IsExpired())
+	assert.True(t, p.ExpiresAt().IsZero())
+}
+
+func TestProcessProviderError(t *testing.T) {
+	p := helperProvider(t, "fail")
+	_, err := p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "ProcessCredentialsRun", err.(awserr.Error).Code())
+		assert.Contains(t, err.Error(), "secret store unavailable")
+	}
+	assert.True(t, p.IsExpired())
+
+	p = helperProvider(t, `{"AccessKeyId": "AKID"}`)
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "ProcessCredentialsParse", err.(awserr.Error).Code())
+	}
+
+	p = helperProvider(t, "sleep")
+	p.Timeout = 100 * time.Millisecond
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "timed out")
+	}
+
+	_, err = (&ProcessProvider{}).Retrieve()
+	assert.Equal(t, ErrProcessCommandEmpty, err)
+}
@@ -0,0 +1,219 @@
+package credentials
+
+import (
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+)
+
+// Defaults of the RefreshOptions fields left zero.
+var (
+	DefaultRefreshWindow = time.Minute
+	DefaultGracePeriod   = time.Minute
+	DefaultMinBackoff    = time.Second
+	DefaultMaxBackoff    = time.Minute
+)
+
+// An Expirer is a Provider which knows when its credentials expire.
+// ExpiresAt returns the time at which IsExpired starts returning true, or the
+// zero time if the credentials do not expire or the provider has none.
+//
+// The Credentials of NewRefreshingCredentials refresh the credentials of an
+// Expirer in the background before they expire.
+type Expirer interface {
+	ExpiresAt() time.Time
+}
+
+// RefreshOptions configures the Credentials of NewRefreshingCredentials.
+type RefreshOptions struct {
+	// How long before the expiry of the credentials they are refreshed in the
+	// background, while Get keeps returning them. DefaultRefreshWindow if
+	// zero.
+	RefreshWindow time.Duration
+
+	// How long past their expiry the credentials are still returned while
+	// refreshing them fails. DefaultGracePeriod if zero, none if negative.
+	// Credentials expired with Expire are never returned.
+	GracePeriod time.Duration
+
+	// Delay before retrying a failed retrieval, doubled on each consecutive
+	// failure up to MaxBackoff. Get returns the error of the last retrieval
+	// until then, without calling the provider. DefaultMinBackoff and
+	// DefaultMaxBackoff if zero.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Called with the error of each failed retrieval, including those made
+	// in the background.
+	OnRefreshError func(error)
+}
+
+// refresher is the state of Credentials refreshing asynchronously.
+type refresher struct {
+	RefreshOptions
+
+	valid     bool          // Credentials.creds were retrieved
+	expiresAt time.Time     // expiry of Credentials.creds, zero if unknown
+	inflight  chan struct{} // closed when the running retrieval completes
+	err       error         // error of the last retrieval
+	failures  int           // consecutive failed retrievals
+	retryAt   time.Time     // no retrieval before, after a failure
+}
+
+// NewRefreshingCredentials returns a pointer to a new Credentials with the
+// provider set, which retrieves the credentials at most once at a time, and
+// refreshes them in the background when they are about to expire.
+//
+// Concurrent calls to Get share the result of a single call to the
+// provider's Retrieve, instead of calling it in turn. Credentials which are
+// still valid are returned while being refreshed, so that callers do not
+// wait on the provider. The provider must implement Expirer for its
+// credentials to be refreshed before they expire; they are otherwise
+// retrieved once IsExpired returns true, as with NewCredentials.
+//
+// The provider is not called concurrently, and its IsExpired is not called
+// while Retrieve runs.
+func NewRefreshingCredentials(provider Provider, options RefreshOptions) *Credentials {
+	if options.RefreshWindow == 0 {
+		options.RefreshWindow = DefaultRefreshWindow
+	}
+	if options.GracePeriod == 0 {
+		options.GracePeriod = DefaultGracePeriod
+	}
+	if options.MinBackoff <= 0 {
+		options.MinBackoff = DefaultMinBackoff
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = DefaultMaxBackoff
+	}
+	return &Credentials{
+		provider:     provider,
+		forceRefresh: true,
+		refresh:      &refresher{RefreshOptions: options},
+	}
+}
+
+func (c *Credentials) getRefreshing() (Value, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	r := c.refresh
+	now := sdk.NowTime()
+	if !c.forceRefresh && !c.refreshExpired(now) {
+		if r.inflight == nil && r.refreshDue(now) {
+			c.startRefresh()
+		}
+		return c.creds, nil
+	}
+
+	if r.inflight == nil {
+		if now.Before(r.retryAt) {
+			if !c.forceRefresh && r.inGrace(now) {
+				return c.creds, nil
+			}
+			return Value{}, r.err
+		}
+		c.startRefresh()
+	}
+	if !c.forceRefresh && r.inGrace(now) {
+		return c.creds, nil
+	}
+
+	done := r.inflight
+	c.m.Unlock()
+	<-done
+	c.m.Lock()
+	if r.err != nil {
+		if !c.forceRefresh && r.inGrace(sdk.NowTime()) {
+			return c.creds, nil
+		}
+		return Value{}, r.err
+	}
+	// The credentials just retrieved are returned even if they are already
+	// expired, rather than retrieved again.
+	return c.creds, nil
+}
+
+// refreshExpired returns if the credentials can no longer be returned without
+// being retrieved. c.m must be held.
+func (c *Credentials) refreshExpired(now time.Time) bool {
+	r := c.refresh
+	if !r.valid {
+		return true
+	}
+	if !r.expiresAt.IsZero() {
+		if !now.Before(r.expiresAt) {
+			return true
+		}
+		// The provider may also expire its credentials before, such as a
+		// FileProvider whose file changed.
+		return r.inflight == nil && c.provider.IsExpired()
+	}
+	// Without an expiry, a retrieval only runs once the provider has
+	// expired.
+	return r.inflight != nil || c.provider.IsExpired()
+}
+
+// startRefresh retrieves the credentials in a new goroutine. c.m must be
+// held.
+func (c *Credentials) startRefresh() {
+	r := c.refresh
+	done := make(chan struct{})
+	r.inflight = done
+
+	go func() {
+		creds, err := c.provider.Retrieve()
+		var expiresAt time.Time
+		if e, ok := c.provider.(Expirer); ok && err == nil {
+			expiresAt = e.ExpiresAt()
+		}
+
+		c.m.Lock()
+		if err != nil {
+			r.err = err
+			r.failures++
+			r.retryAt = sdk.NowTime().Add(r.backoff())
+		} else {
+			c.creds = creds
+			c.forceRefresh = false
+			r.valid = true
+			r.expiresAt = expiresAt
+			r.err = nil
+			r.failures = 0
+			r.retryAt = time.Time{}
+		}
+		r.inflight = nil
+		close(done)
+		c.m.Unlock()
+
+		if err != nil && r.OnRefreshError != nil {
+			r.OnRefreshError(err)
+		}
+	}()
+}
+
+// refreshDue returns if valid credentials should be refreshed in the
+// background.
+func (r *refresher) refreshDue(now time.Time) bool {
+	return !r.expiresAt.IsZero() &&
+		!now.Before(r.expiresAt.Add(-r.RefreshWindow)) &&
+		!now.Before(r.retryAt)
+}
+
+// inGrace returns if expired credentials may still be returned because
+// refreshing them failed.
+func (r *refresher) inGrace(now time.Time) bool {
+	return r.valid && r.err != nil && !r.expiresAt.IsZero() &&
+		now.Before(r.expiresAt.Add(r.GracePeriod))
+}
+
+func (r *refresher) backoff() time.Duration {
+	d := r.MinBackoff
+	for i := 1; i < r.failures && d < r.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.MaxBackoff {
+		d = r.MaxBackoff
+	}
+	return d
+}
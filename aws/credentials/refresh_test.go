@@ -0,0 +1,261 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock replaces sdk.NowTime in tests.
+type fakeClock struct {
+	m   sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}
+	sdk.NowTime = c.Now
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// expiringProvider returns credentials valid for ttl, numbered by call. Its
+// Retrieve blocks while gate is set, and fails while err is set.
+type expiringProvider struct {
+	clock *fakeClock
+	ttl   time.Duration
+
+	m     sync.Mutex
+	calls int
+	gate  chan struct{}
+	err   error
+
+	expiresOn time.Time
+}
+
+func (p *expiringProvider) Retrieve() (Value, error) {
+	p.m.Lock()
+	p.calls++
+	n, gate, err := p.calls, p.gate, p.err
+	p.m.Unlock()
+	if gate != nil {
+		<-gate
+	}
+	if err != nil {
+		return Value{}, err
+	}
+	p.expiresOn = p.clock.Now().Add(p.ttl)
+	return Value{AccessKeyID: fmt.Sprintf("AKID%d", n), SecretAccessKey: "SECRET"}, nil
+}
+
+func (p *expiringProvider) IsExpired() bool {
+	return !p.clock.Now().Before(p.expiresOn)
+}
+
+func (p *expiringProvider) ExpiresAt() time.Time {
+	return p.expiresOn
+}
+
+func (p *expiringProvider) set(gate chan struct{}, err error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.gate, p.err = gate, err
+}
+
+func (p *expiringProvider) callCount() int {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.calls
+}
+
+// waitIdle waits for the retrieval running in the background to complete.
+func waitIdle(c *Credentials) {
+	c.m.Lock()
+	done := c.refresh.inflight
+	c.m.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+func TestRefreshingCredentialsSingleFlight(t *testing.T) {
+	clock := newFakeClock()
+	defer func() { sdk.NowTime = time.Now }()
+
+	gate := make(chan struct{})
+	p := &expiringProvider{clock: clock, ttl: 10 * time.Minute}
+	p.set(gate, nil)
+	c := NewRefreshingCredentials(p, RefreshOptions{})
+
+	var wg sync.WaitGroup
+	values := make([]Value, 50)
+	for i := range values {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values[i], _ = c.Get()
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(gate)
+	wg.Wait()
+
+	assert.Equal(t, 1, p.callCount())
+	for _, v := range values {
+		assert.Equal(t, "AKID1", v.AccessKeyID)
+	}
+}
+
+func TestRefreshingCredentialsBackground(t *testing.T) {
+	clock := newFakeClock()
+	defer func() { sdk.NowTime = time.Now }()
+
+	p := &expiringProvider{clock: clock, ttl: 10 * time.Minute}
+	c := NewRefreshingCredentials(p, RefreshOptions{RefreshWindow: time.Minute})
+	v, err := c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID1", v.AccessKeyID)
+
+	clock.Add(8 * time.Minute)
+	v, _ = c.Get()
+	assert.Equal(t, "AKID1", v.AccessKeyID)
+	assert.Equal(t, 1, p.callCount())
+
+	// Within the refresh window, the credentials are returned while being
+	// refreshed.
+	gate := make(chan struct{})
+	p.set(gate, nil)
+	clock.Add(90 * time.Second)
+	for i := 0; i < 3; i++ {
+		v, err = c.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "AKID1", v.AccessKeyID)
+	}
+	close(gate)
+	waitIdle(c)
+	assert.Equal(t, 2, p.callCount())
+
+	v, _ = c.Get()
+	assert.Equal(t, "AKID2", v.AccessKeyID)
+	assert.False(t, c.IsExpired())
+}
+
+func TestRefreshingCredentialsFailure(t *testing.T) {
+	clock := newFakeClock()
+	defer func() { sdk.NowTime = time.Now }()
+
+	var m sync.Mutex
+	var reported []error
+	p := &expiringProvider{clock: clock, ttl: 10 * time.Minute}
+	c := NewRefreshingCredentials(p, RefreshOptions{
+		RefreshWindow: time.Minute,
+		GracePeriod:   2 * time.Minute,
+		MinBackoff:    10 * time.Second,
+		MaxBackoff:    30 * time.Second,
+		OnRefreshError: func(err error) {
+			m.Lock()
+			reported = append(reported, err)
+			m.Unlock()
+		},
+	})
+	_, err := c.Get()
+	assert.NoError(t, err)
+
+	errDown := errors.New("metadata service unavailable")
+	p.set(nil, errDown)
+	clock.Add(9*time.Minute + 30*time.Second)
+	v, err := c.Get() // starts a background refresh, which fails
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID1", v.AccessKeyID)
+	waitIdle(c)
+	assert.Equal(t, 2, p.callCount())
+
+	// Backing off: no retrieval for 10s.
+	clock.Add(5 * time.Second)
+	v, err = c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID1", v.AccessKeyID)
+	assert.Equal(t, 2, p.callCount())
+
+	// Expired, but within the grace period: the credentials are returned
+	// while retrying in the background.
+	clock.Add(time.Minute)
+	v, err = c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID1", v.AccessKeyID)
+	waitIdle(c)
+	assert.Equal(t, 3, p.callCount())
+
+	// Past the grace period.
+	clock.Add(2 * time.Minute)
+	_, err = c.Get()
+	assert.Equal(t, errDown, err)
+	assert.Equal(t, 4, p.callCount())
+
+	// Backing off for 30s after the third failure.
+	clock.Add(20 * time.Second)
+	_, err = c.Get()
+	assert.Equal(t, errDown, err)
+	assert.Equal(t, 4, p.callCount())
+
+	m.Lock()
+	assert.Equal(t, []error{errDown, errDown, errDown}, reported)
+	m.Unlock()
+
+	p.set(nil, nil)
+	clock.Add(10 * time.Second)
+	v, err = c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID5", v.AccessKeyID)
+}
+
+func TestRefreshingCredentialsExpire(t *testing.T) {
+	clock := newFakeClock()
+	defer func() { sdk.NowTime = time.Now }()
+
+	p := &expiringProvider{clock: clock, ttl: 10 * time.Minute}
+	c := NewRefreshingCredentials(p, RefreshOptions{})
+	_, err := c.Get()
+	assert.NoError(t, err)
+
+	// Credentials expired with Expire are not returned during the grace
+	// period.
+	errDown := errors.New("metadata service unavailable")
+	p.set(nil, errDown)
+	c.Expire()
+	assert.True(t, c.IsExpired())
+	_, err = c.Get()
+	assert.Equal(t, errDown, err)
+}
+
+func TestRefreshingCredentialsNoExpiry(t *testing.T) {
+	p := &stubProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, expired: true}
+	c := NewRefreshingCredentials(p, RefreshOptions{})
+
+	v, err := c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID", v.AccessKeyID)
+	assert.False(t, c.IsExpired())
+
+	p.expired = true
+	p.creds.AccessKeyID = "AKID2"
+	v, err = c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID2", v.AccessKeyID)
+}
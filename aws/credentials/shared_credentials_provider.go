@@ -14,7 +14,9 @@ var (
 )
 
 // A SharedCredentialsProvider retrieves credentials from the current user's home
-// directory, and keeps track if those credentials are expired.
+// directory, and keeps track if those credentials are expired. They expire when
+// the file is modified, which is checked at most once per
+// DefaultFilePollInterval.
 //
 // Profile ini file example: $HOME/.aws/credentials
 type SharedCredentialsProvider struct {
@@ -29,6 +31,8 @@ type SharedCredentialsProvider struct {
 
 	// retrieved states if the credentials have been successfully retrieved.
 	retrieved bool
+
+	watch fileWatch
 }
 
 // NewSharedCredentials returns a pointer to a new Credentials object
@@ -50,18 +54,23 @@ func (p *SharedCredentialsProvider) Retrieve() (Value, error) {
 		return Value{}, err
 	}
 
+	stamp, err := statFile(filename)
+	if err != nil {
+		return Value{}, apierr.New("SharedCredsLoad", "failed to load shared credentials file", err)
+	}
 	creds, err := loadProfile(filename, p.profile())
 	if err != nil {
 		return Value{}, err
 	}
 
+	p.watch.reset(stamp)
 	p.retrieved = true
 	return creds, nil
 }
 
 // IsExpired returns if the shared credentials have expired.
 func (p *SharedCredentialsProvider) IsExpired() bool {
-	return !p.retrieved
+	return !p.retrieved || p.watch.changed(p.Filename, 0)
 }
 
 // loadProfiles loads from the file pointed to by shared credentials filename for profile.
@@ -1,9 +1,12 @@
 package credentials
 
 import (
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
 	"github.com/stretchr/testify/assert"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestSharedCredentialsProvider(t *testing.T) {
@@ -75,3 +78,26 @@ func BenchmarkSharedCredentialsProvider(b *testing.B) {
 		}
 	})
 }
+
+func TestSharedCredentialsProviderFileChanged(t *testing.T) {
+	os.Clearenv()
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	modTime := time.Now().Add(-time.Hour)
+	writeFile(t, filename, "[default]\naws_access_key_id = AKID1\naws_secret_access_key = secret\n", modTime)
+
+	p := SharedCredentialsProvider{Filename: filename}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "AKID1", creds.AccessKeyID)
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve")
+
+	writeFile(t, filename, "[default]\naws_access_key_id = AKID2\naws_secret_access_key = secret\n", modTime.Add(time.Minute))
+	sdk.NowTime = func() time.Time { return time.Now().Add(DefaultFilePollInterval) }
+	defer func() { sdk.NowTime = time.Now }()
+	assert.True(t, p.IsExpired(), "Expect creds to be expired after the file changed")
+
+	creds, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "AKID2", creds.AccessKeyID)
+}
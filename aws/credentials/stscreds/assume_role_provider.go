@@ -0,0 +1,152 @@
+// Package stscreds provides credentials retrieved by assuming a role with the
+// Security Token Service.
+//
+// The temporary credentials are cached by the credentials.Credentials which
+// wraps the provider, and the role is assumed again in the background shortly
+// before they expire. A request failing with an expired token also expires
+// them, so that the next request assumes the role again.
+//
+// Example of accessing a bucket of another account through a role it grants:
+//
+//	creds := stscreds.NewCredentials(&aws.Config{
+//	    Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+//	}, "krn:ksc:iam::123456789012:role/ks3-access", func(p *stscreds.AssumeRoleProvider) {
+//	    p.ExternalID = aws.String("customer-42")
+//	})
+//
+//	svc := s3.New(&aws.Config{
+//	    Credentials: creds,
+//	    Region:      "BEIJING",
+//	})
+package stscreds
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+	"github.com/ks3sdklib/aws-sdk-go/service/sts"
+)
+
+// AssumeRoler is the operation of the STS client used by the provider, to be
+// replaced in tests.
+type AssumeRoler interface {
+	AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
+}
+
+// DefaultDuration is the lifetime of the credentials when the provider has no
+// Duration.
+var DefaultDuration = 15 * time.Minute
+
+// DefaultExpiryWindow is the ExpiryWindow set by NewCredentials.
+var DefaultExpiryWindow = time.Minute
+
+// An AssumeRoleProvider retrieves temporary credentials by assuming a role,
+// and keeps track of their expiry.
+type AssumeRoleProvider struct {
+	// STS client assuming the role, signed with the credentials of the caller.
+	Client AssumeRoler
+
+	// Role to assume.
+	RoleARN string
+
+	// Session name of the caller in the role. A name is generated from the
+	// current time if empty.
+	RoleSessionName string
+
+	// Lifetime of the credentials. DefaultDuration if zero.
+	Duration time.Duration
+
+	// External ID the account of the role may require from the caller.
+	ExternalID *string
+
+	// Policy further restricting the permissions of the credentials.
+	Policy *string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, so that requests do not fail with
+	// credentials expiring while they are sent.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// The date/time at which the credentials expire.
+	expiresOn time.Time
+}
+
+// NewCredentials returns a pointer to a new Credentials object wrapping an
+// AssumeRoleProvider of roleARN, with an STS client of cfg and an ExpiryWindow
+// of DefaultExpiryWindow. The options are applied to the provider in order.
+//
+// The Credentials are those of credentials.NewRefreshingCredentials, which
+// assume the role again in the background before the credentials expire.
+func NewCredentials(cfg *aws.Config, roleARN string, options ...func(*AssumeRoleProvider)) *credentials.Credentials {
+	return NewCredentialsWithClient(sts.New(cfg), roleARN, options...)
+}
+
+// NewCredentialsWithClient is NewCredentials with the STS client given.
+func NewCredentialsWithClient(client AssumeRoler, roleARN string, options ...func(*AssumeRoleProvider)) *credentials.Credentials {
+	p := &AssumeRoleProvider{
+		Client:       client,
+		RoleARN:      roleARN,
+		ExpiryWindow: DefaultExpiryWindow,
+	}
+	for _, option := range options {
+		option(p)
+	}
+	return credentials.NewRefreshingCredentials(p, credentials.RefreshOptions{})
+}
+
+// Retrieve assumes the role and returns the temporary credentials.
+func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
+	name := p.RoleSessionName
+	if name == "" {
+		name = fmt.Sprintf("ks3-go-sdk-%d", sdk.NowTime().UnixNano())
+	}
+	duration := p.Duration
+	if duration == 0 {
+		duration = DefaultDuration
+	}
+
+	out, err := p.Client.AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String(p.RoleARN),
+		RoleSessionName: aws.String(name),
+		DurationSeconds: aws.Long(int64(duration / time.Second)),
+		ExternalId:      p.ExternalID,
+		Policy:          p.Policy,
+	})
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	c := out.Credentials
+	if c == nil || c.AccessKeyId == nil || c.SecretAccessKey == nil || c.Expiration == nil {
+		return credentials.Value{}, apierr.New("InvalidAssumeRoleResponse",
+			fmt.Sprintf("no credentials returned for role %s", p.RoleARN), nil)
+	}
+
+	p.expiresOn = *c.Expiration
+	if p.ExpiryWindow > 0 {
+		// Offset based on expiry window if set.
+		p.expiresOn = p.expiresOn.Add(-p.ExpiryWindow)
+	}
+
+	return credentials.Value{
+		AccessKeyID:     *c.AccessKeyId,
+		SecretAccessKey: *c.SecretAccessKey,
+		SessionToken:    aws.ToString(c.SessionToken),
+	}, nil
+}
+
+// IsExpired returns if the credentials are expired.
+func (p *AssumeRoleProvider) IsExpired() bool {
+	return p.expiresOn.Before(sdk.NowTime())
+}
+
+// ExpiresAt returns the time at which the credentials expire, offset by the
+// ExpiryWindow.
+func (p *AssumeRoleProvider) ExpiresAt() time.Time {
+	return p.expiresOn
+}
@@ -0,0 +1,193 @@
+package stscreds
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/internal/sdk"
+	"github.com/ks3sdklib/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+const assumeRoleResponse = `<AssumeRoleResponse>
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <AssumedRoleId>AROA:session</AssumedRoleId>
+      <Arn>krn:ksc:iam::123456789012:role/ks3-access</Arn>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>TMPAKID%d</AccessKeyId>
+      <SecretAccessKey>TMPSECRET</SecretAccessKey>
+      <SessionToken>TOKEN</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata><RequestId>req</RequestId></ResponseMetadata>
+</AssumeRoleResponse>`
+
+// stsServer is a stand-in STS endpoint issuing credentials expiring at
+// *expiration.
+func stsServer(t *testing.T, calls *int32, expiration *time.Time) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+		assert.Equal(t, "POST", r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/cn-beijing-6/sts/aws4_request")
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "AssumeRole", r.PostForm.Get("Action"))
+		assert.Equal(t, "2015-11-01", r.PostForm.Get("Version"))
+		assert.Equal(t, "krn:ksc:iam::123456789012:role/ks3-access", r.PostForm.Get("RoleArn"))
+		assert.Equal(t, "tenant", r.PostForm.Get("RoleSessionName"))
+		assert.Equal(t, "900", r.PostForm.Get("DurationSeconds"))
+		assert.Equal(t, "customer-42", r.PostForm.Get("ExternalId"))
+
+		fmt.Fprintf(w, assumeRoleResponse, n, expiration.UTC().Format("2006-01-02T15:04:05Z"))
+	}))
+}
+
+func TestAssumeRoleProvider(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	sdk.NowTime = func() time.Time { return now }
+	defer func() { sdk.NowTime = time.Now }()
+
+	var calls int32
+	expiration := now.Add(15 * time.Minute)
+	server := stsServer(t, &calls, &expiration)
+	defer server.Close()
+
+	p := &AssumeRoleProvider{
+		Client: sts.New(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Endpoint:    server.URL,
+			MaxRetries:  -1,
+		}),
+		RoleARN:         "krn:ksc:iam::123456789012:role/ks3-access",
+		RoleSessionName: "tenant",
+		ExternalID:      aws.String("customer-42"),
+		ExpiryWindow:    time.Minute,
+	}
+	v, err := p.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, credentials.Value{AccessKeyID: "TMPAKID1", SecretAccessKey: "TMPSECRET", SessionToken: "TOKEN"}, v)
+	assert.Equal(t, now.Add(14*time.Minute), p.ExpiresAt())
+
+	now = now.Add(13 * time.Minute)
+	assert.False(t, p.IsExpired())
+	now = now.Add(90 * time.Second)
+	assert.True(t, p.IsExpired())
+}
+
+// clock is a stand-in sdk.NowTime, read by the credentials refreshed in the
+// background.
+type clock struct {
+	m   sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestAssumeRoleCredentials(t *testing.T) {
+	c := &clock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}
+	sdk.NowTime = c.Now
+	defer func() { sdk.NowTime = time.Now }()
+
+	var calls int32
+	expiration := c.Now().Add(15 * time.Minute)
+	server := stsServer(t, &calls, &expiration)
+	defer server.Close()
+
+	// The Config shared with the S3 client: its Region is not the STS one.
+	creds := NewCredentials(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    server.URL,
+		Region:      "BEIJING",
+		MaxRetries:  -1,
+	}, "krn:ksc:iam::123456789012:role/ks3-access", func(p *AssumeRoleProvider) {
+		p.RoleSessionName = "tenant"
+		p.ExternalID = aws.String("customer-42")
+	})
+
+	v, err := creds.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, credentials.Value{AccessKeyID: "TMPAKID1", SecretAccessKey: "TMPSECRET", SessionToken: "TOKEN"}, v)
+
+	// Cached until the refresh window before the ExpiryWindow.
+	c.Add(12 * time.Minute)
+	v, err = creds.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "TMPAKID1", v.AccessKeyID)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+
+	// Assumed again in the background before the credentials expire, while
+	// they are still returned.
+	expiration = c.Now().Add(15 * time.Minute)
+	c.Add(90 * time.Second)
+	assert.False(t, creds.IsExpired())
+	v, err = creds.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "TMPAKID1", v.AccessKeyID)
+	assert.Eventually(t, func() bool {
+		v, err := creds.Get()
+		return err == nil && v.AccessKeyID == "TMPAKID2"
+	}, 5*time.Second, time.Millisecond)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+
+	// Assumed again on Get once expired.
+	c.Add(20 * time.Minute)
+	expiration = c.Now().Add(15 * time.Minute)
+	assert.True(t, creds.IsExpired())
+	v, err = creds.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "TMPAKID3", v.AccessKeyID)
+
+	creds.Expire()
+	v, err = creds.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "TMPAKID4", v.AccessKeyID)
+}
+
+func TestAssumeRoleProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code>`+
+			`<Message>not authorized to assume the role</Message></Error><RequestId>req-1</RequestId></ErrorResponse>`)
+	}))
+	defer server.Close()
+
+	creds := NewCredentials(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    server.URL,
+		MaxRetries:  -1,
+	}, "krn:ksc:iam::123456789012:role/ks3-access")
+
+	_, err := creds.Get()
+	if assert.Error(t, err) {
+		reqErr, ok := err.(awserr.RequestFailure)
+		if assert.True(t, ok, "%T", err) {
+			assert.Equal(t, "AccessDenied", reqErr.Code())
+			assert.Equal(t, http.StatusForbidden, reqErr.StatusCode())
+			assert.Equal(t, "req-1", reqErr.RequestID())
+		}
+	}
+	assert.True(t, creds.IsExpired())
+}
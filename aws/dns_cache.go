@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DNS缓存配置的默认值
+const (
+	DefaultDnsCacheTTL        = 60 * time.Second
+	DefaultDnsCacheMaxStale   = time.Hour
+	DefaultDnsBlacklistPeriod = 30 * time.Second
+	DefaultDnsCacheMaxHosts   = 1000
+)
+
+// DnsCacheConfig DNS缓存配置
+type DnsCacheConfig struct {
+	TTL             time.Duration       // 解析结果的缓存时间，默认值为60s
+	MaxStale        time.Duration       // 解析失败时（如DNS服务不可用），继续使用已过期解析结果的最长时间，默认值为1h，值小于0时不使用过期结果
+	BlacklistPeriod time.Duration       // 连接失败的IP被暂时跳过的时间，默认值为30s
+	MaxHosts        int                 // 最多缓存的域名数，默认值为1000
+	StaticHosts     map[string][]string // 静态解析，域名对应的IP列表，不经过DNS解析，列表为空的域名仍经过DNS解析
+	Resolver        *net.Resolver       // DNS解析器，默认值为net.DefaultResolver
+}
+
+// DnsCacheStats DNS缓存统计信息
+type DnsCacheStats struct {
+	Hits         uint64 // 命中未过期缓存的次数
+	StaleHits    uint64 // 解析失败时使用过期缓存的次数
+	Misses       uint64 // 未命中缓存，进行DNS解析的次数
+	LookupErrors uint64 // DNS解析失败的次数
+	Dials        uint64 // 建立连接的次数
+	DialErrors   uint64 // 建立连接失败的次数
+	Hosts        int    // 当前缓存的域名数
+	Blacklisted  int    // 当前被暂时跳过的IP数
+}
+
+// DnsCache 带TTL的DNS缓存，为每个域名缓存解析到的全部IP，建立连接时轮询使用，
+// 连接失败的IP在一段时间内被跳过，并在所有IP都失败时依次尝试
+type DnsCache struct {
+	ttl             time.Duration
+	maxStale        time.Duration
+	blacklistPeriod time.Duration
+	maxHosts        int
+	staticHosts     map[string][]string
+	resolver        *net.Resolver
+
+	now    func() time.Time
+	lookup func(ctx context.Context, host string) ([]string, error)
+
+	mu        sync.Mutex
+	entries   map[string]*dnsCacheEntry
+	blacklist map[string]time.Time
+
+	hits, staleHits, misses, lookupErrors, dials, dialErrors uint64
+}
+
+type dnsCacheEntry struct {
+	addrs   []string
+	expires time.Time
+	next    uint32
+}
+
+// NewDnsCache 根据配置创建DNS缓存，未设置的配置项使用默认值
+func NewDnsCache(cfg DnsCacheConfig) *DnsCache {
+	c := &DnsCache{
+		ttl:             durationOrDefault(cfg.TTL, DefaultDnsCacheTTL),
+		maxStale:        durationOrDefault(cfg.MaxStale, DefaultDnsCacheMaxStale),
+		blacklistPeriod: durationOrDefault(cfg.BlacklistPeriod, DefaultDnsBlacklistPeriod),
+		maxHosts:        intOrDefault(cfg.MaxHosts, DefaultDnsCacheMaxHosts),
+		staticHosts:     map[string][]string{},
+		resolver:        cfg.Resolver,
+		now:             time.Now,
+		entries:         map[string]*dnsCacheEntry{},
+		blacklist:       map[string]time.Time{},
+	}
+	for host, addrs := range cfg.StaticHosts {
+		if len(addrs) > 0 {
+			c.staticHosts[host] = append([]string{}, addrs...)
+		}
+	}
+	if c.resolver == nil {
+		c.resolver = net.DefaultResolver
+	}
+	c.lookup = c.resolver.LookupHost
+	return c
+}
+
+// DialContext 返回使用该缓存解析域名的DialContext函数，用于http.Transport
+func (c *DnsCache) DialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil || net.ParseIP(host) != nil {
+			return dialer.DialContext(ctx, network, address)
+		}
+
+		addrs, err := c.Lookup(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		addrs = filterNetwork(network, addrs)
+		if len(addrs) == 0 {
+			return nil, &net.DNSError{Err: "no suitable address found", Name: host}
+		}
+
+		var lastErr error
+		for _, addr := range addrs {
+			atomic.AddUint64(&c.dials, 1)
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr, port))
+			if err == nil {
+				c.clearFailure(addr)
+				return conn, nil
+			}
+			atomic.AddUint64(&c.dialErrors, 1)
+			c.MarkFailed(addr)
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		return nil, lastErr
+	}
+}
+
+// Lookup 返回域名对应的IP列表。列表按轮询顺序排列，被暂时跳过的IP排在最后
+func (c *DnsCache) Lookup(ctx context.Context, host string) ([]string, error) {
+	if addrs, ok := c.staticHosts[host]; ok {
+		return c.order(host, addrs), nil
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[host]
+	var expires time.Time
+	if ok {
+		expires = entry.expires
+	}
+	c.mu.Unlock()
+	if ok && c.now().Before(expires) {
+		atomic.AddUint64(&c.hits, 1)
+		return c.rotate(entry), nil
+	}
+
+	atomic.AddUint64(&c.misses, 1)
+	addrs, err := c.lookup(ctx, host)
+	if err == nil && len(addrs) == 0 {
+		err = &net.DNSError{Err: "no such host", Name: host}
+	}
+	if err != nil {
+		atomic.AddUint64(&c.lookupErrors, 1)
+		if ok && c.maxStale > 0 && c.now().Before(expires.Add(c.maxStale)) {
+			atomic.AddUint64(&c.staleHits, 1)
+			return c.rotate(entry), nil
+		}
+		return nil, err
+	}
+
+	c.mu.Lock()
+	entry = c.entries[host]
+	if entry == nil {
+		c.evict()
+		entry = &dnsCacheEntry{}
+		c.entries[host] = entry
+	}
+	entry.addrs = addrs
+	entry.expires = c.now().Add(c.ttl)
+	c.mu.Unlock()
+
+	return c.rotate(entry), nil
+}
+
+// MarkFailed 将IP暂时加入黑名单，黑名单期间该IP排在其它IP之后
+func (c *DnsCache) MarkFailed(addr string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.blacklist[addr] = c.now().Add(c.blacklistPeriod)
+}
+
+// Flush 清空缓存的解析结果及黑名单
+func (c *DnsCache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]*dnsCacheEntry{}
+	c.blacklist = map[string]time.Time{}
+}
+
+// Stats 返回缓存的统计信息
+func (c *DnsCache) Stats() DnsCacheStats {
+	c.mu.Lock()
+	hosts := len(c.entries)
+	now := c.now()
+	blacklisted := 0
+	for _, until := range c.blacklist {
+		if now.Before(until) {
+			blacklisted++
+		}
+	}
+	c.mu.Unlock()
+
+	return DnsCacheStats{
+		Hits:         atomic.LoadUint64(&c.hits),
+		StaleHits:    atomic.LoadUint64(&c.staleHits),
+		Misses:       atomic.LoadUint64(&c.misses),
+		LookupErrors: atomic.LoadUint64(&c.lookupErrors),
+		Dials:        atomic.LoadUint64(&c.dials),
+		DialErrors:   atomic.LoadUint64(&c.dialErrors),
+		Hosts:        hosts,
+		Blacklisted:  blacklisted,
+	}
+}
+
+func (c *DnsCache) clearFailure(addr string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.blacklist, addr)
+}
+
+// rotate 返回从下一个轮询位置开始的IP列表
+func (c *DnsCache) rotate(entry *dnsCacheEntry) []string {
+	c.mu.Lock()
+	addrs := entry.addrs
+	start := int(entry.next % uint32(len(addrs)))
+	entry.next++
+	c.mu.Unlock()
+
+	rotated := make([]string, 0, len(addrs))
+	rotated = append(rotated, addrs[start:]...)
+	rotated = append(rotated, addrs[:start]...)
+	return c.sortBlacklisted(rotated)
+}
+
+// order 轮询静态解析的IP列表
+func (c *DnsCache) order(host string, addrs []string) []string {
+	c.mu.Lock()
+	entry, ok := c.entries[host]
+	if !ok {
+		entry = &dnsCacheEntry{addrs: addrs}
+		c.entries[host] = entry
+	}
+	c.mu.Unlock()
+	return c.rotate(entry)
+}
+
+// sortBlacklisted 将黑名单中的IP移到列表末尾，保持其余IP的顺序
+func (c *DnsCache) sortBlacklisted(addrs []string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	healthy := make([]string, 0, len(addrs))
+	var failed []string
+	for _, addr := range addrs {
+		if until, ok := c.blacklist[addr]; ok {
+			if now.Before(until) {
+				failed = append(failed, addr)
+				continue
+			}
+			delete(c.blacklist, addr)
+		}
+		healthy = append(healthy, addr)
+	}
+	return append(healthy, failed...)
+}
+
+// evict 缓存的域名数达到上限时，淘汰最早过期的条目。调用时需持有c.mu
+func (c *DnsCache) evict() {
+	if len(c.entries) < c.maxHosts {
+		return
+	}
+	var oldestHost string
+	var oldest time.Time
+	for host, entry := range c.entries {
+		if _, static := c.staticHosts[host]; static {
+			continue
+		}
+		if oldestHost == "" || entry.expires.Before(oldest) {
+			oldestHost, oldest = host, entry.expires
+		}
+	}
+	delete(c.entries, oldestHost)
+}
+
+// filterNetwork 按网络类型过滤IP，tcp4只保留IPv4地址，tcp6只保留IPv6地址
+func filterNetwork(network string, addrs []string) []string {
+	if network != "tcp4" && network != "tcp6" {
+		return addrs
+	}
+	filtered := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			continue
+		}
+		if (ip.To4() != nil) == (network == "tcp4") {
+			filtered = append(filtered, addr)
+		}
+	}
+	return filtered
+}
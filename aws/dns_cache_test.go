@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDnsCache(cfg DnsCacheConfig, addrs []string) (*DnsCache, *time.Time, *error) {
+	now := time.Unix(1700000000, 0)
+	var lookupErr error
+	c := NewDnsCache(cfg)
+	c.now = func() time.Time { return now }
+	c.lookup = func(ctx context.Context, host string) ([]string, error) {
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return addrs, nil
+	}
+	return c, &now, &lookupErr
+}
+
+func TestDnsCacheTTL(t *testing.T) {
+	c, now, lookupErr := newTestDnsCache(DnsCacheConfig{TTL: time.Minute, MaxStale: 10 * time.Minute}, []string{"10.0.0.1"})
+	ctx := context.Background()
+
+	_, err := c.Lookup(ctx, "ks3.test")
+	assert.NoError(t, err)
+	_, err = c.Lookup(ctx, "ks3.test")
+	assert.NoError(t, err)
+	stats := c.Stats()
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, 1, stats.Hosts)
+
+	// Expired entries are resolved again.
+	*now = now.Add(2 * time.Minute)
+	_, err = c.Lookup(ctx, "ks3.test")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), c.Stats().Misses)
+
+	// During a DNS outage the expired addresses are still used, up to MaxStale.
+	*lookupErr = errors.New("dns outage")
+	*now = now.Add(5 * time.Minute)
+	addrs, err := c.Lookup(ctx, "ks3.test")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, addrs)
+	assert.Equal(t, uint64(1), c.Stats().StaleHits)
+
+	*now = now.Add(10 * time.Minute)
+	_, err = c.Lookup(ctx, "ks3.test")
+	assert.Error(t, err)
+	assert.Equal(t, uint64(2), c.Stats().LookupErrors)
+}
+
+func TestDnsCacheRoundRobinAndBlacklist(t *testing.T) {
+	c, now, _ := newTestDnsCache(DnsCacheConfig{BlacklistPeriod: 30 * time.Second}, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"})
+	ctx := context.Background()
+
+	var firsts []string
+	for i := 0; i < 4; i++ {
+		addrs, err := c.Lookup(ctx, "ks3.test")
+		assert.NoError(t, err)
+		assert.Len(t, addrs, 3)
+		firsts = append(firsts, addrs[0])
+	}
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.1"}, firsts)
+
+	c.MarkFailed("10.0.0.2")
+	addrs, _ := c.Lookup(ctx, "ks3.test")
+	assert.Equal(t, []string{"10.0.0.3", "10.0.0.1", "10.0.0.2"}, addrs)
+	assert.Equal(t, 1, c.Stats().Blacklisted)
+
+	*now = now.Add(31 * time.Second)
+	assert.Equal(t, 0, c.Stats().Blacklisted)
+	addrs, _ = c.Lookup(ctx, "ks3.test")
+	assert.Equal(t, []string{"10.0.0.3", "10.0.0.1", "10.0.0.2"}, addrs)
+	addrs, _ = c.Lookup(ctx, "ks3.test")
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, addrs)
+}
+
+func TestDnsCacheStaticHostsDialFailover(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("cannot listen on loopback:", err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	port := strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+
+	// Nothing listens on 127.0.0.2, so dialing it is refused.
+	c := NewDnsCache(DnsCacheConfig{StaticHosts: map[string][]string{"ks3.test": {"127.0.0.2", "127.0.0.1"}}})
+	c.lookup = func(ctx context.Context, host string) ([]string, error) {
+		t.Fatalf("unexpected lookup of %s", host)
+		return nil, nil
+	}
+	dial := c.DialContext(&net.Dialer{Timeout: time.Second})
+
+	conn, err := dial(context.Background(), "tcp", net.JoinHostPort("ks3.test", port))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "127.0.0.1", conn.RemoteAddr().(*net.TCPAddr).IP.String())
+	conn.Close()
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(2), stats.Dials)
+	assert.Equal(t, uint64(1), stats.DialErrors)
+	assert.Equal(t, 1, stats.Blacklisted)
+}
+
+func TestDnsCacheEmptyStaticHosts(t *testing.T) {
+	c := NewDnsCache(DnsCacheConfig{StaticHosts: map[string][]string{"ks3.test": {}}})
+	c.lookup = func(ctx context.Context, host string) ([]string, error) {
+		return []string{"10.0.0.1"}, nil
+	}
+
+	addrs, err := c.Lookup(context.Background(), "ks3.test")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, addrs, "an empty static list falls back to DNS")
+}
+
+func TestServiceDnsCache(t *testing.T) {
+	shared := NewDnsCache(DnsCacheConfig{})
+	s1 := NewService(DefaultConfig.Merge(&Config{Endpoint: "https://ks3.example.com", Region: "test"}))
+	s2 := NewService(DefaultConfig.Merge(&Config{Endpoint: "https://ks3.example.com", Region: "test", DnsCache: shared}))
+	s3 := NewService(DefaultConfig.Merge(&Config{Endpoint: "https://ks3.example.com", Region: "test", DisableDnsCache: true}))
+
+	assert.NotNil(t, s1.Config.DnsCache)
+	assert.NotSame(t, shared, s1.Config.DnsCache)
+	assert.Same(t, shared, s2.Config.DnsCache)
+	assert.Nil(t, s3.Config.DnsCache)
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 )
 
+// DnsCacheTransport is a shared transport with the DNS cache. Services do not
+// use it anymore, each of them builds its own transport with NewTransport.
 var DnsCacheTransport = &http.Transport{
 	Proxy: http.ProxyFromEnvironment,
 	DialContext: DnsCacheTransportDialContext(&net.Dialer{
@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+)
+
+// Default values of the EndpointHealthConfig options.
+const (
+	DefaultEndpointFailureThreshold = 3
+	DefaultEndpointEjectionPeriod   = 30 * time.Second
+	DefaultEndpointProbeInterval    = 10 * time.Second
+	DefaultEndpointProbeTimeout     = 5 * time.Second
+)
+
+// EndpointHealthConfig 多Endpoint的健康检查配置，设置Config.Endpoints时生效
+type EndpointHealthConfig struct {
+	FailureThreshold int           // 连续失败（连接失败或返回5xx）次数达到该值后，Endpoint被暂时剔除，新请求优先使用其它Endpoint，默认值为3
+	EjectionPeriod   time.Duration // 剔除时间，到期后由后台探测恢复该Endpoint，默认值为30s
+	ProbeInterval    time.Duration // 后台探测的间隔，剔除时间到期后，每隔该时间向被剔除的Endpoint发送HEAD请求，返回非5xx的响应则恢复，默认值为10s。为负数时不在后台探测，剔除时间到期后由下一个请求重新探测该Endpoint
+	ProbeTimeout     time.Duration // 后台探测请求的超时时间，默认值为5s
+}
+
+// EndpointStatus is the health of one of the endpoints of Config.Endpoints.
+type EndpointStatus struct {
+	URL                 string
+	Healthy             bool
+	ConsecutiveFailures int
+	EjectedUntil        time.Time
+}
+
+// endpointPool tracks the health of the endpoints of a service. Endpoints
+// failing FailureThreshold times in a row are ejected for EjectionPeriod,
+// after which a HEAD request sent in the background every ProbeInterval
+// probes them, until one gets a response other than 5xx. The background
+// probe only runs while an endpoint is ejected, and stops with the service.
+//
+// Without background probes, or once the service is closed, a single
+// request probes an endpoint whose ejection is over, the next one after
+// another EjectionPeriod if the probe fails too.
+type endpointPool struct {
+	threshold     int
+	period        time.Duration
+	probeInterval time.Duration
+	probeTimeout  time.Duration
+	client        *http.Client
+	now           func() time.Time
+
+	mu        sync.Mutex
+	endpoints []*endpointState
+	probing   bool
+	closed    bool
+	stop      chan struct{}
+}
+
+type endpointState struct {
+	url          string
+	scheme       string
+	host         string
+	failures     int
+	ejectedUntil time.Time
+}
+
+func newEndpointPool(urls []string, cfg *EndpointHealthConfig, client *http.Client) (*endpointPool, error) {
+	var opts EndpointHealthConfig
+	if cfg != nil {
+		opts = *cfg
+	}
+	p := &endpointPool{
+		threshold:     intOrDefault(opts.FailureThreshold, DefaultEndpointFailureThreshold),
+		period:        durationOrDefault(opts.EjectionPeriod, DefaultEndpointEjectionPeriod),
+		probeInterval: durationOrDefault(opts.ProbeInterval, DefaultEndpointProbeInterval),
+		probeTimeout:  durationOrDefault(opts.ProbeTimeout, DefaultEndpointProbeTimeout),
+		client:        client,
+		now:           time.Now,
+		stop:          make(chan struct{}),
+	}
+	for _, raw := range urls {
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid endpoint %q", raw)
+		}
+		p.endpoints = append(p.endpoints, &endpointState{url: raw, scheme: u.Scheme, host: u.Host})
+	}
+	return p, nil
+}
+
+// backgroundProbes returns if ejected endpoints are probed in the
+// background. p.mu must be held.
+func (p *endpointPool) backgroundProbes() bool {
+	return p.probeInterval > 0 && p.client != nil && !p.closed
+}
+
+// pick returns the first available endpoint other than exclude, in the
+// order of Config.Endpoints. Without background probes, an ejected endpoint
+// whose ejection period is over is picked as a probe, and stays ejected for
+// the other requests until the probe ends. If no endpoint is available, the
+// next one after exclude is returned, so that requests are still attempted.
+func (p *endpointPool) pick(exclude int) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	for i, e := range p.endpoints {
+		if i == exclude || now.Before(e.ejectedUntil) {
+			continue
+		}
+		if !e.ejectedUntil.IsZero() && p.backgroundProbes() {
+			// Restored by the background probe only.
+			continue
+		}
+		if !e.ejectedUntil.IsZero() {
+			e.ejectedUntil = now.Add(p.period)
+		}
+		return i
+	}
+	return (exclude + 1) % len(p.endpoints)
+}
+
+func (p *endpointPool) success(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e := p.endpoints[i]
+	e.failures = 0
+	e.ejectedUntil = time.Time{}
+}
+
+func (p *endpointPool) failure(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e := p.endpoints[i]
+	e.failures++
+	if e.failures >= p.threshold {
+		e.ejectedUntil = p.now().Add(p.period)
+		if !p.probing && p.backgroundProbes() {
+			p.probing = true
+			go p.probeLoop()
+		}
+	}
+}
+
+// probeLoop probes the ejected endpoints every probeInterval, until none is
+// ejected or the pool is closed.
+func (p *endpointPool) probeLoop() {
+	ticker := time.NewTicker(p.probeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+		if !p.probeEjected() {
+			return
+		}
+	}
+}
+
+// probeEjected probes the endpoints whose ejection period is over, restoring
+// the ones which respond. It returns false, and ends the probing, once no
+// endpoint is ejected.
+func (p *endpointPool) probeEjected() bool {
+	p.mu.Lock()
+	now := p.now()
+	var due []int
+	ejected := false
+	for i, e := range p.endpoints {
+		if e.ejectedUntil.IsZero() {
+			continue
+		}
+		ejected = true
+		if !now.Before(e.ejectedUntil) {
+			due = append(due, i)
+		}
+	}
+	if !ejected {
+		p.probing = false
+	}
+	p.mu.Unlock()
+
+	for _, i := range due {
+		if p.probe(p.endpoints[i].url) {
+			p.success(i)
+		}
+	}
+	return ejected
+}
+
+// probe sends a HEAD request to the endpoint, and returns if it responded
+// with a status other than 5xx.
+func (p *endpointPool) probe(endpoint string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), p.probeTimeout)
+	defer cancel()
+	req, err := http.NewRequest("HEAD", endpoint+"/", nil)
+	if err != nil {
+		return false
+	}
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return resp.StatusCode < 500
+}
+
+// close stops the background probe.
+func (p *endpointPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.stop)
+	}
+}
+
+func (p *endpointPool) status() []EndpointStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	status := make([]EndpointStatus, len(p.endpoints))
+	for i, e := range p.endpoints {
+		healthy := !now.Before(e.ejectedUntil)
+		if p.backgroundProbes() {
+			healthy = e.ejectedUntil.IsZero()
+		}
+		status[i] = EndpointStatus{
+			URL:                 e.url,
+			Healthy:             healthy,
+			ConsecutiveFailures: e.failures,
+			EjectedUntil:        e.ejectedUntil,
+		}
+	}
+	return status
+}
+
+// buildEndpointPool sets up the endpoints of Config.Endpoints.
+func (s *Service) buildEndpointPool() {
+	urls := make([]string, len(s.Config.Endpoints))
+	for i, endpoint := range s.Config.Endpoints {
+		urls[i] = s.addScheme(endpoint)
+	}
+	pool, err := newEndpointPool(urls, s.Config.EndpointHealth, s.Config.HTTPClient)
+	if err != nil {
+		s.endpointErr = apierr.New("InvalidEndpointConfig", "invalid Endpoints configuration", err)
+		return
+	}
+	s.endpoints = pool
+	s.Endpoint = urls[0]
+}
+
+// EndpointStatus returns the health of the endpoints of Config.Endpoints, in
+// their order, or nil if Config.Endpoints is not set.
+func (s *Service) EndpointStatus() []EndpointStatus {
+	if s.endpoints == nil {
+		return nil
+	}
+	return s.endpoints.status()
+}
+
+// Close stops the background probe of the endpoints of Config.Endpoints.
+// Requests can still be sent once the service is closed, ejected endpoints
+// are then probed by the requests themselves.
+func (s *Service) Close() {
+	if s.endpoints != nil {
+		s.endpoints.close()
+	}
+}
+
+// reportEndpointHealth records the outcome of the attempt for the health of
+// its endpoint.
+func (r *Request) reportEndpointHealth() {
+	r.endpointFailed = false
+	pool := r.Service.endpoints
+	if pool == nil || r.Context().Err() != nil {
+		// Canceled operations say nothing about the endpoint.
+		return
+	}
+
+	r.endpointFailed = r.Error != nil || (r.HTTPResponse != nil && r.HTTPResponse.StatusCode >= 500)
+	if r.endpointFailed {
+		pool.failure(r.endpointIndex)
+	} else {
+		pool.success(r.endpointIndex)
+	}
+}
+
+// failoverEndpoint moves a request about to be retried to the next available
+// endpoint if its endpoint failed, and returns if it did.
+func (r *Request) failoverEndpoint() bool {
+	if !r.endpointFailed {
+		return false
+	}
+	r.endpointFailed = false
+	next := r.Service.endpoints.pick(r.endpointIndex)
+	if next == r.endpointIndex {
+		return false
+	}
+	r.failovers++
+	r.switchEndpoint(next)
+	return true
+}
+
+// failoverWithoutRetry moves a request which failed on its endpoint, and is
+// not retried, to another available endpoint, so that clients which do not
+// retry fail over too. It returns if the request is to be sent again. A
+// request fails over at most once per other endpoint.
+func (r *Request) failoverWithoutRetry() bool {
+	if !r.endpointFailed || !r.bodyReplayable() || r.failovers >= len(r.Service.endpoints.endpoints)-1 {
+		return false
+	}
+	return r.failoverEndpoint()
+}
+
+// switchEndpoint moves the request to another endpoint of the pool, keeping
+// the bucket prepended to the host by virtual hosted style requests, and
+// drops its signature so that it is signed again for the new host.
+func (r *Request) switchEndpoint(next int) {
+	from := r.Service.endpoints.endpoints[r.endpointIndex]
+	to := r.Service.endpoints.endpoints[next]
+
+	u := r.HTTPRequest.URL
+	if u.Host == from.host {
+		u.Host = to.host
+	} else if strings.HasSuffix(u.Host, "."+from.host) {
+		u.Host = strings.TrimSuffix(u.Host, from.host) + to.host
+	}
+	u.Scheme = to.scheme
+	r.HTTPRequest.Host = ""
+	r.HTTPRequest.Header.Del("Authorization")
+	r.endpointIndex = next
+	r.endpoint = to.url
+
+	r.log(Warn, fmt.Sprintf("Endpoint %s failed, switching to %s.", from.url, to.url))
+}
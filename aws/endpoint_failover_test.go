@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFailoverTestService(cfg *Config, signedHosts *[]string) *Service {
+	cfg.Region = "mock-region"
+	cfg.RetryRule = retry.DefaultNoDelayRetryRule
+	cfg.ShouldRetry = retry.ShouldRetry
+	s := NewService(cfg)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		if r.HTTPRequest.Header.Get("Authorization") == "" {
+			*signedHosts = append(*signedHosts, r.HTTPRequest.URL.Host)
+			r.HTTPRequest.Header.Set("Authorization", "signed for "+r.HTTPRequest.URL.Host)
+		}
+	})
+	return s
+}
+
+func TestEndpointFailover5xx(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer bad.Close()
+	var authorization string
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer good.Close()
+
+	var signed []string
+	s := newFailoverTestService(&Config{Endpoints: []string{bad.URL, good.URL}, MaxRetries: 2}, &signed)
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+	err := r.Send()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, []string{bad.Listener.Addr().String(), good.Listener.Addr().String()}, signed)
+	assert.Equal(t, "signed for "+good.Listener.Addr().String(), authorization)
+
+	status := s.EndpointStatus()
+	assert.Equal(t, 1, status[0].ConsecutiveFailures)
+	assert.True(t, status[0].Healthy, "a single failure is below the threshold")
+	assert.True(t, status[1].Healthy)
+}
+
+func TestEndpointFailoverConnectionError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("cannot listen on loopback:", err)
+	}
+	closed := "http://" + l.Addr().String()
+	l.Close()
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer good.Close()
+
+	var signed []string
+	s := newFailoverTestService(&Config{
+		Endpoints:      []string{closed, good.URL},
+		EndpointHealth: &EndpointHealthConfig{FailureThreshold: 1},
+		MaxRetries:     1,
+	}, &signed)
+	defer s.Close()
+	op := &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}
+	assert.NoError(t, NewRequest(s, op, nil, nil).Send())
+	assert.False(t, s.EndpointStatus()[0].Healthy)
+
+	// The ejected endpoint is skipped by new requests.
+	signed = nil
+	r := NewRequest(s, op, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 0, int(r.RetryCount))
+	assert.Equal(t, []string{good.Listener.Addr().String()}, signed)
+}
+
+func TestEndpointFailoverWithoutRetries(t *testing.T) {
+	attempts := 0
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+	var body string
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer good.Close()
+
+	// Requests which are not retried fail over too.
+	var signed []string
+	s := newFailoverTestService(&Config{Endpoints: []string{bad.URL, good.URL}, MaxRetries: -1}, &signed)
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "PUT", HTTPPath: "/"}, nil, nil)
+	r.SetStringBody("payload")
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 0, int(r.RetryCount))
+	assert.Equal(t, good.Listener.Addr().String(), r.HTTPRequest.URL.Host)
+	assert.Equal(t, "payload", body)
+
+	// Each of the other endpoints is tried once.
+	s = newFailoverTestService(&Config{Endpoints: []string{bad.URL, bad.URL + "/"}, MaxRetries: 0}, &signed)
+	attempts = 0
+	assert.Error(t, NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil).Send())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestEndpointPoolRequestProbe(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	p, err := newEndpointPool([]string{"https://a.example.com", "https://b.example.com"},
+		&EndpointHealthConfig{FailureThreshold: 2, EjectionPeriod: 10 * time.Second, ProbeInterval: -1}, http.DefaultClient)
+	assert.NoError(t, err)
+	p.now = func() time.Time { return now }
+
+	p.failure(0)
+	assert.Equal(t, 0, p.pick(-1))
+	p.failure(0)
+	assert.Equal(t, 1, p.pick(-1))
+
+	// Once the ejection is over, a single request probes the endpoint.
+	now = now.Add(11 * time.Second)
+	assert.Equal(t, 0, p.pick(-1))
+	assert.Equal(t, 1, p.pick(-1))
+	p.failure(0)
+	now = now.Add(5 * time.Second)
+	assert.Equal(t, 1, p.pick(-1))
+
+	now = now.Add(6 * time.Second)
+	assert.Equal(t, 0, p.pick(-1))
+	p.success(0)
+	assert.Equal(t, 0, p.pick(-1))
+	assert.Equal(t, 0, p.status()[0].ConsecutiveFailures)
+
+	// With every endpoint ejected, requests are still attempted.
+	p.failure(0)
+	p.failure(0)
+	p.failure(1)
+	p.failure(1)
+	assert.Equal(t, 0, p.pick(-1))
+	assert.Equal(t, 1, p.pick(0))
+}
+
+func TestEndpointBackgroundProbe(t *testing.T) {
+	var mu sync.Mutex
+	down := true
+	var probes, requests int
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == "HEAD" {
+			probes++
+		} else {
+			requests++
+		}
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer flaky.Close()
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer good.Close()
+
+	var signed []string
+	s := newFailoverTestService(&Config{
+		Endpoints: []string{flaky.URL, good.URL},
+		EndpointHealth: &EndpointHealthConfig{
+			FailureThreshold: 1,
+			EjectionPeriod:   time.Millisecond,
+			ProbeInterval:    10 * time.Millisecond,
+		},
+		MaxRetries: 1,
+	}, &signed)
+	defer s.Close()
+	op := &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}
+	assert.NoError(t, NewRequest(s, op, nil, nil).Send())
+	assert.False(t, s.EndpointStatus()[0].Healthy)
+
+	// Once the ejection is over, requests still avoid the endpoint, which
+	// is probed in the background.
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, NewRequest(s, op, nil, nil).Send())
+	assert.False(t, s.EndpointStatus()[0].Healthy)
+	mu.Lock()
+	assert.Equal(t, 1, requests)
+	assert.True(t, probes > 0)
+	down = false
+	mu.Unlock()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !s.EndpointStatus()[0].Healthy && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, s.EndpointStatus()[0].Healthy, "restored by the probe")
+	signed = nil
+	assert.NoError(t, NewRequest(s, op, nil, nil).Send())
+	assert.Equal(t, []string{flaky.Listener.Addr().String()}, signed)
+}
+
+func TestSwitchEndpointKeepsBucketHost(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", Endpoints: []string{"a.example.com", "http://b.example.com:8080"}})
+	assert.Equal(t, "https://a.example.com", s.Endpoint)
+
+	r := NewRequest(s, &Operation{Name: "GetObject", HTTPMethod: "GET", HTTPPath: "/key"}, nil, nil)
+	r.HTTPRequest.URL.Host = "bucket." + r.HTTPRequest.URL.Host
+	r.HTTPRequest.Header.Set("Authorization", "signature")
+	r.switchEndpoint(1)
+
+	assert.Equal(t, "http://bucket.b.example.com:8080/key", r.HTTPRequest.URL.String())
+	assert.Equal(t, "http://b.example.com:8080", r.EndpointURL())
+	assert.Empty(t, r.HTTPRequest.Header.Get("Authorization"))
+
+	r.switchEndpoint(0)
+	assert.Equal(t, "https://bucket.a.example.com/key", r.HTTPRequest.URL.String())
+}
+
+func TestInvalidEndpoints(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", Endpoints: []string{"https://a.example.com", "http://"}})
+	err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Build()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "InvalidEndpointConfig")
+	}
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/endpoints"
+)
+
+// An Endpoint is the address requests of a service are sent to.
+type Endpoint struct {
+	// URL is the endpoint, with or without a scheme. Without one, https is
+	// used unless Config.DisableSSL is set.
+	URL string
+
+	// SigningRegion is the region requests are signed for. Config.Region is
+	// used if empty. The signing region of the endpoints resolved for a
+	// bucket is ignored, requests are signed for the region of the client.
+	SigningRegion string
+}
+
+// EndpointOptions are the options an endpoint is resolved with.
+type EndpointOptions struct {
+	// Bucket is the bucket of the request, or empty when resolving the
+	// endpoint of the client.
+	Bucket string
+
+	UseInternalEndpoint   bool
+	UseAccelerateEndpoint bool
+}
+
+// An EndpointResolver resolves the endpoint of a service in a region.
+//
+// Config.EndpointResolver is called once with an empty Bucket when the
+// client is created, then for each request having a bucket, so that the
+// endpoint of some buckets can differ from the one of the client. Resolvers
+// which only override some endpoints can fall back to
+// DefaultEndpointResolver.
+type EndpointResolver interface {
+	ResolveEndpoint(service, region string, opts EndpointOptions) (Endpoint, error)
+}
+
+// EndpointResolverFunc is a function usable as an EndpointResolver.
+type EndpointResolverFunc func(service, region string, opts EndpointOptions) (Endpoint, error)
+
+// ResolveEndpoint calls fn.
+func (fn EndpointResolverFunc) ResolveEndpoint(service, region string, opts EndpointOptions) (Endpoint, error) {
+	return fn(service, region, opts)
+}
+
+// DefaultEndpointResolver resolves the endpoints of KS3 from the built-in
+// table of its regions. The region can be given by its identifier, such as
+// cn-beijing, or by its legacy name, such as BEIJING. See KS3Regions for the
+// regions of the table.
+var DefaultEndpointResolver EndpointResolver = defaultEndpointResolver{}
+
+type defaultEndpointResolver struct{}
+
+func (defaultEndpointResolver) ResolveEndpoint(service, region string, opts EndpointOptions) (Endpoint, error) {
+	if service != "s3" {
+		url, signingRegion := endpoints.EndpointForRegion(service, region)
+		return Endpoint{URL: url, SigningRegion: signingRegion}, nil
+	}
+
+	if opts.UseInternalEndpoint && opts.UseAccelerateEndpoint {
+		return Endpoint{}, apierr.New("InvalidEndpointConfig",
+			"UseInternalEndpoint and UseAccelerateEndpoint cannot be used together", nil)
+	}
+	url, ok := endpoints.KS3Endpoint(region, opts.UseInternalEndpoint, opts.UseAccelerateEndpoint)
+	if !ok {
+		variant := "public"
+		if opts.UseInternalEndpoint {
+			variant = "internal"
+		} else if opts.UseAccelerateEndpoint {
+			variant = "accelerate"
+		}
+		return Endpoint{}, apierr.New("UnknownEndpoint",
+			fmt.Sprintf("no KS3 %s endpoint is known for region %q, set Endpoint", variant, region), nil)
+	}
+	return Endpoint{URL: url}, nil
+}
+
+// KS3Regions returns the identifiers of the regions known to
+// DefaultEndpointResolver.
+func KS3Regions() []string {
+	return endpoints.KS3Regions()
+}
+
+// EndpointURL returns the endpoint the request is sent to. It is the one of
+// the service, unless the request was given the endpoint of its bucket by
+// Config.EndpointResolver, or was moved to another endpoint of
+// Config.Endpoints. The host of virtual hosted style requests is the one of
+// the endpoint prefixed with the bucket.
+func (r *Request) EndpointURL() string {
+	if r.endpoint == "" {
+		return r.Service.Endpoint
+	}
+	return r.endpoint
+}
+
+// resolveEndpoint resolves the endpoint of the service, or of bucket if not
+// empty, adding the scheme to its URL if missing.
+func (s *Service) resolveEndpoint(bucket string) (Endpoint, error) {
+	resolver := s.Config.EndpointResolver
+	if resolver == nil {
+		resolver = DefaultEndpointResolver
+	}
+	e, err := resolver.ResolveEndpoint(s.ServiceName, s.Config.Region, EndpointOptions{
+		Bucket:                bucket,
+		UseInternalEndpoint:   s.Config.UseInternalEndpoint,
+		UseAccelerateEndpoint: s.Config.UseAccelerateEndpoint,
+	})
+	if err != nil {
+		if _, ok := err.(awserr.Error); !ok {
+			err = apierr.New("EndpointResolutionFailed", "failed to resolve endpoint", err)
+		}
+		return Endpoint{}, err
+	}
+	e.URL = s.addScheme(e.URL)
+	return e, nil
+}
+
+// addScheme adds the scheme selected by Config.DisableSSL to endpoint if it
+// has none.
+func (s *Service) addScheme(endpoint string) string {
+	if endpoint == "" || schemeRE.MatchString(endpoint) {
+		return endpoint
+	}
+	scheme := "https"
+	if s.Config.DisableSSL {
+		scheme = "http"
+	}
+	return scheme + "://" + endpoint
+}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEndpointTestService(cfg *Config) *Service {
+	s := &Service{Config: DefaultConfig.Merge(cfg), ServiceName: "s3"}
+	s.Initialize()
+	return s
+}
+
+func TestDefaultEndpointResolver(t *testing.T) {
+	s := newEndpointTestService(&Config{Region: "cn-beijing"})
+	assert.Equal(t, "https://ks3-cn-beijing.ksyuncs.com", s.Endpoint)
+
+	s = newEndpointTestService(&Config{Region: "SHANGHAI", UseInternalEndpoint: true, DisableSSL: true})
+	assert.Equal(t, "http://ks3-cn-shanghai-internal.ksyuncs.com", s.Endpoint)
+
+	s = newEndpointTestService(&Config{Region: "cn-guangzhou", UseAccelerateEndpoint: true})
+	assert.Equal(t, "https://ks3-cn-accelerate.ksyuncs.com", s.Endpoint)
+
+	// An explicit Endpoint wins over the table.
+	s = newEndpointTestService(&Config{Region: "cn-beijing", Endpoint: "ks3.example.com"})
+	assert.Equal(t, "https://ks3.example.com", s.Endpoint)
+}
+
+func TestDefaultEndpointResolverErrors(t *testing.T) {
+	op := &Operation{Name: "GetObject", HTTPMethod: "GET", HTTPPath: "/{Bucket}"}
+
+	s := newEndpointTestService(&Config{Region: "mars-1"})
+	err := NewRequest(s, op, nil, nil).Build()
+	assert.Equal(t, "UnknownEndpoint", err.(awserr.Error).Code())
+
+	s = newEndpointTestService(&Config{Region: "ap-singapore-1", UseAccelerateEndpoint: true})
+	err = NewRequest(s, op, nil, nil).Build()
+	assert.Equal(t, "UnknownEndpoint", err.(awserr.Error).Code())
+
+	s = newEndpointTestService(&Config{Region: "cn-beijing", UseInternalEndpoint: true, UseAccelerateEndpoint: true})
+	err = NewRequest(s, op, nil, nil).Build()
+	assert.Equal(t, "InvalidEndpointConfig", err.(awserr.Error).Code())
+
+	s = newEndpointTestService(&Config{})
+	err = NewRequest(s, op, nil, nil).Build()
+	assert.Equal(t, ErrMissingRegion, err)
+}
+
+func TestBucketEndpointResolver(t *testing.T) {
+	var calls []EndpointOptions
+	resolver := EndpointResolverFunc(func(service, region string, opts EndpointOptions) (Endpoint, error) {
+		calls = append(calls, opts)
+		switch opts.Bucket {
+		case "archive":
+			return Endpoint{URL: "http://archive.example.com"}, nil
+		case "broken":
+			return Endpoint{}, errors.New("no endpoint")
+		}
+		return DefaultEndpointResolver.ResolveEndpoint(service, region, opts)
+	})
+	s := newEndpointTestService(&Config{Region: "cn-beijing", UseInternalEndpoint: true, EndpointResolver: resolver})
+	assert.Equal(t, "https://ks3-cn-beijing-internal.ksyuncs.com", s.Endpoint)
+	assert.Equal(t, []EndpointOptions{{UseInternalEndpoint: true}}, calls)
+
+	op := &Operation{Name: "GetObject", HTTPMethod: "GET", HTTPPath: "/{Bucket}"}
+	r := NewRequest(s, op, &logTestInput{Bucket: String("archive")}, nil)
+	assert.Equal(t, "archive.example.com", r.HTTPRequest.URL.Host)
+	assert.Equal(t, "http://archive.example.com", r.EndpointURL())
+	assert.Equal(t, "http", r.HTTPRequest.URL.Scheme)
+
+	r = NewRequest(s, op, &logTestInput{Bucket: String("photos")}, nil)
+	assert.Equal(t, "ks3-cn-beijing-internal.ksyuncs.com", r.HTTPRequest.URL.Host)
+	assert.Equal(t, "photos", calls[len(calls)-1].Bucket)
+
+	r = NewRequest(s, op, &logTestInput{Bucket: String("broken")}, nil)
+	err := r.Build()
+	if assert.Error(t, err) {
+		assert.Equal(t, "EndpointResolutionFailed", err.(awserr.Error).Code())
+		assert.Equal(t, "no endpoint", err.(awserr.Error).OrigErr().Error())
+	}
+}
@@ -16,9 +16,26 @@ import (
 	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 )
 
-var sleepDelay = func(delay time.Duration) {
-	time.Sleep(delay)
-}
+// sleepDelay waits out the retry backoff, returning early if the context is
+// canceled or reaches its deadline. Stub-able for unit tests.
+var sleepDelay = sleepWithContext
+
+// Named versions of the core handlers, as they are registered by
+// Service.Initialize. Their names can be used to position custom handlers
+// with HandlerList.InsertBefore and HandlerList.InsertAfter, or to replace
+// and remove them.
+var (
+	ValidateEndpointHandlerNamed  = NamedHandler{Name: "core.ValidateEndpointHandler", Fn: ValidateEndpointHandler}
+	ValidateTransportHandlerNamed = NamedHandler{Name: "core.ValidateTransportHandler", Fn: ValidateTransportHandler}
+	ValidateParametersNamed       = NamedHandler{Name: "core.ValidateParameters", Fn: ValidateParameters}
+	UserAgentHandlerNamed         = NamedHandler{Name: "core.UserAgentHandler", Fn: UserAgentHandler}
+	BuildContentLengthNamed       = NamedHandler{Name: "core.BuildContentLength", Fn: BuildContentLength}
+	ClientRateLimitHandlerNamed   = NamedHandler{Name: "core.ClientRateLimitHandler", Fn: ClientRateLimitHandler}
+	SendHandlerNamed              = NamedHandler{Name: "core.SendHandler", Fn: SendHandler}
+	AfterRetryHandlerNamed        = NamedHandler{Name: "core.AfterRetryHandler", Fn: AfterRetryHandler}
+	ValidateResponseHandlerNamed  = NamedHandler{Name: "core.ValidateResponseHandler", Fn: ValidateResponseHandler}
+	RetrySuccessHandlerNamed      = NamedHandler{Name: "core.RetrySuccessHandler", Fn: RetrySuccessHandler}
+)
 
 // Interface for matching types which also have a Len method.
 type lener interface {
@@ -26,8 +43,9 @@ type lener interface {
 }
 
 // BuildContentLength builds the content length of a request based on the body,
-// or will use the HTTPRequest.Header's "Content-Length" if defined. If unable
-// to determine request body length and no "Content-Length" was specified it will panic.
+// or will use the HTTPRequest.Header's "Content-Length" if defined. The length
+// of bodies which cannot seek is unknown if no "Content-Length" was specified,
+// they are sent with chunked transfer encoding.
 func BuildContentLength(r *Request) {
 	if slength := r.HTTPRequest.Header.Get("Content-Length"); slength != "" {
 		length, _ := strconv.ParseInt(slength, 10, 64)
@@ -42,12 +60,14 @@ func BuildContentLength(r *Request) {
 	case lener:
 		length = int64(body.Len())
 	case io.Seeker:
+		if !seekable(body) {
+			r.HTTPRequest.ContentLength = -1
+			return
+		}
 		r.bodyStart, _ = body.Seek(0, 1)
 		end, _ := body.Seek(0, 2)
 		body.Seek(r.bodyStart, 0) // make sure to seek back to original location
 		length = end - r.bodyStart
-	default:
-		panic("Cannot get length of body, must provide `ContentLength`")
 	}
 
 	r.HTTPRequest.ContentLength = length
@@ -66,11 +86,31 @@ func ContentTypeHandler(r *Request) {
 
 var reStatusCode = regexp.MustCompile(`^(\d+)`)
 
+// ClientRateLimitHandler is a request handler which waits for the service's
+// client-side rate limiter to allow the attempt to be sent. It has no effect
+// unless the adaptive retry mode is used.
+func ClientRateLimitHandler(r *Request) {
+	if r.Service.RateLimiter == nil {
+		return
+	}
+
+	if err := r.Service.RateLimiter.Acquire(r.Context()); err != nil {
+		r.Error = r.contextError()
+		if r.Error == nil {
+			r.Error = apierr.New(ErrCodeRequestCanceled, "request context canceled", err)
+		}
+	}
+}
+
 // SendHandler is a request handler to send service request using HTTP client.
 func SendHandler(r *Request) {
+	if r.Error != nil {
+		// A previous send handler already failed the attempt.
+		return
+	}
 
 	var err error
-	if r.HTTPRequest.ContentLength <= 0 {
+	if r.HTTPRequest.ContentLength == 0 {
 		r.HTTPRequest.Body = http.NoBody
 	}
 	r.HTTPResponse, err = r.Service.Config.HTTPClient.Do(r.HTTPRequest)
@@ -94,6 +134,7 @@ func SendHandler(r *Request) {
 		r.Error = apierr.New("RequestError", "send request failed", err)
 		r.Retryable.Set(true) // network errors are retryable
 	}
+	r.reportEndpointHealth()
 }
 
 // ValidateResponseHandler is a request handler to validate service response.
@@ -113,16 +154,56 @@ func AfterRetryHandler(r *Request) {
 		r.Retryable.Set(r.Service.ShouldRetry(r.Error))
 	}
 
+	if r.Service.RateLimiter != nil {
+		r.Service.RateLimiter.Update(retry.IsErrorThrottle(r.Error))
+	}
+
 	if r.WillRetry() {
+		if !r.bodyReplayable() {
+			r.Error = errBodyNotReplayable(r.Error)
+			r.Retryable.Set(false)
+			return
+		}
+		if r.Service.RetryQuota != nil {
+			cost := retry.RetryCost
+			if retry.IsErrorTimeout(r.Error) {
+				cost = retry.RetryTimeoutCost
+			}
+			if !r.Service.RetryQuota.GetToken(cost) {
+				r.log(Warn, "Retry quota exhausted, will not retry.")
+				r.Retryable.Set(false)
+				return
+			}
+			r.retryCost = cost
+		}
+
+		r.failoverEndpoint()
 		r.RetryCount++
 		delay := r.Service.RetryRule.GetDelay(int(r.RetryCount))
 		if delay < 0 {
 			delay = 0
 		}
+		// Honor the delay requested by the service, up to the maximum
+		// single retry delay.
+		if retryAfter, ok := retry.RetryAfter(r.HTTPResponse, time.Now()); ok && retryAfter > delay {
+			delay = retryAfter
+			if delay > retry.DefaultMaxDelay {
+				delay = retry.DefaultMaxDelay
+			}
+		}
 		r.RetryDelay = delay
 
-		r.Config.LogWarn("Tried %d times, will retry in %d ms.", r.RetryCount, r.RetryDelay.Milliseconds())
-		sleepDelay(r.RetryDelay)
+		r.log(Warn, fmt.Sprintf("Tried %d times, will retry in %d ms.", r.RetryCount, r.RetryDelay.Milliseconds()))
+		if err := sleepDelay(r.Context(), r.RetryDelay); err != nil {
+			// The context or the operation budget ran out while backing off,
+			// there is no point in retrying.
+			r.Error = r.contextError()
+			if r.Error == nil {
+				r.Error = apierr.New(ErrCodeRequestCanceled, "request context canceled", err)
+			}
+			r.Retryable.Set(false)
+			return
+		}
 
 		// when the expired token exception occurs the credentials
 		// need to be expired locally so that the next request to
@@ -135,10 +216,37 @@ func AfterRetryHandler(r *Request) {
 			}
 		}
 
+		r.Error = nil
+	} else if r.Error != nil && r.failoverWithoutRetry() {
+		r.log(Warn, "Sending again to another endpoint.")
+		r.Retryable.Set(true)
 		r.Error = nil
 	}
 }
 
+// RetrySuccessHandler is a request handler which reports a successful
+// response to the service's retry quota and client-side rate limiter. The
+// tokens spent on the last retry are returned to the quota, or a single
+// token if the request succeeded without retrying.
+func RetrySuccessHandler(r *Request) {
+	if r.Error != nil {
+		return
+	}
+
+	if r.Service.RateLimiter != nil {
+		r.Service.RateLimiter.Update(false)
+	}
+
+	if r.Service.RetryQuota != nil {
+		if r.retryCost > 0 {
+			r.Service.RetryQuota.ReleaseToken(r.retryCost)
+			r.retryCost = 0
+		} else {
+			r.Service.RetryQuota.ReleaseToken(retry.NoRetryIncrement)
+		}
+	}
+}
+
 var (
 	// ErrMissingRegion is an error that is returned if region configuration is
 	// not found.
@@ -151,11 +259,23 @@ var (
 
 // ValidateEndpointHandler is a request handler to validate a request had the
 // appropriate Region and Endpoint set. Will set r.Error if the endpoint or
-// region is not valid.
+// region is not valid, or if the endpoint could not be resolved.
 func ValidateEndpointHandler(r *Request) {
-	if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
+	if r.bucketEndpointErr != nil {
+		r.Error = r.bucketEndpointErr
+	} else if r.Service.endpointErr != nil {
+		r.Error = r.Service.endpointErr
+	} else if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
 		r.Error = ErrMissingRegion
 	} else if r.Service.Endpoint == "" {
 		r.Error = ErrMissingEndpoint
 	}
 }
+
+// ValidateTransportHandler is a request handler to validate that the HTTP
+// client of the service could be built from Config.Transport.
+func ValidateTransportHandler(r *Request) {
+	if r.Error == nil && r.Service.transportErr != nil {
+		r.Error = r.Service.transportErr
+	}
+}
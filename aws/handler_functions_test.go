@@ -4,8 +4,10 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
 	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 	"github.com/stretchr/testify/assert"
 )
@@ -79,3 +81,103 @@ func TestAfterRetryRefreshCreds(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, credProvider.retreiveCalled)
 }
+
+func newRetryModeTestService(cfg *Config, resps []*http.Response) *Service {
+	cfg.ShouldRetry = retry.ShouldRetry
+	cfg.RetryRule = retry.DefaultNoDelayRetryRule
+	svc := NewService(cfg)
+	svc.Handlers.Validate.Clear()
+	svc.Handlers.UnmarshalError.PushBack(unmarshalError)
+
+	reqNum := 0
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = resps[reqNum]
+		if reqNum < len(resps)-1 {
+			reqNum++
+		}
+	})
+	return svc
+}
+
+func TestRetryQuotaExhausted(t *testing.T) {
+	quota := retry.NewRetryQuota(retry.RetryCost)
+	svc := newRetryModeTestService(&Config{MaxRetries: 5, RetryMode: retry.ModeStandard, RetryQuota: quota},
+		[]*http.Response{
+			{StatusCode: 500, Body: body(`{"__type":"InternalError","message":"An error occurred."}`)},
+		})
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Send()
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, int(req.RetryCount), "expect a single retry paid by the quota")
+	assert.Equal(t, 0, quota.Available())
+}
+
+func TestRetryQuotaRefundedOnSuccess(t *testing.T) {
+	quota := retry.NewRetryQuota(100)
+	svc := newRetryModeTestService(&Config{MaxRetries: 5, RetryMode: retry.ModeStandard, RetryQuota: quota},
+		[]*http.Response{
+			{StatusCode: 500, Body: body(`{"__type":"InternalError","message":"An error occurred."}`)},
+			{StatusCode: 200, Body: body(`{}`)},
+		})
+	assert.True(t, quota.GetToken(50))
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Send()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, int(req.RetryCount))
+	assert.Equal(t, 50, quota.Available())
+}
+
+func TestRetryAfterHonored(t *testing.T) {
+	var delays []time.Duration
+	defer func() { sleepDelay = sleepWithContext }()
+	sleepDelay = func(ctx Context, delay time.Duration) error {
+		delays = append(delays, delay)
+		return nil
+	}
+
+	svc := newRetryModeTestService(&Config{MaxRetries: 5},
+		[]*http.Response{
+			{StatusCode: 503, Header: http.Header{"Retry-After": []string{"3"}}, Body: body(`{"__type":"SlowDown","message":"Reduce your request rate."}`)},
+			{StatusCode: 200, Body: body(`{}`)},
+		})
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Send()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{3 * time.Second}, delays)
+}
+
+func TestAdaptiveRetryModeThrottle(t *testing.T) {
+	svc := newRetryModeTestService(&Config{MaxRetries: 5, RetryMode: retry.ModeAdaptive},
+		[]*http.Response{
+			{StatusCode: 429, Body: body(`{"__type":"TooManyRequests","message":"Rate exceeded."}`)},
+			{StatusCode: 200, Body: body(`{}`)},
+		})
+	assert.False(t, svc.RateLimiter.Enabled())
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Send()
+
+	assert.NoError(t, err)
+	assert.True(t, svc.RateLimiter.Enabled(), "expect throttling to enable the rate limiter")
+}
+
+func TestRetryModeDefaultJitter(t *testing.T) {
+	for _, mode := range []retry.Mode{retry.ModeStandard, retry.ModeAdaptive} {
+		svc := NewService(DefaultConfig.Merge(&Config{RetryMode: mode}))
+		assert.Equal(t, retry.DefaultExponentialJitterRetryRule, svc.RetryRule, "mode %s", mode)
+	}
+
+	svc := NewService(DefaultConfig.Merge(&Config{}))
+	assert.Equal(t, retry.DefaultExponentialRetryRule, svc.RetryRule, "the legacy mode keeps its rule")
+
+	rule := retry.NewFixedRetryRule(time.Second)
+	svc = NewService(DefaultConfig.Merge(&Config{RetryMode: retry.ModeStandard, RetryRule: rule}))
+	assert.Equal(t, rule, svc.RetryRule)
+}
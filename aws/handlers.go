@@ -48,21 +48,32 @@ func (h *Handlers) Clear() {
 	h.CheckCrc64.Clear()
 }
 
+// A NamedHandler is a request handler with a name, so it can be found,
+// replaced or removed in a HandlerList, and new handlers can be inserted
+// relative to it.
+type NamedHandler struct {
+	Name string
+	Fn   func(*Request)
+}
+
 // A HandlerList manages zero or more handlers in a list.
+//
+// Handlers pushed with PushBack or PushFront have no name. The built-in
+// handlers of the SDK all have stable names, see HandlerList.Names.
 type HandlerList struct {
-	list []func(*Request)
+	list []NamedHandler
 }
 
 // copy creates a copy of the handler list.
 func (l *HandlerList) copy() HandlerList {
 	var n HandlerList
-	n.list = append([]func(*Request){}, l.list...)
+	n.list = append([]NamedHandler{}, l.list...)
 	return n
 }
 
 // Clear clears the handler list.
 func (l *HandlerList) Clear() {
-	l.list = []func(*Request){}
+	l.list = []NamedHandler{}
 }
 
 // Len returns the number of handlers in the list.
@@ -72,17 +83,114 @@ func (l *HandlerList) Len() int {
 
 // PushBack pushes handlers f to the back of the handler list.
 func (l *HandlerList) PushBack(f ...func(*Request)) {
-	l.list = append(l.list, f...)
+	l.PushBackNamed(unnamed(f)...)
 }
 
 // PushFront pushes handlers f to the front of the handler list.
 func (l *HandlerList) PushFront(f ...func(*Request)) {
-	l.list = append(f, l.list...)
+	l.PushFrontNamed(unnamed(f)...)
+}
+
+// PushBackNamed pushes named handlers n to the back of the handler list.
+func (l *HandlerList) PushBackNamed(n ...NamedHandler) {
+	l.list = append(l.list, n...)
+}
+
+// PushFrontNamed pushes named handlers n to the front of the handler list.
+func (l *HandlerList) PushFrontNamed(n ...NamedHandler) {
+	l.list = append(append([]NamedHandler{}, n...), l.list...)
+}
+
+// InsertBefore inserts the named handler n before the first handler named
+// name. False is returned, and the list is left unchanged, if there is no
+// handler with that name.
+func (l *HandlerList) InsertBefore(name string, n NamedHandler) bool {
+	i := l.index(name)
+	if i < 0 {
+		return false
+	}
+	l.insert(i, n)
+	return true
+}
+
+// InsertAfter inserts the named handler n after the first handler named
+// name. False is returned, and the list is left unchanged, if there is no
+// handler with that name.
+func (l *HandlerList) InsertAfter(name string, n NamedHandler) bool {
+	i := l.index(name)
+	if i < 0 {
+		return false
+	}
+	l.insert(i+1, n)
+	return true
+}
+
+// Swap replaces every handler named name with the named handler n, keeping
+// its position in the list. Returns if any handler was replaced.
+func (l *HandlerList) Swap(name string, n NamedHandler) bool {
+	swapped := false
+	for i := range l.list {
+		if l.list[i].Name == name {
+			l.list[i] = n
+			swapped = true
+		}
+	}
+	return swapped
+}
+
+// Remove removes every handler named name from the list. Returns if any
+// handler was removed.
+func (l *HandlerList) Remove(name string) bool {
+	list := make([]NamedHandler, 0, len(l.list))
+	for _, h := range l.list {
+		if h.Name != name {
+			list = append(list, h)
+		}
+	}
+	removed := len(list) != len(l.list)
+	l.list = list
+	return removed
+}
+
+// Names returns the names of the handlers in the list, in the order they
+// are run. Handlers pushed without a name are listed as empty strings.
+func (l *HandlerList) Names() []string {
+	names := make([]string, len(l.list))
+	for i, h := range l.list {
+		names[i] = h.Name
+	}
+	return names
 }
 
 // Run executes all handlers in the list with a given request object.
 func (l *HandlerList) Run(r *Request) {
-	for _, f := range l.list {
-		f(r)
+	for _, h := range l.list {
+		h.Fn(r)
 	}
 }
+
+// index returns the position of the first handler named name, or -1.
+func (l *HandlerList) index(name string) int {
+	for i, h := range l.list {
+		if h.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// insert inserts the named handler n at position i.
+func (l *HandlerList) insert(i int, n NamedHandler) {
+	l.list = append(l.list, NamedHandler{})
+	copy(l.list[i+1:], l.list[i:])
+	l.list[i] = n
+}
+
+// unnamed wraps handler funcs into named handlers without a name.
+func unnamed(f []func(*Request)) []NamedHandler {
+	n := make([]NamedHandler, len(f))
+	for i, fn := range f {
+		n[i] = NamedHandler{Fn: fn}
+	}
+	return n
+}
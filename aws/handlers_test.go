@@ -29,3 +29,50 @@ func TestMultipleHandlers(t *testing.T) {
 		t.Error("Expected handler to execute")
 	}
 }
+
+func namedHandler(name string, s *string) NamedHandler {
+	return NamedHandler{Name: name, Fn: func(r *Request) { *s += name }}
+}
+
+func TestNamedHandlersInsert(t *testing.T) {
+	s := ""
+	l := HandlerList{}
+	l.PushBackNamed(namedHandler("b", &s))
+	l.PushFrontNamed(namedHandler("a", &s))
+	l.PushBackNamed(namedHandler("d", &s))
+
+	assert.True(t, l.InsertAfter("b", namedHandler("c", &s)))
+	assert.True(t, l.InsertBefore("a", namedHandler("0", &s)))
+	assert.False(t, l.InsertBefore("missing", namedHandler("x", &s)))
+	assert.Equal(t, []string{"0", "a", "b", "c", "d"}, l.Names())
+
+	l.Run(&Request{})
+	assert.Equal(t, "0abcd", s)
+}
+
+func TestNamedHandlersSwapRemove(t *testing.T) {
+	s := ""
+	l := HandlerList{}
+	l.PushBackNamed(namedHandler("a", &s), namedHandler("b", &s))
+	l.PushBack(func(r *Request) { s += "-" })
+
+	assert.True(t, l.Swap("a", namedHandler("x", &s)))
+	assert.False(t, l.Swap("a", namedHandler("y", &s)))
+	assert.True(t, l.Remove("b"))
+	assert.False(t, l.Remove("b"))
+	assert.Equal(t, []string{"x", ""}, l.Names())
+
+	l.Run(&Request{})
+	assert.Equal(t, "x-", s)
+}
+
+func TestCoreHandlerNames(t *testing.T) {
+	s := NewService(&Config{})
+	assert.Equal(t, []string{"core.ClientRateLimitHandler", "core.SendHandler"}, s.Handlers.Send.Names())
+	assert.Equal(t, []string{"core.ValidateEndpointHandler", "core.ValidateTransportHandler", "core.ValidateParameters"}, s.Handlers.Validate.Names())
+
+	copied := s.Handlers.copy()
+	copied.Send.Remove("core.ClientRateLimitHandler")
+	assert.Equal(t, 2, s.Handlers.Send.Len())
+	assert.Equal(t, 1, copied.Send.Len())
+}
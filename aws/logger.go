@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awsutil"
+)
+
+// A LogField is a key value pair attached to a structured log entry.
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// Keys of the fields attached to the log entries of a request.
+const (
+	LogFieldOperation = "op"
+	LogFieldBucket    = "bucket"
+	LogFieldKey       = "key"
+	LogFieldAttempt   = "attempt"
+	LogFieldStatus    = "status"
+	LogFieldRequestID = "requestID"
+)
+
+// A LeveledLogger receives the log entries of the SDK. Level is one of Error,
+// Warn, Info or Debug, and entries above Config.LogLevel are never passed to
+// the logger.
+type LeveledLogger interface {
+	Log(level uint, msg string, fields ...LogField)
+}
+
+// NewWriterLogger returns a LeveledLogger writing entries as lines of text
+// to w, in the same format used when Config.LeveledLogger is not set.
+func NewWriterLogger(w io.Writer) LeveledLogger {
+	return writerLogger{w: w}
+}
+
+type writerLogger struct {
+	w io.Writer
+}
+
+func (l writerLogger) Log(level uint, msg string, fields ...LogField) {
+	var logBuffer bytes.Buffer
+	logBuffer.WriteString(time.Now().Format("2006/01/02 15:04:05"))
+	logBuffer.WriteString(" ")
+	logBuffer.WriteString(LogTag[level-1])
+	if len(fields) > 0 {
+		for _, f := range fields {
+			fmt.Fprintf(&logBuffer, "%s=%v ", f.Key, f.Value)
+		}
+		if strings.Contains(msg, "\n") {
+			logBuffer.WriteString("\n")
+		}
+	}
+	logBuffer.WriteString(msg)
+	if logBuffer.Len() == 0 || logBuffer.Bytes()[logBuffer.Len()-1] != '\n' {
+		logBuffer.WriteString("\n")
+	}
+	fmt.Fprintf(l.w, "%s", logBuffer.String())
+}
+
+// log writes a log entry carrying the fields describing the request: the
+// operation name, bucket, key, attempt and, once known, the response status
+// and request ID.
+func (r *Request) log(level uint, msg string, fields ...LogField) {
+	if r.Config.LogLevel < level {
+		return
+	}
+	r.Config.logger().Log(level, msg, append(r.logFields(), fields...)...)
+}
+
+// Log writes a log entry of the request at level, with the fields describing
+// the request, for the handlers which are not part of this package, such as
+// the signers.
+func (r *Request) Log(level uint, msg string, fields ...LogField) {
+	r.log(level, msg, fields...)
+}
+
+func (r *Request) logFields() []LogField {
+	var fields []LogField
+	if r.Operation != nil && r.Operation.Name != "" {
+		fields = append(fields, LogField{LogFieldOperation, r.Operation.Name})
+	}
+	if v := paramString(r.Params, "Bucket"); v != "" {
+		fields = append(fields, LogField{LogFieldBucket, v})
+	}
+	if v := paramString(r.Params, "Key"); v != "" {
+		fields = append(fields, LogField{LogFieldKey, v})
+	}
+	fields = append(fields, LogField{LogFieldAttempt, r.RetryCount + 1})
+	if r.HTTPResponse != nil {
+		fields = append(fields, LogField{LogFieldStatus, r.HTTPResponse.StatusCode})
+	}
+	if r.RequestID != "" {
+		fields = append(fields, LogField{LogFieldRequestID, r.RequestID})
+	}
+	return fields
+}
+
+// paramString returns the string value of the named input parameter, or an
+// empty string if the parameter is not set.
+func paramString(params interface{}, name string) string {
+	if params == nil {
+		return ""
+	}
+	for _, v := range awsutil.ValuesAtPath(params, name) {
+		switch s := v.(type) {
+		case *string:
+			if s != nil {
+				return *s
+			}
+		case string:
+			return s
+		}
+	}
+	return ""
+}
+
+// redactedValue replaces secrets in logged requests and responses.
+const redactedValue = "[REDACTED]"
+
+// redactedHeaders are the headers whose values are never logged unless
+// Config.DisableLogRedaction is set.
+var redactedHeaders = map[string]bool{
+	"Authorization":                                              true,
+	"X-Amz-Security-Token":                                       true,
+	"X-Amz-Server-Side-Encryption-Customer-Key":                  true,
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key":      true,
+	"X-Amz-Migration-Source-Server-Side-Encryption-Customer-Key": true,
+}
+
+// redactedQuery are the query parameters whose values are never logged
+// unless Config.DisableLogRedaction is set, as found in presigned URLs.
+var redactedQuery = map[string]bool{
+	"Signature":            true,
+	"X-Amz-Signature":      true,
+	"X-Amz-Security-Token": true,
+	"x-amz-security-token": true, // signature version 2
+}
+
+// RedactURL returns u as a string with the signature and security token of
+// a presigned URL replaced, so that it can be logged.
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	c := *u
+	c.RawQuery = redactQuery(u.RawQuery)
+	return c.String()
+}
+
+// RedactHeaderLines returns s with the values of sensitive headers replaced,
+// for lines of the form "name:value" such as the canonical headers of a
+// string to sign.
+func RedactHeaderLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if j := strings.IndexByte(line, ':'); j > 0 {
+			if redactedHeaders[http.CanonicalHeaderKey(strings.TrimSpace(line[:j]))] {
+				lines[i] = line[:j+1] + redactedValue
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func redactQuery(rawQuery string) string {
+	parts := strings.Split(rawQuery, "&")
+	for i, p := range parts {
+		name := p
+		if j := strings.IndexByte(p, '='); j >= 0 {
+			name = p[:j]
+		}
+		if n, err := url.QueryUnescape(name); err == nil && redactedQuery[n] {
+			parts[i] = name + "=" + redactedValue
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// redactDump replaces secrets in the output of httputil.DumpRequestOut or
+// httputil.DumpResponse: the values of sensitive headers, and sensitive query
+// parameters of the request line. The body is left as is.
+func redactDump(dump []byte) []byte {
+	var out bytes.Buffer
+	reader := bufio.NewReader(bytes.NewReader(dump))
+	first := true
+	for {
+		line, err := reader.ReadString('\n')
+		content := strings.TrimRight(line, "\r\n")
+		if content == "" && line != "" {
+			// End of the header section, copy the body as is.
+			out.WriteString(line)
+			rest, _ := io.ReadAll(reader)
+			out.Write(rest)
+			break
+		}
+
+		if first {
+			line = redactRequestLine(line)
+			first = false
+		} else if i := strings.IndexByte(content, ':'); i > 0 {
+			if redactedHeaders[http.CanonicalHeaderKey(content[:i])] {
+				line = content[:i+1] + " " + redactedValue + line[len(content):]
+			}
+		}
+		out.WriteString(line)
+
+		if err != nil {
+			break
+		}
+	}
+	return out.Bytes()
+}
+
+// redactRequestLine redacts the query of a request line such as
+// "GET /key?Signature=... HTTP/1.1". Status lines are returned unchanged.
+func redactRequestLine(line string) string {
+	fields := strings.SplitN(line, " ", 3)
+	if len(fields) != 3 {
+		return line
+	}
+	i := strings.IndexByte(fields[1], '?')
+	if i < 0 {
+		return line
+	}
+	fields[1] = fields[1][:i+1] + redactQuery(fields[1][i+1:])
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,41 @@
+//go:build go1.21
+// +build go1.21
+
+package aws
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewSlogLogger returns a LeveledLogger writing entries to l. The SDK log
+// levels are mapped to the slog levels of the same name, and the fields of
+// an entry become slog attributes.
+func NewSlogLogger(l *slog.Logger) LeveledLogger {
+	return slogLogger{l: l}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (s slogLogger) Log(level uint, msg string, fields ...LogField) {
+	attrs := make([]slog.Attr, len(fields))
+	for i, f := range fields {
+		attrs[i] = slog.Any(f.Key, f.Value)
+	}
+	s.l.LogAttrs(context.Background(), slogLevel(level), msg, attrs...)
+}
+
+func slogLevel(level uint) slog.Level {
+	switch level {
+	case Error:
+		return slog.LevelError
+	case Warn:
+		return slog.LevelWarn
+	case Info:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug
+	}
+}
@@ -0,0 +1,19 @@
+//go:build go1.21
+// +build go1.21
+
+package aws
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	NewSlogLogger(l).Log(Warn, "retrying", LogField{LogFieldBucket, "bucket"}, LogField{LogFieldAttempt, 2})
+	assert.Contains(t, buf.String(), "level=WARN msg=retrying bucket=bucket attempt=2")
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type logEntry struct {
+	level  uint
+	msg    string
+	fields map[string]interface{}
+}
+
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Log(level uint, msg string, fields ...LogField) {
+	e := logEntry{level: level, msg: msg, fields: map[string]interface{}{}}
+	for _, f := range fields {
+		e.fields[f.Key] = f.Value
+	}
+	l.entries = append(l.entries, e)
+}
+
+func TestRedactDump(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://bucket.ks3-cn-beijing.ksyuncs.com/key?AccessKeyId=AKID&Expires=1&Signature=c2VjcmV0", nil)
+	req.Header.Set("Authorization", "AWS AKID:c2VjcmV0")
+	req.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key", "a2V5")
+	req.Header.Set("X-Amz-Migration-Source-Server-Side-Encryption-Customer-Key", "bWlncmF0aW9u")
+	req.Header.Set("X-Amz-Meta-Name", "value")
+
+	dump, _ := httputil.DumpRequestOut(req, false)
+	redacted := string(redactDump(dump))
+
+	assert.NotContains(t, redacted, "c2VjcmV0")
+	assert.NotContains(t, redacted, "a2V5")
+	assert.NotContains(t, redacted, "bWlncmF0aW9u")
+	assert.Contains(t, redacted, "Authorization: "+redactedValue)
+	assert.Contains(t, redacted, "Signature="+redactedValue)
+	assert.Contains(t, redacted, "AccessKeyId=AKID")
+	assert.Contains(t, redacted, "X-Amz-Meta-Name: value")
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://ks3.example.com/b/k?X-Amz-Credential=AKID&X-Amz-Signature=abc&X-Amz-Security-Token=tok")
+	s := RedactURL(u)
+	assert.NotContains(t, s, "abc")
+	assert.NotContains(t, s, "tok")
+	assert.Contains(t, s, "X-Amz-Credential=AKID")
+	assert.Equal(t, "abc", u.Query().Get("X-Amz-Signature"))
+}
+
+func TestRedactHeaderLines(t *testing.T) {
+	s := RedactHeaderLines("PUT\n\n\nx-amz-server-side-encryption-customer-key:a2V5\nx-amz-meta-a:b\n/bucket/key")
+	assert.Equal(t, "PUT\n\n\nx-amz-server-side-encryption-customer-key:"+redactedValue+"\nx-amz-meta-a:b\n/bucket/key", s)
+}
+
+type logTestInput struct {
+	Bucket *string
+	Key    *string
+}
+
+func TestDebugLogFieldsAndRedaction(t *testing.T) {
+	logger := &recordingLogger{}
+	s := NewService(&Config{
+		Endpoint:      "https://ks3.example.com",
+		LogLevel:      Debug,
+		LeveledLogger: logger,
+	})
+	s.Handlers.Send.Swap("core.SendHandler", NamedHandler{Name: "test.Send", Fn: func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}, Body: http.NoBody}
+		r.RequestID = "req-1"
+	}})
+
+	r := NewRequest(s, &Operation{Name: "GetObject", HTTPMethod: "GET", HTTPPath: "/{Bucket}/{Key+}"},
+		&logTestInput{Bucket: String("bucket"), Key: String("key")}, nil)
+	r.HTTPRequest.Header.Set("Authorization", "AWS AKID:c2VjcmV0")
+	r.Handlers.Send.Run(r)
+
+	if !assert.Len(t, logger.entries, 2) {
+		return
+	}
+	req, resp := logger.entries[0], logger.entries[1]
+	assert.Equal(t, uint(Debug), req.level)
+	assert.Equal(t, "GetObject", req.fields[LogFieldOperation])
+	assert.Equal(t, "bucket", req.fields[LogFieldBucket])
+	assert.Equal(t, "key", req.fields[LogFieldKey])
+	assert.Equal(t, uint(1), req.fields[LogFieldAttempt])
+	assert.NotContains(t, req.msg, "c2VjcmV0")
+	assert.Equal(t, 200, resp.fields[LogFieldStatus])
+	assert.Equal(t, "req-1", resp.fields[LogFieldRequestID])
+}
+
+func TestDisableLogRedaction(t *testing.T) {
+	logger := &recordingLogger{}
+	s := NewService(&Config{
+		Endpoint:            "https://ks3.example.com",
+		LogLevel:            Debug,
+		LeveledLogger:       logger,
+		DisableLogRedaction: true,
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.HTTPRequest.Header.Set("Authorization", "AWS AKID:c2VjcmV0")
+	logHTTPRequest(r)
+
+	assert.True(t, strings.Contains(logger.entries[0].msg, "c2VjcmV0"))
+}
+
+func TestWriterLogger(t *testing.T) {
+	var buf bytes.Buffer
+	NewWriterLogger(&buf).Log(Warn, "retrying", LogField{LogFieldOperation, "PutObject"}, LogField{LogFieldAttempt, 2})
+	assert.Contains(t, buf.String(), "[warn]op=PutObject attempt=2 retrying\n")
+}
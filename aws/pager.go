@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awsutil"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+)
+
+// ErrCodeNoMorePages is the error code returned when a page is asked from a
+// Pager which has returned the last page.
+const ErrCodeNoMorePages = "NoMorePages"
+
+// A PagerOption changes the settings of a Pager.
+type PagerOption func(*Pager)
+
+// WithPageSize sets the maximum number of items of each page, through the
+// LimitToken of the operation's Paginator. The pages of operations without a
+// LimitToken fail with the InvalidParameter error code.
+func WithPageSize(size int64) PagerOption {
+	return func(p *Pager) {
+		p.PageSize = size
+	}
+}
+
+// WithStartingTokens makes a pager start from tokens previously returned by
+// its NextTokens method, in the order of the InputTokens of the operation.
+// A nil token leaves the value of the input unchanged.
+func WithStartingTokens(tokens ...interface{}) PagerOption {
+	return func(p *Pager) {
+		p.tokens = tokens
+	}
+}
+
+// A Pager sends the requests of an operation one page at a time. The first
+// page is the one of the input, and the tokens of each page are set on a copy
+// of the input for the next one. An operation without a Paginator has a
+// single page.
+type Pager struct {
+	// NewRequest returns a new request of the operation for the input of the
+	// pager.
+	NewRequest func() *Request
+
+	// PageSize is the maximum number of items of a page, 0 leaving the limit
+	// of the input. Only operations whose Paginator has a LimitToken support
+	// it.
+	PageSize int64
+
+	tokens []interface{}
+	done   bool
+}
+
+// ApplyOptions applies the options to the pager.
+func (p *Pager) ApplyOptions(opts ...PagerOption) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// HasMorePages returns true until the last page has been returned.
+func (p *Pager) HasMorePages() bool {
+	return !p.done
+}
+
+// NextTokens returns the tokens of the next page, to resume paging later with
+// WithStartingTokens. It returns nil before the first page.
+func (p *Pager) NextTokens() []interface{} {
+	return p.tokens
+}
+
+// NextPage sends the request of the next page and returns it, its Data being
+// the output of the page. A page whose request fails can be asked again.
+func (p *Pager) NextPage(ctx Context) (*Request, error) {
+	if p.done {
+		return nil, apierr.New(ErrCodeNoMorePages, "no more pages available", nil)
+	}
+
+	req := p.NewRequest()
+	if pg := req.Operation.Paginator; p.PageSize > 0 && (pg == nil || pg.LimitToken == "") {
+		return nil, apierr.New("InvalidParameter",
+			fmt.Sprintf("%s does not support setting a page size", req.Operation.Name), nil)
+	}
+	if pg := req.Operation.Paginator; pg != nil {
+		req.Params = awsutil.CopyOf(req.Params)
+		if p.PageSize > 0 && pg.LimitToken != "" {
+			awsutil.SetValueAtAnyPath(req.Params, pg.LimitToken, p.PageSize)
+		}
+		for i, token := range p.tokens {
+			if token != nil && i < len(pg.InputTokens) {
+				awsutil.SetValueAtAnyPath(req.Params, pg.InputTokens[i], token)
+			}
+		}
+	}
+
+	req.SetContext(ctx)
+	if err := req.Send(); err != nil {
+		return req, err
+	}
+
+	// Some operations return the token they were given on their last page,
+	// which would otherwise be asked for again and again.
+	next := req.nextPageTokens()
+	if next == nil || reflect.DeepEqual(next, p.tokens) {
+		p.done, next = true, nil
+	}
+	p.tokens = next
+	return req, nil
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+// +build go1.23
+
+package aws
+
+import "iter"
+
+// PagerItems returns an iterator over the items of the pages of p, items
+// returning those of a sent request. The iteration stops after yielding the
+// error of a page.
+func PagerItems[T any](ctx Context, p *Pager, items func(*Request) []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasMorePages() {
+			req, err := p.NextPage(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items(req) {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
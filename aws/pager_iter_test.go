@@ -0,0 +1,44 @@
+//go:build go1.23
+// +build go1.23
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagerItems(t *testing.T) {
+	server := newPagerTestServer([]string{"a", "b", "c", "d", "e"})
+	defer server.Close()
+
+	keys := func(r *Request) []string {
+		return r.Data.(*pagerTestOutput).Keys
+	}
+	var all []string
+	for key, err := range PagerItems(context.Background(), newPager(server.URL, &pagerTestInput{}), keys) {
+		assert.NoError(t, err)
+		all = append(all, key)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, all)
+
+	p := newPager(server.URL, &pagerTestInput{})
+	for key := range PagerItems(context.Background(), p, keys) {
+		if key == "c" {
+			break
+		}
+	}
+	assert.Equal(t, []interface{}{"d"}, p.NextTokens())
+
+	server = newPagerTestServer([]string{"a"}, http.StatusInternalServerError)
+	defer server.Close()
+	var errs int
+	for _, err := range PagerItems(context.Background(), newPager(server.URL, &pagerTestInput{}), keys) {
+		assert.Error(t, err)
+		errs++
+	}
+	assert.Equal(t, 1, errs)
+}
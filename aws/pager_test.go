@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+type pagerTestInput struct {
+	Marker  *string
+	MaxKeys *int64
+}
+
+type pagerTestOutput struct {
+	Keys        []string
+	NextMarker  *string
+	IsTruncated *bool
+}
+
+// newPagerTestServer lists the keys after the marker query parameter, two by
+// default, the status of the response being taken from failures first.
+func newPagerTestServer(keys []string, failures ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(failures) > 0 {
+			w.WriteHeader(failures[0])
+			failures = failures[1:]
+			return
+		}
+		start := 0
+		for start < len(keys) && keys[start] <= r.URL.Query().Get("marker") {
+			start++
+		}
+		size := 2
+		if n, err := strconv.Atoi(r.URL.Query().Get("max-keys")); err == nil {
+			size = n
+		}
+		end := start + size
+		if end > len(keys) {
+			end = len(keys)
+		}
+		w.Header().Set("X-Keys", strings.Join(keys[start:end], ","))
+		w.Header().Set("X-Truncated", strconv.FormatBool(end < len(keys)))
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func newPager(endpoint string, input *pagerTestInput, opts ...PagerOption) *Pager {
+	s := NewService(&Config{
+		Endpoint:    endpoint,
+		Region:      "mock-region",
+		MaxRetries:  -1,
+		RetryRule:   retry.DefaultNoDelayRetryRule,
+		ShouldRetry: retry.ShouldRetry,
+	})
+	s.Handlers.Build.PushBack(func(r *Request) {
+		in := r.Params.(*pagerTestInput)
+		query := r.HTTPRequest.URL.Query()
+		if in.Marker != nil {
+			query.Set("marker", *in.Marker)
+		}
+		if in.MaxKeys != nil {
+			query.Set("max-keys", strconv.FormatInt(*in.MaxKeys, 10))
+		}
+		r.HTTPRequest.URL.RawQuery = query.Encode()
+	})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		out := r.Data.(*pagerTestOutput)
+		if keys := r.HTTPResponse.Header.Get("X-Keys"); keys != "" {
+			out.Keys = strings.Split(keys, ",")
+		}
+		out.IsTruncated = Boolean(r.HTTPResponse.Header.Get("X-Truncated") == "true")
+	})
+
+	op := &Operation{
+		Name:       "ListKeys",
+		HTTPMethod: "GET",
+		HTTPPath:   "/",
+		Paginator: &Paginator{
+			InputTokens:     []string{"Marker"},
+			OutputTokens:    []string{"NextMarker || Keys[-1]"},
+			LimitToken:      "MaxKeys",
+			TruncationToken: "IsTruncated",
+		},
+	}
+	p := &Pager{NewRequest: func() *Request {
+		return NewRequest(s, op, input, &pagerTestOutput{})
+	}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+func nextKeys(t *testing.T, p *Pager) []string {
+	req, err := p.NextPage(context.Background())
+	assert.NoError(t, err)
+	return req.Data.(*pagerTestOutput).Keys
+}
+
+func TestPager(t *testing.T) {
+	server := newPagerTestServer([]string{"a", "b", "c", "d", "e"})
+	defer server.Close()
+
+	input := &pagerTestInput{}
+	p := newPager(server.URL, input)
+	assert.Nil(t, p.NextTokens())
+	assert.Equal(t, []string{"a", "b"}, nextKeys(t, p))
+	assert.Equal(t, []interface{}{"b"}, p.NextTokens())
+	assert.Equal(t, []string{"c", "d"}, nextKeys(t, p))
+	assert.True(t, p.HasMorePages())
+	assert.Equal(t, []string{"e"}, nextKeys(t, p))
+	assert.False(t, p.HasMorePages())
+	assert.Nil(t, p.NextTokens())
+	_, err := p.NextPage(context.Background())
+	assert.Equal(t, ErrCodeNoMorePages, err.(awserr.Error).Code())
+	assert.Equal(t, &pagerTestInput{}, input, "the input is not modified")
+
+	p = newPager(server.URL, &pagerTestInput{Marker: String("a")}, WithPageSize(3))
+	assert.Equal(t, []string{"b", "c", "d"}, nextKeys(t, p))
+	assert.Equal(t, []string{"e"}, nextKeys(t, p))
+	assert.False(t, p.HasMorePages())
+}
+
+func TestPagerPageSizeWithoutLimitToken(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region"})
+	op := &Operation{Name: "ListConfigs", HTTPMethod: "GET", HTTPPath: "/", Paginator: &Paginator{
+		InputTokens:     []string{"Marker"},
+		OutputTokens:    []string{"NextMarker"},
+		TruncationToken: "IsTruncated",
+	}}
+	p := &Pager{NewRequest: func() *Request {
+		return NewRequest(s, op, &pagerTestInput{}, &pagerTestOutput{})
+	}}
+	p.ApplyOptions(WithPageSize(10))
+
+	req, err := p.NextPage(context.Background())
+	assert.Nil(t, req)
+	if assert.Error(t, err) {
+		assert.Equal(t, "InvalidParameter", err.(awserr.Error).Code())
+	}
+}
+
+func TestPagerResume(t *testing.T) {
+	server := newPagerTestServer([]string{"a", "b", "c", "d", "e"}, http.StatusInternalServerError)
+	defer server.Close()
+
+	p := newPager(server.URL, &pagerTestInput{})
+	_, err := p.NextPage(context.Background())
+	assert.Error(t, err)
+	assert.True(t, p.HasMorePages())
+	assert.Equal(t, []string{"a", "b"}, nextKeys(t, p), "a failed page is asked again")
+
+	p = newPager(server.URL, &pagerTestInput{}, WithStartingTokens(p.NextTokens()...))
+	assert.Equal(t, []string{"c", "d"}, nextKeys(t, p))
+}
+
+func TestPagerRepeatedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Keys", "a")
+		w.Header().Set("X-Truncated", "true")
+	}))
+	defer server.Close()
+
+	p := newPager(server.URL, &pagerTestInput{})
+	nextKeys(t, p)
+	nextKeys(t, p)
+	assert.False(t, p.HasMorePages())
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+)
+
+// MaxPresignExpiry is the longest validity accepted by the V4 signer for a
+// presigned request.
+const MaxPresignExpiry = 7 * 24 * time.Hour
+
+// Presign signs the request in the query string instead of the Authorization
+// header, and returns the URL which grants access to the operation for
+// expiry, counted from the time the request was created, whatever the signer
+// version. The returned headers were included in the signature, the caller
+// must send them with the same values when using the URL.
+//
+// Any operation can be presigned, e.g. UploadPartRequest,
+// CompleteMultipartUploadRequest or HeadObjectRequest. The request body is
+// not part of the signature, but the caller must send the same body.
+func (r *Request) Presign(expiry time.Duration) (string, http.Header, error) {
+	if expiry < time.Second {
+		return "", nil, apierr.New("InvalidParameter", "presign expiry must be at least 1 second", nil)
+	}
+	r.presignExpiry = expiry
+	if err := r.Sign(); err != nil {
+		return "", nil, err
+	}
+	return r.HTTPRequest.URL.String(), presignedHeaders(r.HTTPRequest), nil
+}
+
+// PresignExpiry returns the validity requested by Presign, or 0 if the request
+// is not being presigned that way.
+func (r *Request) PresignExpiry() time.Duration {
+	return r.presignExpiry
+}
+
+// presignedHeaders returns the headers of a presigned request that are part
+// of its signature. V4 lists them in the X-Amz-SignedHeaders query parameter,
+// V2 signs Content-MD5, Content-Type and the x-amz-* headers.
+func presignedHeaders(req *http.Request) http.Header {
+	header := http.Header{}
+	if signed := req.URL.Query().Get("X-Amz-SignedHeaders"); signed != "" {
+		for _, name := range strings.Split(signed, ";") {
+			if name == "host" {
+				continue
+			}
+			if v := headerValues(req.Header, name); v != nil {
+				header[http.CanonicalHeaderKey(name)] = v
+			}
+		}
+		return header
+	}
+
+	for name, v := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-md5" || lower == "content-type" || strings.HasPrefix(lower, "x-amz-") {
+			header[http.CanonicalHeaderKey(name)] = append([]string{}, v...)
+		}
+	}
+	return header
+}
+
+// headerValues returns a copy of the values of the header name, which the V2
+// signer may have stored under its lower case key.
+func headerValues(h http.Header, name string) []string {
+	if v, ok := h[http.CanonicalHeaderKey(name)]; ok {
+		return append([]string{}, v...)
+	}
+	if v, ok := h[strings.ToLower(name)]; ok {
+		return append([]string{}, v...)
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+// Package recorder provides an http.RoundTripper recording the interactions
+// of a client with KS3 to a cassette file, and replaying them later, so that
+// tests captured once against a real service run offline.
+//
+// Requests are matched on their method, path, canonical query string and a
+// set of headers. The host is not matched, so that a cassette recorded
+// against one endpoint replays for another. Credentials, signatures and
+// signing dates are redacted from the cassette, which makes it stable across
+// recordings and safe to commit.
+//
+// Example:
+//
+//	rec, err := recorder.New("testdata/put_object.json", recorder.Config{Mode: recorder.ModeAuto})
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//	client := s3.New(&aws.Config{
+//	    Endpoint:   endpoint,
+//	    HTTPClient: rec.Client(),
+//	})
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// A Mode selects whether a Recorder records or replays.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette, and fails the
+	// requests which were not recorded.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends the requests and records their interactions, replacing
+	// the cassette when the Recorder is stopped.
+	ModeRecord
+
+	// ModeAuto replays the cassette if it exists, and records it otherwise.
+	ModeAuto
+)
+
+// Redacted replaces the credentials and the signatures in cassettes.
+const Redacted = "REDACTED"
+
+// Normalized signing dates of the cassettes.
+const (
+	normalizedAmzDate = "19700101T000000Z"
+	normalizedDate    = "Thu, 01 Jan 1970 00:00:00 GMT"
+)
+
+// DefaultMatchHeaders are the request headers matched by default, besides the
+// method, the path and the query string.
+var DefaultMatchHeaders = []string{
+	"Range",
+	"If-Match",
+	"If-None-Match",
+	"X-Amz-Copy-Source",
+	"X-Amz-Copy-Source-Range",
+}
+
+// redactedHeaders are the request headers which carry credentials or
+// signatures.
+var redactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Migration-Source-Server-Side-Encryption-Customer-Key",
+}
+
+// redactedParams are the query parameters which carry credentials or
+// signatures.
+var redactedParams = []string{
+	"Signature",
+	"X-Amz-Signature",
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"x-amz-security-token", // signature version 2
+	"AWSAccessKeyId",
+	"KSSAccessKeyId",
+}
+
+// Config 录制回放配置
+type Config struct {
+	Mode          Mode              // 录制或回放，默认为ModeReplay
+	Transport     http.RoundTripper // 录制时发送请求的Transport，默认为http.DefaultTransport
+	MatchHeaders  []string          // 匹配请求时比较的请求头，默认为DefaultMatchHeaders
+	RedactHeaders []string          // 录制时额外脱敏的请求头及响应头
+}
+
+// A Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// An Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+
+	used bool
+}
+
+// A RecordedRequest is a request of an Interaction, redacted.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Host   string      `json:"host"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"` // canonical query string
+	Header http.Header `json:"header,omitempty"`
+}
+
+// A RecordedResponse is the response of an Interaction.
+type RecordedResponse struct {
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"` // "base64" for binary bodies
+}
+
+// A Recorder is an http.RoundTripper recording or replaying a cassette.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	match     []string
+	redact    []string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// New returns a Recorder of the cassette file at path. The cassette is loaded
+// unless the Recorder records.
+func New(path string, cfg Config) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      cfg.Mode,
+		transport: cfg.Transport,
+		match:     cfg.MatchHeaders,
+		redact:    append(append([]string(nil), redactedHeaders...), cfg.RedactHeaders...),
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	if r.match == nil {
+		r.match = DefaultMatchHeaders
+	}
+
+	if r.mode == ModeAuto {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+	if r.mode == ModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("recorder: parse cassette %s: %v", path, err)
+		}
+	}
+	return r, nil
+}
+
+// Mode returns whether the Recorder records or replays.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an http.Client sending its requests through the Recorder,
+// to be set as aws.Config.HTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop writes the recorded cassette. It does nothing when the Recorder
+// replays.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(&r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// RoundTrip records or replays the interaction of req.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	i := &Interaction{
+		Request: r.recordRequest(req),
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.redactHeader(resp.Header),
+		},
+	}
+	if utf8.Valid(body) {
+		i.Response.Body = string(body)
+	} else {
+		i.Response.Body = base64.StdEncoding.EncodeToString(body)
+		i.Response.BodyEncoding = "base64"
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, i)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching req.
+// The body of req is read, as a server would, before responding.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_, err := io.Copy(ioutil.Discard, req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recorded := r.recordRequest(req)
+	r.mu.Lock()
+	var found *Interaction
+	for _, i := range r.cassette.Interactions {
+		if !i.used && r.matches(&recorded, &i.Request) {
+			found = i
+			found.used = true
+			break
+		}
+	}
+	r.mu.Unlock()
+	if found == nil {
+		return nil, fmt.Errorf("recorder: no interaction recorded in %s for %s %s?%s",
+			r.path, recorded.Method, recorded.Path, recorded.Query)
+	}
+
+	body := []byte(found.Response.Body)
+	if found.Response.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(found.Response.Body); err != nil {
+			return nil, err
+		}
+	}
+	header := http.Header{}
+	for name, values := range found.Response.Header {
+		header[name] = append([]string(nil), values...)
+	}
+	length := int64(len(body))
+	if n, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64); err == nil && req.Method == http.MethodHead {
+		length = n
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", found.Response.StatusCode, http.StatusText(found.Response.StatusCode)),
+		StatusCode:    found.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: length,
+		Request:       req,
+	}, nil
+}
+
+// Unused returns the interactions of the cassette which were not replayed,
+// to check that a test made all the requests it was recorded with.
+func (r *Recorder) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []*Interaction
+	for _, i := range r.cassette.Interactions {
+		if !i.used {
+			unused = append(unused, i)
+		}
+	}
+	return unused
+}
+
+func (r *Recorder) matches(req, recorded *RecordedRequest) bool {
+	if req.Method != recorded.Method || req.Path != recorded.Path || req.Query != recorded.Query {
+		return false
+	}
+	for _, name := range r.match {
+		if headerValue(req.Header, name) != headerValue(recorded.Header, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordRequest returns the redacted record of req.
+func (r *Recorder) recordRequest(req *http.Request) RecordedRequest {
+	header := r.redactHeader(req.Header)
+	if header.Get("X-Amz-Date") != "" {
+		header.Set("X-Amz-Date", normalizedAmzDate)
+	}
+	if header.Get("Date") != "" {
+		header.Set("Date", normalizedDate)
+	}
+	header.Del("User-Agent")
+	return RecordedRequest{
+		Method: req.Method,
+		Host:   req.URL.Host,
+		Path:   req.URL.EscapedPath(),
+		Query:  canonicalQuery(req.URL.RawQuery),
+		Header: header,
+	}
+}
+
+func (r *Recorder) redactHeader(h http.Header) http.Header {
+	redacted := http.Header{}
+	for name, values := range h {
+		redacted[name] = append([]string(nil), values...)
+		for _, secret := range r.redact {
+			if strings.EqualFold(name, secret) {
+				redacted[name] = []string{Redacted}
+				break
+			}
+		}
+	}
+	return redacted
+}
+
+// headerValue returns the first value of the header name. Names are compared
+// case-insensitively: the V2 signer stores the x-amz-* headers under lower
+// case keys, which http.Header.Get does not find.
+func headerValue(h http.Header, name string) string {
+	for key, values := range h {
+		if strings.EqualFold(key, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// canonicalQuery returns the query string sorted, with the signatures
+// redacted and the signing date normalized. A query string which does not
+// parse is returned as is.
+func canonicalQuery(raw string) string {
+	query, err := url.ParseQuery(raw)
+	if err != nil {
+		return raw
+	}
+	for _, name := range redactedParams {
+		if _, ok := query[name]; ok {
+			query.Set(name, Redacted)
+		}
+	}
+	if _, ok := query["X-Amz-Date"]; ok {
+		query.Set("X-Amz-Date", normalizedAmzDate)
+	}
+	// The expiry of V2 presigned URLs is a time, not a duration.
+	if _, ok := query["Signature"]; ok && query.Get("Expires") != "" {
+		query.Set("Expires", "0")
+	}
+	for _, values := range query {
+		sort.Strings(values)
+	}
+	// url.Values.Encode sorts by key, and writes "name=" for the parameters
+	// without value, such as ?uploads.
+	return strings.Replace(query.Encode(), "+", "%20", -1)
+}
@@ -0,0 +1,166 @@
+package recorder
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3/s3test"
+	"github.com/stretchr/testify/assert"
+)
+
+// sseCustomerKey is the encryption key of the SSE-C put of run, and
+// sseCustomerKeyMD5 its digest.
+var (
+	sseCustomerKey    = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("k"), 32))
+	sseCustomerKeyMD5 = func() string {
+		sum := md5.Sum(bytes.Repeat([]byte("k"), 32))
+		return base64.StdEncoding.EncodeToString(sum[:])
+	}()
+)
+
+// run makes the requests of a test: a put, a ranged get, an SSE-C put and
+// multipart upload, download and copy.
+func run(t *testing.T, client *s3.S3) {
+	_, err := client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("dir/small file"),
+		Body:   strings.NewReader("hello, world"),
+	})
+	assert.NoError(t, err)
+
+	out, err := client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("dir/small file"),
+		Range:  aws.String("bytes=7-"),
+	})
+	if assert.NoError(t, err) {
+		data, _ := ioutil.ReadAll(out.Body)
+		out.Body.Close()
+		assert.Equal(t, "world", string(data))
+	}
+
+	_, err = client.PutObject(&s3.PutObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("encrypted"),
+		Body:                 strings.NewReader("secret"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String(sseCustomerKey),
+		SSECustomerKeyMD5:    aws.String(sseCustomerKeyMD5),
+	})
+	assert.NoError(t, err)
+
+	data := bytes.Repeat([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 0xff}, 25*1024)
+	file := filepath.Join(t.TempDir(), "data")
+	assert.NoError(t, ioutil.WriteFile(file, data, 0644))
+	_, err = client.UploadFile(&s3.UploadFileInput{
+		Bucket:     aws.String("bucket"),
+		Key:        aws.String("big"),
+		UploadFile: aws.String(file),
+		PartSize:   aws.Long(s3.MinPartSize),
+	})
+	assert.NoError(t, err)
+
+	download := filepath.Join(t.TempDir(), "download")
+	_, err = client.DownloadFile(&s3.DownloadFileInput{
+		Bucket:       aws.String("bucket"),
+		Key:          aws.String("big"),
+		DownloadFile: aws.String(download),
+		PartSize:     aws.Long(s3.MinPartSize),
+	})
+	if assert.NoError(t, err) {
+		downloaded, _ := ioutil.ReadFile(download)
+		assert.Equal(t, data, downloaded)
+	}
+
+	_, err = client.CopyFile(&s3.CopyFileInput{
+		Bucket:       aws.String("bucket"),
+		Key:          aws.String("copy"),
+		SourceBucket: aws.String("bucket"),
+		SourceKey:    aws.String("big"),
+		PartSize:     aws.Long(s3.MinPartSize),
+	})
+	assert.NoError(t, err)
+}
+
+func newClient(endpoint *aws.Config, signerVersion string, rec *Recorder) *s3.S3 {
+	cfg := *endpoint
+	cfg.SignerVersion = signerVersion
+	cfg.CrcCheckEnabled = true
+	cfg.MaxRetries = -1
+	cfg.HTTPClient = rec.Client()
+	return s3.New(&cfg)
+}
+
+func TestRecordReplay(t *testing.T) {
+	for _, signer := range []string{"V2", "V4"} {
+		path := filepath.Join(t.TempDir(), "cassettes", "multipart.json")
+		server := s3test.NewServer(s3test.Config{})
+		server.CreateBucket("bucket")
+		endpoint := server.ClientConfig()
+
+		rec, err := New(path, Config{Mode: ModeAuto})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, ModeRecord, rec.Mode())
+		run(t, newClient(endpoint, signer, rec))
+		assert.NoError(t, rec.Stop())
+		server.Close()
+
+		cassette, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(cassette), s3test.DefaultSecretAccessKey)
+		assert.NotContains(t, string(cassette), s3test.DefaultAccessKeyID+":")
+		assert.NotContains(t, string(cassette), "Credential="+s3test.DefaultAccessKeyID)
+		assert.NotContains(t, string(cassette), sseCustomerKey, "%s: the SSE-C key is redacted", signer)
+
+		// The server is closed: the requests are answered from the cassette.
+		rec, err = New(path, Config{Mode: ModeAuto})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, ModeReplay, rec.Mode())
+		run(t, newClient(endpoint, signer, rec))
+		assert.Empty(t, rec.Unused(), signer)
+
+		_, err = newClient(endpoint, signer, rec).HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("missing"),
+		})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "no interaction recorded")
+		}
+	}
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	assert.Equal(t, "partNumber=2&uploadId=abc", canonicalQuery("uploadId=abc&partNumber=2"))
+	assert.Equal(t, "uploads=", canonicalQuery("uploads"))
+	assert.Equal(t, "prefix=a%20b", canonicalQuery("prefix=a%20b"))
+	assert.Equal(t,
+		"X-Amz-Credential=REDACTED&X-Amz-Date=19700101T000000Z&X-Amz-Expires=3600&X-Amz-Signature=REDACTED",
+		canonicalQuery("X-Amz-Signature=abc&X-Amz-Date=20261018T120000Z&X-Amz-Expires=3600&X-Amz-Credential=AKID%2F20261018"))
+	assert.Equal(t, "Expires=0&KSSAccessKeyId=REDACTED&Signature=REDACTED",
+		canonicalQuery("KSSAccessKeyId=AKID&Expires=1792000000&Signature=abc"))
+}
+
+func TestMatchHeaderCase(t *testing.T) {
+	rec := &Recorder{match: DefaultMatchHeaders}
+	recorded := &RecordedRequest{Method: "PUT", Path: "/bucket/copy", Header: http.Header{
+		"x-amz-copy-source": []string{"/bucket/a"},
+	}}
+	req := &RecordedRequest{Method: "PUT", Path: "/bucket/copy", Header: http.Header{
+		"X-Amz-Copy-Source": []string{"/bucket/a"},
+	}}
+	assert.True(t, rec.matches(req, recorded))
+	req.Header.Set("X-Amz-Copy-Source", "/bucket/b")
+	assert.False(t, rec.matches(req, recorded))
+}
@@ -2,7 +2,10 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"github.com/ks3sdklib/aws-sdk-go/aws/awsutil"
+	"github.com/ks3sdklib/aws-sdk-go/aws/telemetry"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 	"github.com/ks3sdklib/aws-sdk-go/internal/crc"
 	"hash"
 	"io"
@@ -38,6 +41,32 @@ type Request struct {
 	ContentType  string
 	RequestType  string
 	SignType     string
+
+	retryCost int
+
+	presignExpiry time.Duration
+
+	endpoint          string
+	bucketEndpointErr error
+	endpointIndex     int
+	endpointFailed    bool
+	failovers         int
+
+	bodyReads   *bodyCounter
+	payloadHash string
+
+	operationContext Context
+	operationCancel  context.CancelFunc
+	attemptContext   Context
+	attemptCancel    context.CancelFunc
+	attemptTimer     *time.Timer
+	attemptTimedOut  *int32
+
+	operationStart     time.Time
+	operationSpan      telemetry.Span
+	attemptStart       time.Time
+	attemptSpan        telemetry.Span
+	attemptSpanContext Context
 }
 
 // An Operation is the service API operation to be made.
@@ -72,8 +101,23 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 		p = "/"
 	}
 
+	endpoint := service.Endpoint
+	endpointIndex := 0
+	var endpointErr error
+	if service.endpoints != nil {
+		endpointIndex = service.endpoints.pick(-1)
+		endpoint = service.endpoints.endpoints[endpointIndex].url
+	} else if service.Config.Endpoint == "" && service.Config.EndpointResolver != nil {
+		if bucket := paramString(params, "Bucket"); bucket != "" {
+			var e Endpoint
+			if e, endpointErr = service.resolveEndpoint(bucket); endpointErr == nil {
+				endpoint = e.URL
+			}
+		}
+	}
+
 	httpReq, _ := http.NewRequest(method, "", nil)
-	httpReq.URL, _ = url.Parse(service.Endpoint + p)
+	httpReq.URL, _ = url.Parse(endpoint + p)
 
 	r := &Request{
 		Service:     service,
@@ -86,6 +130,10 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 		Params:      params,
 		Error:       nil,
 		Data:        data,
+
+		endpoint:          endpoint,
+		bucketEndpointErr: endpointErr,
+		endpointIndex:     endpointIndex,
 	}
 	r.SetBufferBody([]byte{})
 	return r
@@ -135,19 +183,43 @@ func (r *Request) SetStringBody(s string) {
 	r.SetReaderBody(strings.NewReader(s))
 }
 
-// SetReaderBody will set the request's body reader.
+// SetReaderBody will set the request's body reader. Readers which cannot
+// seek, such as the ones returned by StreamBody, are streamed with an
+// unknown length.
 func (r *Request) SetReaderBody(reader io.ReadSeeker) {
 	if r.Config.CrcCheckEnabled {
 		r.Crc64 = crc.NewCRC(crc.CrcTable(), 0)
 	}
+	var src io.Reader = reader
+	r.bodyReads = nil
+	r.payloadHash = ""
+	if !seekable(reader) {
+		r.bodyReads = &bodyCounter{r: reader}
+		src = r.bodyReads
+	}
 	if r.Config.CrcCheckEnabled || r.ProgressFn != nil {
-		r.HTTPRequest.Body = TeeReader(reader, r.Crc64, GetReaderLen(reader), r.ProgressFn)
+		r.HTTPRequest.Body = TeeReader(src, r.Crc64, GetReaderLen(reader), r.ProgressFn)
 	} else {
-		r.HTTPRequest.Body = io.NopCloser(reader)
+		r.HTTPRequest.Body = io.NopCloser(src)
 	}
 	r.Body = reader
 }
 
+// SetPayloadHash sets the hex encoded SHA-256 digest of the body, computed
+// while the body was read before, such as a part read into memory. The V4
+// signer then signs it instead of reading the body to hash it. Setting the
+// body again, as Build does, discards the digest.
+func (r *Request) SetPayloadHash(hash string) {
+	r.payloadHash = hash
+}
+
+// PayloadHash returns the digest set with SetPayloadHash, or computed by the
+// V4 signer the first time the request was signed, so that the body is not
+// hashed again when the request is signed again for a retry.
+func (r *Request) PayloadHash() string {
+	return r.payloadHash
+}
+
 // Build will build the request's object, so it can be signed and sent
 // to the service. Build will also validate all the request's parameters.
 // Anny additional build Handlers set on this request will be run
@@ -190,11 +262,23 @@ func (r *Request) Sign() error {
 //
 // Send will sign the request prior to sending. All Send Handlers will
 // be executed in the order they were set.
+//
+// Each HTTP attempt is bounded by Config.AttemptTimeout, and the operation
+// as a whole, retries and backoff delays included, by Config.OperationTimeout.
+// When one of them runs out the returned error has the code
+// ErrCodeAttemptTimeout or ErrCodeOperationTimeout respectively.
 func (r *Request) Send() error {
+	r.startOperation()
+	defer r.finishOperation()
+
 	for {
+		r.startAttemptSpan()
+		signSpan := r.startPhaseSpan("Sign")
 		r.Sign()
+		signSpan.End()
 
 		if r.Error != nil {
+			r.endAttemptSpan()
 			return r.Error
 		}
 		if r.Retryable.Get() {
@@ -204,8 +288,13 @@ func (r *Request) Send() error {
 		}
 		r.Retryable.Reset()
 
+		r.startAttempt()
+		sendSpan := r.startPhaseSpan("Send")
 		r.Handlers.Send.Run(r)
+		sendSpan.End()
 		if r.Error != nil {
+			r.checkContextError()
+			r.endAttemptSpan()
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -218,6 +307,8 @@ func (r *Request) Send() error {
 		r.Handlers.ValidateResponse.Run(r)
 		if r.Error != nil {
 			r.Handlers.UnmarshalError.Run(r)
+			r.checkContextError()
+			r.endAttemptSpan()
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -226,9 +317,12 @@ func (r *Request) Send() error {
 			continue
 		}
 
+		r.releaseAttemptOnClose()
 		r.Handlers.Unmarshal.Run(r)
 		r.Handlers.CheckCrc64.Run(r)
 		if r.Error != nil {
+			r.checkContextError()
+			r.endAttemptSpan()
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -237,12 +331,26 @@ func (r *Request) Send() error {
 			continue
 		}
 
+		// The attempt succeeded, its timeout no longer applies to the
+		// caller reading the response body.
+		r.stopAttemptTimer()
+		r.endAttemptSpan()
 		break
 	}
 
 	return nil
 }
 
+// checkContextError replaces the request's error with one naming the context
+// or timeout budget that stopped the attempt, if any did. Only an attempt
+// timeout leaves the request retryable, the other budgets are exhausted.
+func (r *Request) checkContextError() {
+	if err := r.contextError(); err != nil {
+		r.Error = err
+		r.Retryable.Set(err.(*apierr.BaseError).Code() == ErrCodeAttemptTimeout)
+	}
+}
+
 // HasNextPage returns true if this request has more pages of data available.
 func (r *Request) HasNextPage() bool {
 	return r.nextPageTokens() != nil
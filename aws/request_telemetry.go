@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/telemetry"
+)
+
+// startOperationSpan starts the span of the operation, child of the span
+// carried by ctx if any, and returns the context carrying it.
+func (r *Request) startOperationSpan(ctx Context) Context {
+	r.operationStart = time.Now()
+	if r.Config.Tracer == nil {
+		return ctx
+	}
+
+	attrs := append(r.operationAttributes(),
+		telemetry.String(telemetry.AttrHTTPMethod, r.HTTPRequest.Method))
+	if v := paramString(r.Params, "Bucket"); v != "" {
+		attrs = append(attrs, telemetry.String(telemetry.AttrBucket, v))
+	}
+	if v := paramString(r.Params, "Key"); v != "" {
+		attrs = append(attrs, telemetry.String(telemetry.AttrKey, v))
+	}
+	ctx, r.operationSpan = r.Config.Tracer.Start(ctx, r.ServiceName+"."+r.operationName(), telemetry.SpanKindClient, attrs...)
+	return ctx
+}
+
+// endOperationSpan records the outcome of the operation, with the number of
+// retries it took.
+func (r *Request) endOperationSpan() {
+	if r.operationStart.IsZero() {
+		return
+	}
+	duration := time.Since(r.operationStart)
+	r.operationStart = time.Time{}
+
+	if meter := r.Config.Meter; meter != nil {
+		attrs := append(r.operationAttributes(), r.outcomeAttributes()...)
+		meter.Histogram(telemetry.MetricOperationDuration, "s", "Duration of KS3 operations, retries included.").
+			Record(r.Context(), duration.Seconds(), attrs...)
+		if r.RetryCount > 0 {
+			meter.Counter(telemetry.MetricRetries, "{retry}", "Number of retried attempts.").
+				Add(r.Context(), int64(r.RetryCount), r.operationAttributes()...)
+		}
+	}
+
+	if r.operationSpan != nil {
+		r.operationSpan.SetAttributes(telemetry.Int(telemetry.AttrRetryCount, int(r.RetryCount)))
+		r.endSpan(r.operationSpan)
+		r.operationSpan = nil
+	}
+}
+
+// startAttemptSpan starts the span of an attempt, child of the operation
+// span. The signing and sending of the attempt are reported as its children.
+func (r *Request) startAttemptSpan() {
+	r.attemptStart = time.Now()
+	if r.Config.Tracer == nil {
+		return
+	}
+	r.attemptSpanContext, r.attemptSpan = r.Config.Tracer.Start(r.Context(), "Attempt", telemetry.SpanKindInternal,
+		telemetry.Int(telemetry.AttrAttempt, int(r.RetryCount)+1))
+}
+
+// startPhaseSpan starts the span of a phase of the current attempt.
+func (r *Request) startPhaseSpan(name string) telemetry.Span {
+	if r.attemptSpanContext == nil {
+		_, span := telemetry.NopTracer{}.Start(r.Context(), name, telemetry.SpanKindInternal)
+		return span
+	}
+	_, span := r.Config.Tracer.Start(r.attemptSpanContext, name, telemetry.SpanKindInternal)
+	return span
+}
+
+// endAttemptSpan records the latency, the status code, the bytes sent and
+// received, and the error of the attempt. It must be called once the error
+// returned by the service, if any, has been unmarshaled.
+func (r *Request) endAttemptSpan() {
+	if r.attemptStart.IsZero() {
+		return
+	}
+	duration := time.Since(r.attemptStart)
+	r.attemptStart = time.Time{}
+
+	var sent, received int64
+	if r.HTTPRequest != nil && r.HTTPRequest.ContentLength > 0 {
+		sent = r.HTTPRequest.ContentLength
+	}
+	if r.HTTPResponse != nil && r.HTTPResponse.ContentLength > 0 {
+		received = r.HTTPResponse.ContentLength
+	}
+
+	if meter := r.Config.Meter; meter != nil {
+		ctx := r.Context()
+		attrs := append(r.operationAttributes(), r.outcomeAttributes()...)
+		meter.Histogram(telemetry.MetricAttemptDuration, "s", "Duration of single HTTP attempts.").
+			Record(ctx, duration.Seconds(), attrs...)
+		meter.Counter(telemetry.MetricBytesSent, "By", "Bytes of request bodies sent.").
+			Add(ctx, sent, r.operationAttributes()...)
+		meter.Counter(telemetry.MetricBytesReceived, "By", "Bytes of response bodies received.").
+			Add(ctx, received, r.operationAttributes()...)
+	}
+
+	if r.attemptSpan != nil {
+		r.attemptSpan.SetAttributes(
+			telemetry.Int64(telemetry.AttrHTTPRequestSize, sent),
+			telemetry.Int64(telemetry.AttrHTTPResponseSize, received))
+		if r.HTTPRequest != nil {
+			r.attemptSpan.SetAttributes(telemetry.String(telemetry.AttrServerAddress, r.HTTPRequest.URL.Hostname()))
+		}
+		r.endSpan(r.attemptSpan)
+		r.attemptSpan = nil
+		r.attemptSpanContext = nil
+	}
+}
+
+// endSpan sets the outcome of the request on span and ends it.
+func (r *Request) endSpan(span telemetry.Span) {
+	span.SetAttributes(r.outcomeAttributes()...)
+	if r.RequestID != "" {
+		span.SetAttributes(telemetry.String(telemetry.AttrRequestID, r.RequestID))
+	}
+	if r.Error != nil {
+		span.RecordError(r.Error)
+		span.SetStatus(telemetry.StatusError, r.Error.Error())
+	}
+	span.End()
+}
+
+func (r *Request) operationName() string {
+	if r.Operation == nil {
+		return ""
+	}
+	return r.Operation.Name
+}
+
+// operationAttributes identifies the operation in spans and metrics.
+func (r *Request) operationAttributes() []telemetry.Attribute {
+	return []telemetry.Attribute{
+		telemetry.String(telemetry.AttrRPCSystem, telemetry.RPCSystem),
+		telemetry.String(telemetry.AttrRPCService, r.ServiceName),
+		telemetry.String(telemetry.AttrRPCMethod, r.operationName()),
+	}
+}
+
+// outcomeAttributes describes the response status and error of the request.
+func (r *Request) outcomeAttributes() []telemetry.Attribute {
+	var attrs []telemetry.Attribute
+	if r.HTTPResponse != nil {
+		attrs = append(attrs, telemetry.Int(telemetry.AttrHTTPStatusCode, r.HTTPResponse.StatusCode))
+	}
+	if r.Error != nil {
+		errorType := fmt.Sprintf("%T", r.Error)
+		if err, ok := r.Error.(awserr.Error); ok {
+			errorType = err.Code()
+		}
+		attrs = append(attrs, telemetry.String(telemetry.AttrErrorType, errorType))
+	}
+	return attrs
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/telemetry"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	status telemetry.StatusCode
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...telemetry.Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+func (s *testSpan) RecordError(err error)                                   {}
+func (s *testSpan) SetStatus(code telemetry.StatusCode, description string) { s.status = code }
+func (s *testSpan) End()                                                    { s.ended = true }
+
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+type testSpanKey struct{}
+
+func (t *testTracer) Start(ctx context.Context, name string, kind telemetry.SpanKind, attrs ...telemetry.Attribute) (context.Context, telemetry.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := &testSpan{name: name, attrs: map[string]interface{}{}}
+	s.parent, _ = ctx.Value(testSpanKey{}).(*testSpan)
+	s.SetAttributes(attrs...)
+	t.spans = append(t.spans, s)
+	return context.WithValue(ctx, testSpanKey{}, s), s
+}
+
+func (t *testTracer) named(name string) []*testSpan {
+	var spans []*testSpan
+	for _, s := range t.spans {
+		if s.name == name {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+func TestOperationAndAttemptSpans(t *testing.T) {
+	tracer := &testTracer{}
+	var metrics bytes.Buffer
+	meter := telemetry.NewExporter(&metrics)
+	svc := newRetryModeTestService(&Config{MaxRetries: 2, Tracer: tracer, Meter: meter},
+		[]*http.Response{
+			{StatusCode: 500, Body: body(`{"__type":"InternalError","message":"An error occurred."}`)},
+			{StatusCode: 200, Body: body(`{}`)},
+		})
+	svc.ServiceName = "s3"
+
+	req := NewRequest(svc, &Operation{Name: "GetObject"}, &logTestInput{Bucket: String("bucket"), Key: String("key")}, nil)
+	err := req.Send()
+	assert.NoError(t, err)
+
+	ops := tracer.named("s3.GetObject")
+	if !assert.Len(t, ops, 1) {
+		return
+	}
+	op := ops[0]
+	assert.True(t, op.ended)
+	assert.Nil(t, op.parent)
+	assert.Equal(t, "bucket", op.attrs[telemetry.AttrBucket])
+	assert.Equal(t, int64(1), op.attrs[telemetry.AttrRetryCount])
+	assert.Equal(t, telemetry.StatusUnset, op.status)
+
+	attempts := tracer.named("Attempt")
+	if !assert.Len(t, attempts, 2) {
+		return
+	}
+	assert.Equal(t, op, attempts[0].parent)
+	assert.Equal(t, int64(500), attempts[0].attrs[telemetry.AttrHTTPStatusCode])
+	assert.Equal(t, "InternalError", attempts[0].attrs[telemetry.AttrErrorType])
+	assert.Equal(t, telemetry.StatusError, attempts[0].status)
+	assert.Equal(t, int64(2), attempts[1].attrs[telemetry.AttrAttempt])
+	assert.Equal(t, int64(200), attempts[1].attrs[telemetry.AttrHTTPStatusCode])
+
+	for _, name := range []string{"Sign", "Send"} {
+		phases := tracer.named(name)
+		assert.Len(t, phases, 2)
+		for i, s := range phases {
+			assert.Equal(t, attempts[i], s.parent)
+			assert.True(t, s.ended)
+		}
+	}
+
+	assert.NoError(t, meter.Flush())
+	assert.Contains(t, metrics.String(), `"name":"`+telemetry.MetricRetries+`"`)
+	assert.Contains(t, metrics.String(), `"name":"`+telemetry.MetricAttemptDuration+`"`)
+}
@@ -142,8 +142,9 @@ func TestRequest4xxUnretryable(t *testing.T) {
 
 func TestRequestExhaustRetries(t *testing.T) {
 	delays := []time.Duration{}
-	sleepDelay = func(delay time.Duration) {
+	sleepDelay = func(ctx Context, delay time.Duration) error {
 		delays = append(delays, delay)
+		return nil
 	}
 
 	reqNum := 0
@@ -0,0 +1,39 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ExponentialJitterRetryRule 带随机抖动的指数级增长等待时间重试规则
+// 等待时间在0到指数级增长的等待时间之间随机选取，避免大量客户端同时重试
+type ExponentialJitterRetryRule struct {
+	baseDelay time.Duration // 基础等待时间
+	maxDelay  time.Duration // 单次最大等待时间
+}
+
+var DefaultExponentialJitterRetryRule = NewExponentialJitterRetryRule(DefaultBaseDelay, DefaultMaxDelay)
+
+func NewExponentialJitterRetryRule(baseDelay time.Duration, maxDelay time.Duration) ExponentialJitterRetryRule {
+	if baseDelay < 0 {
+		baseDelay = DefaultBaseDelay
+	}
+
+	if maxDelay < 0 {
+		maxDelay = DefaultMaxDelay
+	}
+
+	return ExponentialJitterRetryRule{
+		baseDelay: baseDelay,
+		maxDelay:  maxDelay,
+	}
+}
+
+func (r ExponentialJitterRetryRule) GetDelay(attempts int) time.Duration {
+	delay := r.baseDelay * time.Duration(math.Pow(2, float64(attempts-1)))
+	if delay > r.maxDelay || delay <= 0 {
+		delay = r.maxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
@@ -0,0 +1,176 @@
+package retry
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// 限流后发送速率的缩减系数
+	rateLimiterBeta = 0.7
+	// CUBIC算法的速率增长系数
+	rateLimiterScaleConstant = 0.4
+	// 实际发送速率的平滑系数
+	rateLimiterSmooth = 0.8
+	// 最小令牌填充速率，单位：令牌/秒
+	rateLimiterMinFillRate = 0.5
+	// 最小令牌桶容量
+	rateLimiterMinCapacity = 1.0
+)
+
+// ClientRateLimiter 客户端发送速率限制器，用于自适应重试模式
+//
+// 在服务端返回限流错误（429、503、Throttling等）之前限制器不生效。
+// 收到限流错误后，按CUBIC算法将发送速率降低到实际发送速率的一定比例，
+// 之后随着请求成功逐步恢复发送速率。一个客户端内所有请求共享同一个限制器。
+type ClientRateLimiter struct {
+	mu sync.Mutex
+
+	now func() time.Time
+
+	enabled          bool
+	fillRate         float64
+	maxCapacity      float64
+	currentCapacity  float64
+	lastTimestamp    float64
+	measuredTxRate   float64
+	lastTxRateBucket float64
+	requestCount     int64
+	lastMaxRate      float64
+	lastThrottleTime float64
+	timeWindow       float64
+}
+
+// NewClientRateLimiter 创建客户端发送速率限制器
+func NewClientRateLimiter() *ClientRateLimiter {
+	l := &ClientRateLimiter{
+		now:         time.Now,
+		fillRate:    rateLimiterMinFillRate,
+		maxCapacity: rateLimiterMinCapacity,
+	}
+	t := l.seconds()
+	l.lastTxRateBucket = math.Floor(t)
+	l.lastThrottleTime = t
+	return l
+}
+
+// Acquire 获取一次发送请求的许可，必要时等待，ctx结束时返回ctx的错误
+func (l *ClientRateLimiter) Acquire(ctx context.Context) error {
+	l.mu.Lock()
+	if !l.enabled {
+		l.mu.Unlock()
+		return nil
+	}
+	l.refill()
+	l.currentCapacity--
+	var delay time.Duration
+	if l.currentCapacity < 0 {
+		delay = time.Duration(-l.currentCapacity / l.fillRate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		// 未发送请求，归还许可
+		l.mu.Lock()
+		l.currentCapacity++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Update 根据一次请求的结果更新发送速率，throttled表示服务端是否返回了限流错误
+func (l *ClientRateLimiter) Update(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updateMeasuredRate()
+
+	var calculatedRate float64
+	if throttled {
+		rateToUse := l.measuredTxRate
+		if l.enabled {
+			rateToUse = math.Min(rateToUse, l.fillRate)
+		}
+		l.lastMaxRate = rateToUse
+		l.calculateTimeWindow()
+		l.lastThrottleTime = l.seconds()
+		calculatedRate = rateToUse * rateLimiterBeta
+		l.enabled = true
+	} else {
+		l.calculateTimeWindow()
+		calculatedRate = l.cubicSuccess(l.seconds())
+	}
+
+	l.updateTokenBucketRate(math.Min(calculatedRate, 2*l.measuredTxRate))
+}
+
+// Enabled 返回限制器当前是否生效
+func (l *ClientRateLimiter) Enabled() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.enabled
+}
+
+// FillRate 返回当前允许的发送速率，单位：请求/秒
+func (l *ClientRateLimiter) FillRate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.fillRate
+}
+
+func (l *ClientRateLimiter) seconds() float64 {
+	return float64(l.now().UnixNano()) / float64(time.Second)
+}
+
+func (l *ClientRateLimiter) refill() {
+	t := l.seconds()
+	if l.lastTimestamp == 0 {
+		l.lastTimestamp = t
+		return
+	}
+
+	l.currentCapacity = math.Min(l.maxCapacity, l.currentCapacity+(t-l.lastTimestamp)*l.fillRate)
+	l.lastTimestamp = t
+}
+
+func (l *ClientRateLimiter) updateTokenBucketRate(newRate float64) {
+	l.refill()
+	l.fillRate = math.Max(newRate, rateLimiterMinFillRate)
+	l.maxCapacity = math.Max(newRate, rateLimiterMinCapacity)
+	l.currentCapacity = math.Min(l.currentCapacity, l.maxCapacity)
+}
+
+// updateMeasuredRate 以0.5秒为时间片统计实际发送速率
+func (l *ClientRateLimiter) updateMeasuredRate() {
+	t := l.seconds()
+	timeBucket := math.Floor(t*2) / 2
+	l.requestCount++
+	if timeBucket > l.lastTxRateBucket {
+		currentRate := float64(l.requestCount) / (timeBucket - l.lastTxRateBucket)
+		l.measuredTxRate = currentRate*rateLimiterSmooth + l.measuredTxRate*(1-rateLimiterSmooth)
+		l.requestCount = 0
+		l.lastTxRateBucket = timeBucket
+	}
+}
+
+func (l *ClientRateLimiter) calculateTimeWindow() {
+	l.timeWindow = math.Cbrt(l.lastMaxRate * (1 - rateLimiterBeta) / rateLimiterScaleConstant)
+}
+
+func (l *ClientRateLimiter) cubicSuccess(t float64) float64 {
+	dt := t - l.lastThrottleTime
+	return rateLimiterScaleConstant*math.Pow(dt-l.timeWindow, 3) + l.lastMaxRate
+}
@@ -0,0 +1,63 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(now *time.Time) *ClientRateLimiter {
+	l := NewClientRateLimiter()
+	l.now = func() time.Time { return *now }
+	t := l.seconds()
+	l.lastTxRateBucket = t
+	l.lastThrottleTime = t
+	return l
+}
+
+func TestClientRateLimiterDisabledUntilThrottled(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestRateLimiter(&now)
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, l.Acquire(context.Background()))
+		l.Update(false)
+	}
+	assert.False(t, l.Enabled())
+}
+
+func TestClientRateLimiterThrottleReducesRate(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestRateLimiter(&now)
+
+	// 10 requests per second for 5 seconds
+	for i := 0; i < 50; i++ {
+		now = now.Add(100 * time.Millisecond)
+		l.Update(false)
+	}
+	l.Update(true)
+
+	assert.True(t, l.Enabled())
+	assert.InDelta(t, 10*rateLimiterBeta, l.FillRate(), 1)
+
+	// recovers after a while without throttling
+	throttledRate := l.FillRate()
+	for i := 0; i < 100; i++ {
+		now = now.Add(100 * time.Millisecond)
+		l.Update(false)
+	}
+	assert.True(t, l.FillRate() > throttledRate)
+}
+
+func TestClientRateLimiterAcquireCanceled(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestRateLimiter(&now)
+	// the bucket is empty right after throttling, acquiring has to wait
+	l.Update(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, l.Acquire(ctx))
+}
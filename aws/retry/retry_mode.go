@@ -0,0 +1,18 @@
+package retry
+
+// Mode 重试模式
+type Mode string
+
+const (
+	// ModeLegacy 传统重试模式（默认），仅按RetryRule等待后重试，各请求之间互不协调
+	ModeLegacy Mode = ""
+
+	// ModeStandard 标准重试模式，每次重试需要从客户端共享的重试配额中扣除令牌，
+	// 配额耗尽时不再重试，请求成功后归还令牌。未指定其它RetryRule时，
+	// 使用带随机抖动的DefaultExponentialJitterRetryRule，避免并发请求同时重试
+	ModeStandard Mode = "standard"
+
+	// ModeAdaptive 自适应重试模式，在标准重试模式的基础上，
+	// 当服务端返回限流错误时，通过客户端发送速率限制器降低请求发送速率
+	ModeAdaptive Mode = "adaptive"
+)
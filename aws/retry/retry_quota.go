@@ -0,0 +1,65 @@
+package retry
+
+import "sync"
+
+const (
+	// DefaultRetryQuotaCapacity 默认重试配额容量
+	DefaultRetryQuotaCapacity = 500
+	// RetryCost 每次重试消耗的令牌数
+	RetryCost = 5
+	// RetryTimeoutCost 超时错误重试消耗的令牌数
+	RetryTimeoutCost = 10
+	// NoRetryIncrement 未经重试即成功的请求归还的令牌数
+	NoRetryIncrement = 1
+)
+
+// RetryQuota 重试配额，一个客户端内所有请求共享的重试令牌桶
+// 每次重试前需要获取令牌，令牌不足时放弃重试，避免在服务端故障时通过重试放大请求量
+type RetryQuota struct {
+	mu        sync.Mutex
+	capacity  int
+	available int
+}
+
+// NewRetryQuota 创建容量为capacity的重试配额，capacity小于等于0时使用默认容量
+func NewRetryQuota(capacity int) *RetryQuota {
+	if capacity <= 0 {
+		capacity = DefaultRetryQuotaCapacity
+	}
+
+	return &RetryQuota{
+		capacity:  capacity,
+		available: capacity,
+	}
+}
+
+// GetToken 从配额中获取cost个令牌，令牌不足时返回false
+func (q *RetryQuota) GetToken(cost int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+	q.available -= cost
+	return true
+}
+
+// ReleaseToken 向配额中归还amount个令牌，归还后不超过配额容量
+func (q *RetryQuota) ReleaseToken(amount int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.available += amount
+	if q.available > q.capacity {
+		q.available = q.capacity
+	}
+}
+
+// Available 返回当前可用的令牌数
+func (q *RetryQuota) Available() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.available
+}
@@ -3,7 +3,6 @@ package retry
 import (
 	"errors"
 	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
-	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 )
 
 // ShouldRetry 判断是否需要重试
@@ -12,7 +11,7 @@ import (
 // 2.状态码在retryErrorCodes中
 // 3.错误码在retryableCodes中
 func ShouldRetry(err error) bool {
-	var requestError *apierr.RequestError
+	var requestError *awserr.RequestError
 	if errors.As(err, &requestError) {
 		if requestError.StatusCode() >= 500 {
 			return true
@@ -25,8 +24,9 @@ func ShouldRetry(err error) bool {
 		}
 	}
 
-	if err, ok := err.(awserr.Error); ok {
-		return IsCodeRetryable(err.Code())
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return IsCodeRetryable(aerr.Code())
 	}
 
 	return false
@@ -46,6 +46,35 @@ var retryableCodes = map[string]struct{}{
 	"Throttling":                             {},
 }
 
+// 限流状态码
+var throttleStatusCodes = []int{
+	429, // TooManyRequests
+	503, // ServiceUnavailable / SlowDown
+}
+
+// throttleCodes is a collection of service response codes which indicate
+// the client is being throttled.
+var throttleCodes = map[string]struct{}{
+	"Throttling":                             {},
+	"ThrottlingException":                    {},
+	"ThrottledException":                     {},
+	"RequestThrottledException":              {},
+	"TooManyRequestsException":               {},
+	"ProvisionedThroughputExceededException": {},
+	"RequestLimitExceeded":                   {},
+	"RequestThrottled":                       {},
+	"SlowDown":                               {},
+	"TooManyRequests":                        {},
+}
+
+// timeoutCodes is a collection of error codes which signify a request
+// attempt timed out. Retrying them costs more of the retry quota.
+var timeoutCodes = map[string]struct{}{
+	"AttemptTimeout":          {},
+	"RequestTimeout":          {},
+	"RequestTimeoutException": {},
+}
+
 // credsExpiredCodes is a collection of error codes which signify the credentials
 // need to be refreshed. Expired tokens require refreshing of credentials, and
 // resigning before the request can be retried.
@@ -60,7 +89,50 @@ func IsCodeRetryable(code string) bool {
 		return true
 	}
 
-	return IsCodeExpiredCreds(code)
+	return IsCodeThrottle(code) || IsCodeExpiredCreds(code)
+}
+
+func IsCodeThrottle(code string) bool {
+	_, ok := throttleCodes[code]
+	return ok
+}
+
+func IsCodeTimeout(code string) bool {
+	_, ok := timeoutCodes[code]
+	return ok
+}
+
+// IsErrorThrottle 判断是否为限流错误
+// 限流条件：
+// 1.状态码在throttleStatusCodes中
+// 2.错误码在throttleCodes中
+func IsErrorThrottle(err error) bool {
+	var requestError *awserr.RequestError
+	if errors.As(err, &requestError) {
+		for _, code := range throttleStatusCodes {
+			if requestError.StatusCode() == code {
+				return true
+			}
+		}
+	}
+
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return IsCodeThrottle(aerr.Code())
+	}
+
+	return false
+}
+
+// IsErrorTimeout 判断是否为超时错误，包括请求超时错误码和网络超时
+func IsErrorTimeout(err error) bool {
+	var netErr interface{ Timeout() bool }
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && IsCodeTimeout(aerr.Code())
 }
 
 func IsCodeExpiredCreds(code string) bool {
@@ -2,7 +2,7 @@ package aws
 
 import (
 	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
-	"github.com/ks3sdklib/aws-sdk-go/internal/endpoints"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 	"net/http"
 	"net/http/httputil"
 	"regexp"
@@ -24,6 +24,12 @@ type Service struct {
 	RetryRule     retry.RetryRule
 	ShouldRetry   func(error) bool
 	MaxRetries    int
+	RetryQuota    *retry.RetryQuota
+	RateLimiter   *retry.ClientRateLimiter
+
+	transportErr error
+	endpointErr  error
+	endpoints    *endpointPool
 }
 
 var schemeRE = regexp.MustCompile("^([^:]+)://")
@@ -41,7 +47,12 @@ func (s *Service) Initialize() {
 		s.Config = &Config{}
 	}
 	if s.Config.HTTPClient == nil {
-		s.Config.HTTPClient = http.DefaultClient
+		client, err := newHTTPClient(s.Config)
+		if err != nil {
+			s.transportErr = apierr.New("InvalidTransportConfig", "invalid transport configuration", err)
+			client = &http.Client{Transport: transportError{s.transportErr}}
+		}
+		s.Config.HTTPClient = client
 	}
 
 	if s.RetryRule == nil {
@@ -53,35 +64,72 @@ func (s *Service) Initialize() {
 	}
 
 	s.MaxRetries = s.Config.MaxRetries
-	s.Handlers.Validate.PushBack(ValidateEndpointHandler)
-	s.Handlers.Build.PushBack(UserAgentHandler)
-	s.Handlers.Sign.PushBack(BuildContentLength)
-	s.Handlers.Send.PushBack(SendHandler)
-	s.Handlers.AfterRetry.PushBack(AfterRetryHandler)
-	s.Handlers.ValidateResponse.PushBack(ValidateResponseHandler)
+	s.initRetryMode()
+	s.Handlers.Validate.PushBackNamed(ValidateEndpointHandlerNamed)
+	s.Handlers.Validate.PushBackNamed(ValidateTransportHandlerNamed)
+	s.Handlers.Build.PushBackNamed(UserAgentHandlerNamed)
+	s.Handlers.Sign.PushBackNamed(BuildContentLengthNamed)
+	s.Handlers.Send.PushBackNamed(ClientRateLimitHandlerNamed)
+	s.Handlers.Send.PushBackNamed(SendHandlerNamed)
+	s.Handlers.AfterRetry.PushBackNamed(AfterRetryHandlerNamed)
+	s.Handlers.ValidateResponse.PushBackNamed(ValidateResponseHandlerNamed)
+	s.Handlers.ValidateResponse.PushBackNamed(RetrySuccessHandlerNamed)
 	s.AddDebugHandlers()
 	s.buildEndpoint()
 
 	if !s.Config.DisableParamValidation {
-		s.Handlers.Validate.PushBack(ValidateParameters)
+		s.Handlers.Validate.PushBackNamed(ValidateParametersNamed)
+	}
+}
+
+// initRetryMode sets up the retry quota and the client-side rate limiter
+// required by the configured retry mode. Ones provided by the Config are
+// used as is, so they can be shared between several services.
+func (s *Service) initRetryMode() {
+	switch s.Config.RetryMode {
+	case retry.ModeStandard, retry.ModeAdaptive:
+		// Retries of concurrent requests are spread by jitter, unless
+		// another rule than the default one was chosen.
+		if s.RetryRule == nil || s.RetryRule == retry.RetryRule(retry.DefaultExponentialRetryRule) {
+			s.RetryRule = retry.DefaultExponentialJitterRetryRule
+		}
+		if s.RetryQuota == nil {
+			s.RetryQuota = s.Config.RetryQuota
+		}
+		if s.RetryQuota == nil {
+			s.RetryQuota = retry.NewRetryQuota(retry.DefaultRetryQuotaCapacity)
+		}
+	}
+
+	if s.Config.RetryMode == retry.ModeAdaptive {
+		if s.RateLimiter == nil {
+			s.RateLimiter = s.Config.RateLimiter
+		}
+		if s.RateLimiter == nil {
+			s.RateLimiter = retry.NewClientRateLimiter()
+		}
 	}
 }
 
 // buildEndpoint builds the endpoint values the service will use to make requests with.
 func (s *Service) buildEndpoint() {
+	if len(s.Config.Endpoints) > 0 {
+		s.buildEndpointPool()
+		return
+	}
 	if s.Config.Endpoint != "" {
-		s.Endpoint = s.Config.Endpoint
-	} else {
-		s.Endpoint, s.SigningRegion =
-			endpoints.EndpointForRegion(s.ServiceName, s.Config.Region)
+		s.Endpoint = s.addScheme(s.Config.Endpoint)
+		return
 	}
-	if s.Endpoint != "" && !schemeRE.MatchString(s.Endpoint) {
-		scheme := "https"
-		if s.Config.DisableSSL {
-			scheme = "http"
-		}
-		s.Endpoint = scheme + "://" + s.Endpoint
+	if s.Config.Region == "" && s.Config.EndpointResolver == nil {
+		return
+	}
+	e, err := s.resolveEndpoint("")
+	if err != nil {
+		s.endpointErr = err
+		return
 	}
+	s.Endpoint, s.SigningRegion = e.URL, e.SigningRegion
 }
 
 // AddDebugHandlers injects debug logging handlers into the service to log request
@@ -91,22 +139,33 @@ func (s *Service) AddDebugHandlers() {
 		return
 	}
 
-	s.Handlers.Send.PushFront(func(r *Request) {
-		logBody := r.Config.LogHTTPBody
-		dumpedBody, _ := httputil.DumpRequestOut(r.HTTPRequest, logBody)
-		r.Config.LogDebug("---[ REQUEST ]-----------------------------")
-		r.Config.LogDebug("%s", string(dumpedBody))
-		r.Config.LogDebug("-----------------------------------------------------")
-	})
-	s.Handlers.Send.PushBack(func(r *Request) {
-		r.Config.LogDebug("---[ RESPONSE ]--------------------------------------")
-		if r.HTTPResponse != nil {
-			logBody := r.Config.LogHTTPBody
-			dumpedBody, _ := httputil.DumpResponse(r.HTTPResponse, logBody)
-			r.Config.LogDebug("%s", string(dumpedBody))
-		} else if r.Error != nil {
-			r.Config.LogDebug("%s", r.Error.Error())
+	s.Handlers.Send.PushFrontNamed(NamedHandler{Name: "core.LogHTTPRequest", Fn: logHTTPRequest})
+	s.Handlers.Send.PushBackNamed(NamedHandler{Name: "core.LogHTTPResponse", Fn: logHTTPResponse})
+}
+
+// logHTTPRequest logs the request about to be sent, with its secrets
+// redacted unless Config.DisableLogRedaction is set.
+func logHTTPRequest(r *Request) {
+	dumpedBody, _ := httputil.DumpRequestOut(r.HTTPRequest, r.Config.LogHTTPBody)
+	if r.Config.RedactLog() {
+		dumpedBody = redactDump(dumpedBody)
+	}
+	r.log(Debug, "---[ REQUEST ]-----------------------------\n"+string(dumpedBody)+
+		"\n-----------------------------------------------------")
+}
+
+// logHTTPResponse logs the response received, or the error if the request
+// could not be sent.
+func logHTTPResponse(r *Request) {
+	var dumpedBody []byte
+	if r.HTTPResponse != nil {
+		dumpedBody, _ = httputil.DumpResponse(r.HTTPResponse, r.Config.LogHTTPBody)
+		if r.Config.RedactLog() {
+			dumpedBody = redactDump(dumpedBody)
 		}
-		r.Config.LogDebug("-----------------------------------------------------")
-	})
+	} else if r.Error != nil {
+		dumpedBody = []byte(r.Error.Error())
+	}
+	r.log(Debug, "---[ RESPONSE ]--------------------------------------\n"+string(dumpedBody)+
+		"\n-----------------------------------------------------")
 }
@@ -0,0 +1,372 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+)
+
+// Environment variables selecting the shared config file and profile.
+const (
+	EnvSharedConfigFile = "KS3_CONFIG_FILE"
+	EnvProfile          = "KS3_PROFILE"
+)
+
+// sharedConfigEnvPrefix prefixes the environment variables overriding the
+// keys of the shared config file, such as KS3_REGION for region.
+const sharedConfigEnvPrefix = "KS3_"
+
+// sharedConfig collects the settings of a profile before they are turned
+// into a Config.
+type sharedConfig struct {
+	cfg          Config
+	accessKey    string
+	secretKey    string
+	sessionToken string
+
+	credentialProcess string
+	credentialFile    string
+}
+
+func (s *sharedConfig) transport() *TransportConfig {
+	if s.cfg.Transport == nil {
+		s.cfg.Transport = &TransportConfig{}
+	}
+	return s.cfg.Transport
+}
+
+// sharedConfigKeys are the keys of a profile of the shared config file, with
+// the function applying their value.
+var sharedConfigKeys = map[string]func(s *sharedConfig, v string) error{
+	"access_key_id":     func(s *sharedConfig, v string) error { s.accessKey = v; return nil },
+	"secret_access_key": func(s *sharedConfig, v string) error { s.secretKey = v; return nil },
+	"session_token":     func(s *sharedConfig, v string) error { s.sessionToken = v; return nil },
+	"credential_process": func(s *sharedConfig, v string) error {
+		if len(strings.Fields(v)) == 0 {
+			return fmt.Errorf("expected a command")
+		}
+		s.credentialProcess = v
+		return nil
+	},
+	"credential_file": func(s *sharedConfig, v string) error { s.credentialFile = v; return nil },
+
+	"region":   func(s *sharedConfig, v string) error { s.cfg.Region = v; return nil },
+	"endpoint": func(s *sharedConfig, v string) error { s.cfg.Endpoint = v; return nil },
+	"endpoints": func(s *sharedConfig, v string) error {
+		s.cfg.Endpoints = splitList(v)
+		return nil
+	},
+	"use_internal_endpoint":   boolKey(func(s *sharedConfig, b bool) { s.cfg.UseInternalEndpoint = b }),
+	"use_accelerate_endpoint": boolKey(func(s *sharedConfig, b bool) { s.cfg.UseAccelerateEndpoint = b }),
+	"disable_ssl":             boolKey(func(s *sharedConfig, b bool) { s.cfg.DisableSSL = b }),
+	"s3_force_path_style":     boolKey(func(s *sharedConfig, b bool) { s.cfg.S3ForcePathStyle = b }),
+	"domain_mode":             boolKey(func(s *sharedConfig, b bool) { s.cfg.DomainMode = b }),
+	"signer_version": func(s *sharedConfig, v string) error {
+		v = strings.ToUpper(v)
+		switch v {
+		case "V2", "V4", "V4_UNSIGNED_PAYLOAD_SIGNER":
+			s.cfg.SignerVersion = v
+			return nil
+		}
+		return fmt.Errorf("expected V2, V4 or V4_UNSIGNED_PAYLOAD_SIGNER")
+	},
+
+	"max_retries": func(s *sharedConfig, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("expected an integer")
+		}
+		if n == 0 {
+			// A zero MaxRetries would be replaced by the default one when
+			// the Config is merged, negative values disable retries too.
+			n = -1
+		}
+		s.cfg.MaxRetries = n
+		return nil
+	},
+	"retry_mode": func(s *sharedConfig, v string) error {
+		switch retry.Mode(strings.ToLower(v)) {
+		case "legacy":
+			s.cfg.RetryMode = retry.ModeLegacy
+		case retry.ModeStandard, retry.ModeAdaptive:
+			s.cfg.RetryMode = retry.Mode(strings.ToLower(v))
+		default:
+			return fmt.Errorf("expected legacy, standard or adaptive")
+		}
+		return nil
+	},
+	"attempt_timeout":   durationKey(func(s *sharedConfig, d time.Duration) { s.cfg.AttemptTimeout = d }),
+	"operation_timeout": durationKey(func(s *sharedConfig, d time.Duration) { s.cfg.OperationTimeout = d }),
+
+	"crc_check_enabled":     boolKey(func(s *sharedConfig, b bool) { s.cfg.CrcCheckEnabled = b }),
+	"disable_dns_cache":     boolKey(func(s *sharedConfig, b bool) { s.cfg.DisableDnsCache = b }),
+	"log_http_body":         boolKey(func(s *sharedConfig, b bool) { s.cfg.LogHTTPBody = b }),
+	"disable_log_redaction": boolKey(func(s *sharedConfig, b bool) { s.cfg.DisableLogRedaction = b }),
+	"log_level": func(s *sharedConfig, v string) error {
+		for level, name := range []string{"off", "error", "warn", "info", "debug"} {
+			if strings.ToLower(v) == name {
+				s.cfg.LogLevel = uint(level)
+				return nil
+			}
+		}
+		return fmt.Errorf("expected off, error, warn, info or debug")
+	},
+
+	"proxy_url":      func(s *sharedConfig, v string) error { s.transport().ProxyURL = v; return nil },
+	"proxy_username": func(s *sharedConfig, v string) error { s.transport().ProxyUsername = v; return nil },
+	"proxy_password": func(s *sharedConfig, v string) error { s.transport().ProxyPassword = v; return nil },
+	"no_proxy": func(s *sharedConfig, v string) error {
+		s.transport().NoProxy = splitList(v)
+		return nil
+	},
+	"ca_bundle_file":          func(s *sharedConfig, v string) error { s.transport().CABundleFile = v; return nil },
+	"insecure_skip_verify":    boolKey(func(s *sharedConfig, b bool) { s.transport().InsecureSkipVerify = b }),
+	"connect_timeout":         durationKey(func(s *sharedConfig, d time.Duration) { s.transport().ConnectTimeout = d }),
+	"response_header_timeout": durationKey(func(s *sharedConfig, d time.Duration) { s.transport().ResponseHeaderTimeout = d }),
+	"max_conns_per_host": func(s *sharedConfig, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return fmt.Errorf("expected a positive integer")
+		}
+		s.transport().MaxConnsPerHost = n
+		return nil
+	},
+}
+
+// sharedConfigAliases are the key names of the shared credentials file,
+// accepted too so that both files can be merged.
+var sharedConfigAliases = map[string]string{
+	"aws_access_key_id":     "access_key_id",
+	"aws_secret_access_key": "secret_access_key",
+	"aws_session_token":     "session_token",
+}
+
+const sourceProfileKey = "source_profile"
+
+func boolKey(set func(s *sharedConfig, b bool)) func(s *sharedConfig, v string) error {
+	return func(s *sharedConfig, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("expected true or false")
+		}
+		set(s, b)
+		return nil
+	}
+}
+
+func durationKey(set func(s *sharedConfig, d time.Duration)) func(s *sharedConfig, v string) error {
+	return func(s *sharedConfig, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			if n, nerr := strconv.Atoi(v); nerr == nil {
+				d, err = time.Duration(n)*time.Second, nil
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("expected a duration such as 30s")
+		}
+		set(s, d)
+		return nil
+	}
+}
+
+func splitList(v string) []string {
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// LoadSharedConfig returns the Config described by profile of the shared
+// config file filename, overridden by the KS3_ environment variables. The
+// returned Config only holds the loaded settings, and is meant to be passed
+// to the constructor of a client, which merges it into DefaultConfig.
+//
+// filename defaults to the KS3_CONFIG_FILE environment variable, then to
+// $HOME/.ks3/config, and a missing default file is not an error. profile
+// defaults to the KS3_PROFILE environment variable, then to "default". A
+// profile is the section [name] or [profile name] of the file.
+//
+// A profile can inherit the settings of another one with source_profile,
+// its own settings taking precedence. Every key has an environment variable
+// overriding it, named after the key in upper case with the KS3_ prefix,
+// such as KS3_REGION for region. The keys are:
+//
+//	access_key_id, secret_access_key, session_token, credential_process, credential_file
+//	region, endpoint, endpoints, use_internal_endpoint, use_accelerate_endpoint, disable_ssl
+//	signer_version, s3_force_path_style, domain_mode
+//	max_retries, retry_mode, attempt_timeout, operation_timeout
+//	crc_check_enabled, disable_dns_cache
+//	log_level, log_http_body, disable_log_redaction
+//	proxy_url, proxy_username, proxy_password, no_proxy, ca_bundle_file, insecure_skip_verify
+//	connect_timeout, response_header_timeout, max_conns_per_host
+//
+// Lists, such as endpoints and no_proxy, are comma separated. Durations are
+// given as 30s or as a number of seconds. Unknown keys and invalid values
+// are reported as errors, naming the file, profile and key.
+//
+// Without access_key_id, the credentials are retrieved by running the command
+// of credential_process, split on spaces, with a credentials.ProcessProvider,
+// or read from credential_file with a credentials.FileProvider.
+func LoadSharedConfig(filename, profile string) (*Config, error) {
+	explicitFile := filename != "" || os.Getenv(EnvSharedConfigFile) != ""
+	if filename == "" {
+		filename = os.Getenv(EnvSharedConfigFile)
+	}
+	if filename == "" {
+		if home := userHomeDir(); home != "" {
+			filename = filepath.Join(home, ".ks3", "config")
+		}
+	}
+	explicitProfile := profile != "" || os.Getenv(EnvProfile) != ""
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	var file credentials.File
+	if filename != "" {
+		var err error
+		file, err = credentials.LoadFile(filename)
+		if err != nil {
+			if !os.IsNotExist(err) || explicitFile {
+				return nil, apierr.New("SharedConfigLoad", fmt.Sprintf("failed to load shared config file %s", filename), err)
+			}
+			file = nil
+		}
+	}
+
+	s := &sharedConfig{}
+	if file != nil {
+		if err := s.loadProfile(file, filename, profile, explicitProfile); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	cfg := s.cfg
+	if s.accessKey != "" || s.secretKey != "" {
+		if s.accessKey == "" || s.secretKey == "" {
+			return nil, apierr.New("SharedConfigCredentials",
+				fmt.Sprintf("profile %s of %s must set both access_key_id and secret_access_key", profile, filename), nil)
+		}
+		cfg.Credentials = credentials.NewStaticCredentials(s.accessKey, s.secretKey, s.sessionToken)
+	} else if s.credentialProcess != "" {
+		command := strings.Fields(s.credentialProcess)
+		cfg.Credentials = credentials.NewProcessCredentials(command[0], command[1:]...)
+	} else if s.credentialFile != "" {
+		cfg.Credentials = credentials.NewFileCredentials(s.credentialFile)
+	}
+	return &cfg, nil
+}
+
+// loadProfile applies the settings of profile, after the ones of the
+// profiles it inherits from.
+func (s *sharedConfig) loadProfile(file credentials.File, filename, profile string, required bool) error {
+	var chain []string
+	seen := map[string]bool{}
+	for name := profile; name != ""; {
+		if seen[name] {
+			return apierr.New("SharedConfigSourceProfile",
+				fmt.Sprintf("source_profile of profile %s in %s forms a cycle: %s", profile, filename, strings.Join(append(chain, name), " -> ")), nil)
+		}
+		seen[name] = true
+
+		section, ok := profileSection(file, name)
+		if !ok {
+			if name == profile && !required {
+				return nil
+			}
+			msg := fmt.Sprintf("profile %s not found in %s", name, filename)
+			if name != profile {
+				msg = fmt.Sprintf("source_profile %s of profile %s not found in %s", name, chain[len(chain)-1], filename)
+			}
+			return apierr.New("SharedConfigProfileNotExists", msg, nil)
+		}
+		chain = append(chain, name)
+		name = section[sourceProfileKey]
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		section, _ := profileSection(file, chain[i])
+		keys := make([]string, 0, len(section))
+		for key := range section {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if key == sourceProfileKey {
+				continue
+			}
+			name := key
+			if alias, ok := sharedConfigAliases[key]; ok {
+				name = alias
+			}
+			set, ok := sharedConfigKeys[name]
+			if !ok {
+				return apierr.New("SharedConfigUnknownKey",
+					fmt.Sprintf("unknown key %s in profile %s of %s", key, chain[i], filename), nil)
+			}
+			if err := set(s, section[key]); err != nil {
+				return apierr.New("SharedConfigInvalidValue",
+					fmt.Sprintf("invalid value %q for key %s in profile %s of %s: %v", section[key], key, chain[i], filename, err), nil)
+			}
+		}
+	}
+	return nil
+}
+
+// loadEnv applies the KS3_ environment variables.
+func (s *sharedConfig) loadEnv() error {
+	keys := make([]string, 0, len(sharedConfigKeys))
+	for key := range sharedConfigKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		env := sharedConfigEnvPrefix + strings.ToUpper(key)
+		v, ok := os.LookupEnv(env)
+		if !ok || v == "" {
+			continue
+		}
+		if err := sharedConfigKeys[key](s, v); err != nil {
+			return apierr.New("SharedConfigInvalidValue",
+				fmt.Sprintf("invalid value %q for environment variable %s: %v", v, env, err), nil)
+		}
+	}
+	return nil
+}
+
+// profileSection returns the section of profile, named either after the
+// profile or after the profile prefixed with "profile ".
+func profileSection(file credentials.File, profile string) (credentials.Section, bool) {
+	if section, ok := file["profile "+profile]; ok {
+		return section, true
+	}
+	section, ok := file[profile]
+	return section, ok
+}
+
+func userHomeDir() string {
+	if home := os.Getenv("HOME"); home != "" { // *nix
+		return home
+	}
+	return os.Getenv("USERPROFILE") // Windows
+}
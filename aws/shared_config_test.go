@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSharedConfig = `
+[default]
+region = cn-beijing
+access_key_id = akid
+secret_access_key = secret
+max_retries = 5
+
+[profile vpc]
+source_profile = default
+use_internal_endpoint = true
+signer_version = v4
+s3_force_path_style = true
+retry_mode = adaptive
+log_level = warn
+proxy_url = http://proxy.example.com:8080
+no_proxy = localhost, .internal
+connect_timeout = 5s
+attempt_timeout = 30
+
+[cycle-a]
+source_profile = cycle-b
+
+[cycle-b]
+source_profile = cycle-a
+
+[orphan]
+source_profile = missing
+
+[typo]
+regoin = cn-beijing
+
+[invalid]
+crc_check_enabled = yes please
+
+[no-retries]
+max_retries = 0
+`
+
+func writeSharedConfig(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "ks3-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestLoadSharedConfig(t *testing.T) {
+	os.Clearenv()
+	filename := writeSharedConfig(t, testSharedConfig)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	cfg, err := LoadSharedConfig(filename, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "cn-beijing", cfg.Region)
+	assert.Equal(t, 5, cfg.MaxRetries)
+	creds, err := cfg.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "akid", creds.AccessKeyID)
+	assert.Nil(t, cfg.Transport)
+
+	cfg, err = LoadSharedConfig(filename, "vpc")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "cn-beijing", cfg.Region, "inherited from source_profile")
+	assert.Equal(t, 5, cfg.MaxRetries)
+	assert.NotNil(t, cfg.Credentials)
+	assert.True(t, cfg.UseInternalEndpoint)
+	assert.Equal(t, "V4", cfg.SignerVersion)
+	assert.True(t, cfg.S3ForcePathStyle)
+	assert.Equal(t, retry.ModeAdaptive, cfg.RetryMode)
+	assert.Equal(t, uint(Warn), cfg.LogLevel)
+	assert.Equal(t, "http://proxy.example.com:8080", cfg.Transport.ProxyURL)
+	assert.Equal(t, []string{"localhost", ".internal"}, cfg.Transport.NoProxy)
+	assert.Equal(t, 5*time.Second, cfg.Transport.ConnectTimeout)
+	assert.Equal(t, 30*time.Second, cfg.AttemptTimeout)
+
+	cfg, err = LoadSharedConfig(filename, "no-retries")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, DefaultConfig.Merge(cfg).MaxRetries, "max_retries = 0 survives Merge as no retries")
+}
+
+func TestLoadSharedConfigEnv(t *testing.T) {
+	os.Clearenv()
+	filename := writeSharedConfig(t, testSharedConfig)
+	defer os.RemoveAll(filepath.Dir(filename))
+	defer os.Clearenv()
+
+	os.Setenv(EnvSharedConfigFile, filename)
+	os.Setenv(EnvProfile, "vpc")
+	os.Setenv("KS3_REGION", "cn-shanghai")
+	os.Setenv("KS3_DOMAIN_MODE", "true")
+	os.Setenv("KS3_CRC_CHECK_ENABLED", "1")
+
+	cfg, err := LoadSharedConfig("", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "cn-shanghai", cfg.Region)
+	assert.True(t, cfg.DomainMode)
+	assert.True(t, cfg.CrcCheckEnabled)
+	assert.True(t, cfg.UseInternalEndpoint)
+
+	os.Setenv("KS3_MAX_RETRIES", "many")
+	_, err = LoadSharedConfig("", "")
+	if assert.Error(t, err) {
+		assert.Equal(t, "SharedConfigInvalidValue", err.(awserr.Error).Code())
+		assert.Contains(t, err.Error(), "KS3_MAX_RETRIES")
+	}
+}
+
+func TestLoadSharedConfigErrors(t *testing.T) {
+	os.Clearenv()
+	filename := writeSharedConfig(t, testSharedConfig)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	cases := map[string]struct {
+		code, msg string
+	}{
+		"cycle-a": {"SharedConfigSourceProfile", "cycle-a -> cycle-b -> cycle-a"},
+		"orphan":  {"SharedConfigProfileNotExists", "source_profile missing of profile orphan"},
+		"typo":    {"SharedConfigUnknownKey", "unknown key regoin in profile typo"},
+		"invalid": {"SharedConfigInvalidValue", "crc_check_enabled"},
+		"missing": {"SharedConfigProfileNotExists", "profile missing not found"},
+	}
+	for profile, c := range cases {
+		_, err := LoadSharedConfig(filename, profile)
+		if assert.Error(t, err, profile) {
+			assert.Equal(t, c.code, err.(awserr.Error).Code(), profile)
+			assert.Contains(t, err.Error(), c.msg, profile)
+		}
+	}
+
+	_, err := LoadSharedConfig(filepath.Join(filepath.Dir(filename), "missing"), "")
+	if assert.Error(t, err) {
+		assert.Equal(t, "SharedConfigLoad", err.(awserr.Error).Code())
+	}
+
+	// A missing default file only leaves the environment.
+	os.Setenv("HOME", filepath.Dir(filename))
+	defer os.Clearenv()
+	os.Setenv("KS3_ENDPOINT", "ks3.example.com")
+	cfg, err := LoadSharedConfig("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "ks3.example.com", cfg.Endpoint)
+}
+
+func TestLoadSharedConfigCredentialFile(t *testing.T) {
+	os.Clearenv()
+	dir, err := ioutil.TempDir("", "ks3-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	secret := filepath.Join(dir, "credentials.json")
+	if err := ioutil.WriteFile(secret, []byte(`{"AccessKeyId": "AKID", "SecretAccessKey": "SECRET"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	filename := writeSharedConfig(t, "[default]\nregion = cn-beijing\ncredential_file = "+secret+"\n")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	cfg, err := LoadSharedConfig(filename, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	creds, err := cfg.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID", creds.AccessKeyID)
+
+	os.Setenv("KS3_CREDENTIAL_PROCESS", " ")
+	defer os.Clearenv()
+	_, err = LoadSharedConfig(filename, "")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "KS3_CREDENTIAL_PROCESS")
+	}
+}
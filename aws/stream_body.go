@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"errors"
+	"io"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+)
+
+// ErrCodeRequestBodyNotReplayable is the error code of requests which could
+// not be retried because their body cannot seek back to its start.
+const ErrCodeRequestBodyNotReplayable = "RequestBodyNotReplayable"
+
+// ErrCodeMissingContentLength is the error code of requests whose body has an
+// unknown length: KS3 requires the length of the body, even signed chunk by
+// chunk with signature version 4.
+const ErrCodeMissingContentLength = "MissingContentLength"
+
+// ErrMissingContentLength is the error of requests with a body of unknown
+// length.
+var ErrMissingContentLength error = apierr.New(ErrCodeMissingContentLength,
+	"a body of unknown length, such as one of aws.StreamBody, requires ContentLength, "+
+		"upload it with s3manager.Uploader instead", nil)
+
+var errBodyNotSeekable = errors.New("body is not seekable")
+
+// StreamBody returns a body reading r, such as a pipe, a compressor or the
+// body of another HTTP request, usable as the Body of PutObject or
+// UploadPart without buffering r in memory.
+//
+// ContentLength is required: the request fails before being sent with the
+// MissingContentLength error code otherwise. Data of unknown length is
+// uploaded by s3manager.Uploader, in parts of known sizes. With signature
+// version 4, the payload is signed chunk by chunk with
+// STREAMING-AWS4-HMAC-SHA256-PAYLOAD instead of being hashed beforehand. A
+// request whose body was partly sent cannot be retried, and fails with the
+// RequestBodyNotReplayable error code.
+func StreamBody(r io.Reader) io.ReadSeeker {
+	return &streamBody{r}
+}
+
+type streamBody struct {
+	io.Reader
+}
+
+func (b *streamBody) Seek(offset int64, whence int) (int64, error) {
+	return 0, errBodyNotSeekable
+}
+
+// bodyCounter counts the bytes read from a body which cannot seek, to tell
+// if the request can still be sent again.
+type bodyCounter struct {
+	r io.Reader
+	n int64
+}
+
+func (c *bodyCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// seekable returns if body can seek, so that it can be read several times.
+func seekable(body io.Seeker) bool {
+	_, err := body.Seek(0, io.SeekCurrent)
+	return err == nil
+}
+
+// BodySeekable returns false if the body of the request cannot seek, such
+// as the bodies returned by StreamBody. Such bodies have an unknown length
+// unless the Content-Length header is set, and cannot be hashed before the
+// request is sent.
+func (r *Request) BodySeekable() bool {
+	return r.bodyReads == nil
+}
+
+// BodyLengthUnknown returns if the body of the request cannot seek and has
+// no Content-Length, so that its length is only known once it is sent.
+func (r *Request) BodyLengthUnknown() bool {
+	return !r.BodySeekable() && r.HTTPRequest.ContentLength < 0
+}
+
+// bodyReplayable returns if the body of the request can be sent again.
+func (r *Request) bodyReplayable() bool {
+	return r.bodyReads == nil || r.bodyReads.n == 0
+}
+
+// errBodyNotReplayable wraps the error of an attempt which cannot be retried
+// because its body was consumed.
+func errBodyNotReplayable(err error) error {
+	return apierr.New(ErrCodeRequestBodyNotReplayable,
+		"request cannot be retried, its body was partly sent and cannot seek back to its start", err)
+}
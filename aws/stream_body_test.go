@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStreamTestService(url string) *Service {
+	s := NewService(&Config{
+		Endpoint:    url,
+		Region:      "mock-region",
+		MaxRetries:  2,
+		RetryRule:   retry.DefaultNoDelayRetryRule,
+		ShouldRetry: retry.ShouldRetry,
+	})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	return s
+}
+
+func TestStreamBodyUnknownLength(t *testing.T) {
+	var received string
+	var transferEncoding []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received, transferEncoding = string(b), r.TransferEncoding
+	}))
+	defer server.Close()
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("streamed "))
+		pw.Write([]byte("body"))
+		pw.Close()
+	}()
+
+	s := newStreamTestService(server.URL)
+	r := NewRequest(s, &Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.SetReaderBody(StreamBody(pr))
+	assert.False(t, r.BodySeekable())
+
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "streamed body", received)
+	assert.Equal(t, []string{"chunked"}, transferEncoding)
+}
+
+func TestStreamBodyNotReplayable(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	s := newStreamTestService(server.URL)
+	r := NewRequest(s, &Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.SetReaderBody(StreamBody(strings.NewReader("data")))
+	err := r.Send()
+
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeRequestBodyNotReplayable, err.(awserr.Error).Code())
+		assert.Equal(t, 500, err.(awserr.Error).OrigErr().(awserr.RequestFailure).StatusCode())
+	}
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, 0, int(r.RetryCount))
+
+	// Seekable bodies are still retried.
+	attempts = 0
+	r = NewRequest(s, &Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.SetReaderBody(strings.NewReader("data"))
+	assert.Error(t, r.Send())
+	assert.Equal(t, 3, attempts)
+}
+
+func TestStreamBodyRetryBeforeRead(t *testing.T) {
+	s := newRetryModeTestService(&Config{MaxRetries: 2}, []*http.Response{
+		{StatusCode: 503, Body: body(`{"__type":"ServiceUnavailable","message":"try later"}`)},
+		{StatusCode: 200, Body: body(`{}`)},
+	})
+	r := NewRequest(s, &Operation{Name: "PutObject"}, nil, nil)
+	r.SetReaderBody(StreamBody(strings.NewReader("data")))
+
+	assert.NoError(t, r.Send(), "a body which was not read yet can be sent again")
+	assert.Equal(t, 1, int(r.RetryCount))
+}
@@ -0,0 +1,455 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ScopeName is the instrumentation scope of the spans and metrics exported.
+const ScopeName = "github.com/ks3sdklib/aws-sdk-go"
+
+// Default histogram bucket boundaries. Durations in seconds use the
+// boundaries advised by the OpenTelemetry semantic conventions, other
+// histograms the default boundaries of the OpenTelemetry SDK.
+var (
+	DurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+	DefaultBuckets  = []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000}
+)
+
+// An Exporter is a Tracer and a Meter writing OTLP/JSON, one JSON document
+// per line, which the OpenTelemetry Collector can ingest with its
+// otlpjsonfile receiver.
+//
+// Every span is written as an ExportTraceServiceRequest when it ends.
+// Metrics are aggregated in memory, with cumulative temporality, and written
+// as an ExportMetricsServiceRequest on each call to Flush.
+type Exporter struct {
+	mu       sync.Mutex
+	w        io.Writer
+	resource []Attribute
+	start    time.Time
+
+	counters   map[string]*counter
+	histograms map[string]*histogram
+	order      []string
+}
+
+// NewExporter returns an Exporter writing to w. The resource attributes,
+// such as service.name, are attached to every span and metric written.
+func NewExporter(w io.Writer, resource ...Attribute) *Exporter {
+	return &Exporter{
+		w:          w,
+		resource:   resource,
+		start:      time.Now(),
+		counters:   map[string]*counter{},
+		histograms: map[string]*histogram{},
+	}
+}
+
+type spanContextKey struct{}
+
+// Start starts a span, child of the Exporter span carried by ctx if any.
+func (e *Exporter) Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, Span) {
+	s := &exporterSpan{
+		exporter:   e,
+		name:       name,
+		kind:       kind,
+		spanID:     newID(8),
+		start:      time.Now(),
+		attributes: append([]Attribute{}, attrs...),
+	}
+	if parent, ok := ctx.Value(spanContextKey{}).(*exporterSpan); ok {
+		s.traceID = parent.traceID
+		s.parentID = parent.spanID
+	} else {
+		s.traceID = newID(16)
+	}
+	return context.WithValue(ctx, spanContextKey{}, s), s
+}
+
+// Counter returns the counter recording to the metric name.
+func (e *Exporter) Counter(name, unit, description string) Counter {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	c, ok := e.counters[name]
+	if !ok {
+		c = &counter{exporter: e, name: name, unit: unit, description: description, points: map[string]*counterPoint{}}
+		e.counters[name] = c
+		e.order = append(e.order, name)
+	}
+	return c
+}
+
+// Histogram returns the histogram recording to the metric name.
+func (e *Exporter) Histogram(name, unit, description string) Histogram {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	h, ok := e.histograms[name]
+	if !ok {
+		bounds := DefaultBuckets
+		if unit == "s" {
+			bounds = DurationBuckets
+		}
+		h = &histogram{exporter: e, name: name, unit: unit, description: description, bounds: bounds, points: map[string]*histogramPoint{}}
+		e.histograms[name] = h
+		e.order = append(e.order, name)
+	}
+	return h
+}
+
+// Flush writes the current value of all the metrics recorded.
+func (e *Exporter) Flush() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := unixNano(time.Now())
+	start := unixNano(e.start)
+	var metrics []otlpMetric
+	for _, name := range e.order {
+		if c, ok := e.counters[name]; ok {
+			m := otlpMetric{Name: c.name, Unit: c.unit, Description: c.description,
+				Sum: &otlpSum{AggregationTemporality: 2, IsMonotonic: true}}
+			for _, key := range sortedKeys(c.points) {
+				p := c.points[key]
+				m.Sum.DataPoints = append(m.Sum.DataPoints, otlpNumberPoint{
+					Attributes: otlpAttributes(p.attrs), StartTimeUnixNano: start, TimeUnixNano: now,
+					AsInt: strconv.FormatInt(p.value, 10),
+				})
+			}
+			metrics = append(metrics, m)
+			continue
+		}
+		h := e.histograms[name]
+		m := otlpMetric{Name: h.name, Unit: h.unit, Description: h.description,
+			Histogram: &otlpHistogram{AggregationTemporality: 2}}
+		for _, key := range sortedKeys(h.points) {
+			p := h.points[key]
+			counts := make([]string, len(p.buckets))
+			for i, n := range p.buckets {
+				counts[i] = strconv.FormatUint(n, 10)
+			}
+			m.Histogram.DataPoints = append(m.Histogram.DataPoints, otlpHistogramPoint{
+				Attributes: otlpAttributes(p.attrs), StartTimeUnixNano: start, TimeUnixNano: now,
+				Count: strconv.FormatUint(p.count, 10), Sum: p.sum, Min: p.min, Max: p.max,
+				BucketCounts: counts, ExplicitBounds: h.bounds,
+			})
+		}
+		metrics = append(metrics, m)
+	}
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	return e.write(map[string]interface{}{
+		"resourceMetrics": []interface{}{map[string]interface{}{
+			"resource":     e.otlpResource(),
+			"scopeMetrics": []interface{}{map[string]interface{}{"scope": otlpScope, "metrics": metrics}},
+		}},
+	})
+}
+
+func (e *Exporter) exportSpan(span otlpSpan) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.write(map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource":   e.otlpResource(),
+			"scopeSpans": []interface{}{map[string]interface{}{"scope": otlpScope, "spans": []otlpSpan{span}}},
+		}},
+	})
+}
+
+// write writes v as a line of JSON. e.mu must be held.
+func (e *Exporter) write(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(append(b, '\n'))
+	return err
+}
+
+func (e *Exporter) otlpResource() map[string]interface{} {
+	return map[string]interface{}{"attributes": otlpAttributes(e.resource)}
+}
+
+type exporterSpan struct {
+	exporter *Exporter
+
+	mu                sync.Mutex
+	name              string
+	kind              SpanKind
+	traceID           string
+	spanID            string
+	parentID          string
+	start             time.Time
+	attributes        []Attribute
+	events            []otlpEvent
+	status            StatusCode
+	statusDescription string
+	ended             bool
+}
+
+func (s *exporterSpan) SetAttributes(attrs ...Attribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attributes = append(s.attributes, attrs...)
+}
+
+// RecordError records err as an exception event, as OpenTelemetry does.
+func (s *exporterSpan) RecordError(err error) {
+	if err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, otlpEvent{
+		TimeUnixNano: unixNano(time.Now()),
+		Name:         "exception",
+		Attributes:   otlpAttributes([]Attribute{String("exception.message", err.Error())}),
+	})
+}
+
+func (s *exporterSpan) SetStatus(code StatusCode, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = code
+	if code == StatusError {
+		s.statusDescription = description
+	} else {
+		s.statusDescription = ""
+	}
+}
+
+func (s *exporterSpan) End() {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	span := otlpSpan{
+		TraceID:           s.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentID,
+		Name:              s.name,
+		Kind:              int(s.kind),
+		StartTimeUnixNano: unixNano(s.start),
+		EndTimeUnixNano:   unixNano(time.Now()),
+		Attributes:        otlpAttributes(s.attributes),
+		Events:            s.events,
+		Status:            otlpStatus{Code: int(s.status), Message: s.statusDescription},
+	}
+	s.mu.Unlock()
+
+	s.exporter.exportSpan(span)
+}
+
+type counter struct {
+	exporter    *Exporter
+	name        string
+	unit        string
+	description string
+	points      map[string]*counterPoint
+}
+
+type counterPoint struct {
+	attrs []Attribute
+	value int64
+}
+
+func (c *counter) Add(ctx context.Context, value int64, attrs ...Attribute) {
+	c.exporter.mu.Lock()
+	defer c.exporter.mu.Unlock()
+
+	key := attributesKey(attrs)
+	p, ok := c.points[key]
+	if !ok {
+		p = &counterPoint{attrs: append([]Attribute{}, attrs...)}
+		c.points[key] = p
+	}
+	p.value += value
+}
+
+type histogram struct {
+	exporter    *Exporter
+	name        string
+	unit        string
+	description string
+	bounds      []float64
+	points      map[string]*histogramPoint
+}
+
+type histogramPoint struct {
+	attrs    []Attribute
+	count    uint64
+	sum      float64
+	min, max float64
+	buckets  []uint64
+}
+
+func (h *histogram) Record(ctx context.Context, value float64, attrs ...Attribute) {
+	h.exporter.mu.Lock()
+	defer h.exporter.mu.Unlock()
+
+	key := attributesKey(attrs)
+	p, ok := h.points[key]
+	if !ok {
+		p = &histogramPoint{attrs: append([]Attribute{}, attrs...), buckets: make([]uint64, len(h.bounds)+1), min: value, max: value}
+		h.points[key] = p
+	}
+	p.count++
+	p.sum += value
+	if value < p.min {
+		p.min = value
+	}
+	if value > p.max {
+		p.max = value
+	}
+	p.buckets[sort.SearchFloat64s(h.bounds, value)]++
+}
+
+// attributesKey identifies the data point of an attribute set, regardless
+// of the order of the attributes.
+func attributesKey(attrs []Attribute) string {
+	keys := make([]string, len(attrs))
+	for i, a := range attrs {
+		keys[i] = a.String()
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*counterPoint:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*histogramPoint:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newID(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// OTLP/JSON encoding of spans and metrics, see
+// https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+
+var otlpScope = map[string]string{"name": ScopeName}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Events            []otlpEvent     `json:"events,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string          `json:"timeUnixNano"`
+	Name         string          `json:"name"`
+	Attributes   []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpMetric struct {
+	Name        string         `json:"name"`
+	Unit        string         `json:"unit,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Sum         *otlpSum       `json:"sum,omitempty"`
+	Histogram   *otlpHistogram `json:"histogram,omitempty"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpNumberPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type otlpNumberPoint struct {
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	TimeUnixNano      string          `json:"timeUnixNano"`
+	AsInt             string          `json:"asInt"`
+}
+
+type otlpHistogram struct {
+	DataPoints             []otlpHistogramPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type otlpHistogramPoint struct {
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	TimeUnixNano      string          `json:"timeUnixNano"`
+	Count             string          `json:"count"`
+	Sum               float64         `json:"sum"`
+	Min               float64         `json:"min"`
+	Max               float64         `json:"max"`
+	BucketCounts      []string        `json:"bucketCounts"`
+	ExplicitBounds    []float64       `json:"explicitBounds"`
+}
+
+type otlpAttribute struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func otlpAttributes(attrs []Attribute) []otlpAttribute {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]otlpAttribute, len(attrs))
+	for i, a := range attrs {
+		var v map[string]interface{}
+		switch value := a.Value.(type) {
+		case bool:
+			v = map[string]interface{}{"boolValue": value}
+		case int:
+			v = map[string]interface{}{"intValue": strconv.Itoa(value)}
+		case int64:
+			v = map[string]interface{}{"intValue": strconv.FormatInt(value, 10)}
+		case float64:
+			v = map[string]interface{}{"doubleValue": value}
+		case string:
+			v = map[string]interface{}{"stringValue": value}
+		default:
+			v = map[string]interface{}{"stringValue": a.String()[len(a.Key)+1:]}
+		}
+		out[i] = otlpAttribute{Key: a.Key, Value: v}
+	}
+	return out
+}
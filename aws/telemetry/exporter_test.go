@@ -0,0 +1,107 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type exportedSpans struct {
+	ResourceSpans []struct {
+		Resource struct {
+			Attributes []otlpAttribute `json:"attributes"`
+		} `json:"resource"`
+		ScopeSpans []struct {
+			Spans []otlpSpan `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+func decodeSpans(t *testing.T, out string) []otlpSpan {
+	var spans []otlpSpan
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var doc exportedSpans
+		if !assert.NoError(t, json.Unmarshal([]byte(line), &doc)) {
+			continue
+		}
+		for _, rs := range doc.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}
+	return spans
+}
+
+func TestExporterSpans(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewExporter(&buf, String("service.name", "test"))
+
+	ctx, parent := e.Start(context.Background(), "s3.PutObject", SpanKindClient, String(AttrBucket, "bucket"))
+	_, child := e.Start(ctx, "Attempt", SpanKindInternal, Int(AttrAttempt, 1))
+	child.RecordError(errors.New("boom"))
+	child.SetStatus(StatusError, "boom")
+	child.End()
+	child.End()
+	parent.End()
+
+	spans := decodeSpans(t, buf.String())
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	c, p := spans[0], spans[1]
+	assert.Equal(t, "Attempt", c.Name)
+	assert.Equal(t, p.TraceID, c.TraceID)
+	assert.Equal(t, p.SpanID, c.ParentSpanID)
+	assert.Len(t, p.TraceID, 32)
+	assert.Len(t, p.SpanID, 16)
+	assert.Empty(t, p.ParentSpanID)
+	assert.Equal(t, int(SpanKindClient), p.Kind)
+	assert.Equal(t, int(StatusError), c.Status.Code)
+	assert.Equal(t, "exception", c.Events[0].Name)
+	assert.Equal(t, "1", c.Attributes[0].Value["intValue"])
+	assert.Equal(t, "bucket", p.Attributes[0].Value["stringValue"])
+	assert.Contains(t, buf.String(), `"key":"service.name","value":{"stringValue":"test"}`)
+}
+
+func TestExporterMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewExporter(&buf)
+
+	ctx := context.Background()
+	h := e.Histogram(MetricAttemptDuration, "s", "")
+	h.Record(ctx, 0.02, String(AttrRPCMethod, "GetObject"))
+	e.Histogram(MetricAttemptDuration, "s", "").Record(ctx, 3, String(AttrRPCMethod, "GetObject"))
+	e.Counter(MetricBytesSent, "By", "").Add(ctx, 10, String(AttrRPCMethod, "PutObject"))
+	e.Counter(MetricBytesSent, "By", "").Add(ctx, 5, String(AttrRPCMethod, "PutObject"))
+	assert.NoError(t, e.Flush())
+
+	var doc struct {
+		ResourceMetrics []struct {
+			ScopeMetrics []struct {
+				Metrics []otlpMetric `json:"metrics"`
+			} `json:"scopeMetrics"`
+		} `json:"resourceMetrics"`
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	metrics := doc.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	if !assert.Len(t, metrics, 2) {
+		return
+	}
+
+	hp := metrics[0].Histogram.DataPoints[0]
+	assert.Equal(t, "2", hp.Count)
+	assert.Equal(t, 3.02, hp.Sum)
+	assert.Equal(t, DurationBuckets, hp.ExplicitBounds)
+	assert.Equal(t, "1", hp.BucketCounts[2])
+	assert.Equal(t, "1", hp.BucketCounts[11])
+
+	sp := metrics[1].Sum.DataPoints[0]
+	assert.Equal(t, "15", sp.AsInt)
+	assert.True(t, metrics[1].Sum.IsMonotonic)
+}
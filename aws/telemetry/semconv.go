@@ -0,0 +1,35 @@
+package telemetry
+
+// Attribute keys reported by the SDK. Where OpenTelemetry defines a semantic
+// convention for the value, its key is used.
+const (
+	AttrRPCSystem          = "rpc.system"
+	AttrRPCService         = "rpc.service"
+	AttrRPCMethod          = "rpc.method"
+	AttrServerAddress      = "server.address"
+	AttrHTTPMethod         = "http.request.method"
+	AttrHTTPStatusCode     = "http.response.status_code"
+	AttrHTTPRequestSize    = "http.request.body.size"
+	AttrHTTPResponseSize   = "http.response.body.size"
+	AttrErrorType          = "error.type"
+	AttrRequestID          = "aws.request_id"
+	AttrBucket             = "aws.s3.bucket"
+	AttrKey                = "aws.s3.key"
+	AttrPartNumber         = "aws.s3.part_number"
+	AttrAttempt            = "ks3.attempt"
+	AttrRetryCount         = "ks3.retry_count"
+	AttrTransferPartSize   = "ks3.transfer.part_size"
+	AttrTransferObjectSize = "ks3.transfer.object_size"
+)
+
+// Names of the metrics recorded by the SDK.
+const (
+	MetricOperationDuration = "ks3.client.operation.duration"
+	MetricAttemptDuration   = "ks3.client.attempt.duration"
+	MetricRetries           = "ks3.client.retries"
+	MetricBytesSent         = "ks3.client.bytes_sent"
+	MetricBytesReceived     = "ks3.client.bytes_received"
+)
+
+// RPCSystem is the value of the rpc.system attribute of KS3 operations.
+const RPCSystem = "ks3"
@@ -0,0 +1,129 @@
+// Package telemetry defines the tracing and metrics interfaces the SDK
+// reports operations, attempts and transfers to.
+//
+// The interfaces follow the shape of the OpenTelemetry API, so an adapter to
+// an OpenTelemetry TracerProvider and MeterProvider is a thin wrapper. The
+// Exporter of this package is a dependency free implementation writing
+// OTLP/JSON.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+)
+
+// SpanKind is the role of a span in a trace, as defined by OpenTelemetry.
+type SpanKind int
+
+// Span kinds, with the values of the OTLP protocol.
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+// StatusCode is the status of a finished span, as defined by OpenTelemetry.
+type StatusCode int
+
+// Status codes, with the values of the OTLP protocol.
+const (
+	StatusUnset StatusCode = 0
+	StatusOK    StatusCode = 1
+	StatusError StatusCode = 2
+)
+
+// A Tracer starts spans. The returned context carries the new span, so that
+// spans started from it are its children.
+type Tracer interface {
+	Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, Span)
+}
+
+// A Span is a timed unit of work of a trace.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	SetStatus(code StatusCode, description string)
+	End()
+}
+
+// A Meter creates the instruments metrics are recorded with. Calling Counter
+// or Histogram several times with the same name must return instruments
+// recording to the same metric.
+type Meter interface {
+	Counter(name, unit, description string) Counter
+	Histogram(name, unit, description string) Histogram
+}
+
+// A Counter records monotonically increasing values, such as bytes sent.
+type Counter interface {
+	Add(ctx context.Context, value int64, attrs ...Attribute)
+}
+
+// A Histogram records a distribution of values, such as latencies.
+type Histogram interface {
+	Record(ctx context.Context, value float64, attrs ...Attribute)
+}
+
+// An Attribute is a key value pair describing a span or a metric data point.
+// Value is a string, bool, int64 or float64.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns a string attribute.
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int returns an integer attribute.
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: int64(value)}
+}
+
+// Int64 returns an integer attribute.
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Float64 returns a floating point attribute.
+func Float64(key string, value float64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Bool returns a boolean attribute.
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func (a Attribute) String() string {
+	return fmt.Sprintf("%s=%v", a.Key, a.Value)
+}
+
+// NopTracer is a Tracer whose spans do nothing.
+type NopTracer struct{}
+
+// Start returns ctx unchanged and a span doing nothing.
+func (NopTracer) Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttributes(attrs ...Attribute)              {}
+func (nopSpan) RecordError(err error)                         {}
+func (nopSpan) SetStatus(code StatusCode, description string) {}
+func (nopSpan) End()                                          {}
+
+// NopMeter is a Meter whose instruments record nothing.
+type NopMeter struct{}
+
+// Counter returns a counter recording nothing.
+func (NopMeter) Counter(name, unit, description string) Counter { return nopInstrument{} }
+
+// Histogram returns a histogram recording nothing.
+func (NopMeter) Histogram(name, unit, description string) Histogram { return nopInstrument{} }
+
+type nopInstrument struct{}
+
+func (nopInstrument) Add(ctx context.Context, value int64, attrs ...Attribute)      {}
+func (nopInstrument) Record(ctx context.Context, value float64, attrs ...Attribute) {}
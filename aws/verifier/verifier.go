@@ -0,0 +1,369 @@
+// Package verifier authenticates the requests signed by KS3 clients, with
+// signature version 2 or 4, in the Authorization header or in the query
+// string of presigned URLs. It is meant for servers which accept the requests
+// of KS3 clients, such as gateways and mock servers.
+package verifier
+
+import (
+	"crypto/hmac"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/signer/v2"
+	"github.com/ks3sdklib/aws-sdk-go/internal/signer/v4"
+)
+
+// Error codes of the errors returned by Verify. They are the codes KS3
+// returns, and the errors carry the HTTP status code KS3 responds with.
+const (
+	ErrCodeAccessDenied                      = "AccessDenied"
+	ErrCodeSignatureDoesNotMatch             = "SignatureDoesNotMatch"
+	ErrCodeInvalidAccessKeyID                = "InvalidAccessKeyId"
+	ErrCodeInvalidToken                      = "InvalidToken"
+	ErrCodeRequestTimeTooSkewed              = "RequestTimeTooSkewed"
+	ErrCodeAuthorizationHeaderMalformed      = "AuthorizationHeaderMalformed"
+	ErrCodeAuthorizationQueryParametersError = "AuthorizationQueryParametersError"
+)
+
+// DefaultMaxClockSkew is the default largest difference accepted between the
+// time a request was signed at and the time of the server.
+const DefaultMaxClockSkew = 15 * time.Minute
+
+// maxPresignExpires is the longest validity of a V4 presigned URL, in seconds.
+const maxPresignExpires = 7 * 24 * 60 * 60
+
+const (
+	v4Algorithm       = "AWS4-HMAC-SHA256"
+	v4TimeFormat      = "20060102T150405Z"
+	v4ShortTimeFormat = "20060102"
+)
+
+// CredentialsLookup returns the credentials of an access key ID. The error it
+// returns for an unknown key is reported as InvalidAccessKeyId.
+type CredentialsLookup func(accessKeyID string) (credentials.Value, error)
+
+// Config 签名校验配置
+type Config struct {
+	Endpoints    []string      // 服务的域名，如ks3-cn-beijing.ksyuncs.com，用于识别V2签名时位于域名中的存储空间，其它域名的请求按存储空间位于路径中校验
+	MaxClockSkew time.Duration // 请求的签名时间与服务端时间的最大偏差，默认值为15min
+}
+
+// Result describes a request whose signature is valid.
+type Result struct {
+	AccessKeyID   string
+	SignerVersion string    // "V2" or "V4"
+	Presigned     bool      // signed in the query string
+	SignTime      time.Time // zero for V2 presigned URLs, which only carry their expiry
+	Expires       time.Time // expiry of presigned URLs
+	Region        string    // region of the V4 credential scope
+	Service       string    // service of the V4 credential scope
+}
+
+// A Verifier validates the signatures of incoming requests.
+type Verifier struct {
+	lookup       CredentialsLookup
+	endpoints    []string
+	maxClockSkew time.Duration
+	now          func() time.Time
+}
+
+// New returns a Verifier looking the credentials of the requests up with
+// lookup.
+func New(lookup CredentialsLookup, cfg Config) *Verifier {
+	v := &Verifier{
+		lookup:       lookup,
+		maxClockSkew: cfg.MaxClockSkew,
+		now:          time.Now,
+	}
+	if v.maxClockSkew <= 0 {
+		v.maxClockSkew = DefaultMaxClockSkew
+	}
+	for _, endpoint := range cfg.Endpoints {
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			endpoint = u.Host
+		}
+		v.endpoints = append(v.endpoints, strings.ToLower(stripPort(endpoint)))
+	}
+	return v
+}
+
+// Signed returns if the request carries a signature, so that a server may
+// treat the other requests as anonymous.
+func Signed(r *http.Request) bool {
+	query := r.URL.Query()
+	return r.Header.Get("Authorization") != "" ||
+		query.Get("X-Amz-Signature") != "" || query.Get("Signature") != ""
+}
+
+// Verify checks the signature of r. The error it returns is an
+// awserr.RequestFailure whose code is one of the ErrCode constants.
+//
+// When a V4 signature covers the hash of the payload, the body of r is
+// replaced so that reading it fails if the payload does not match. A streamed
+// body (STREAMING-AWS4-HMAC-SHA256-PAYLOAD) is decoded while being read, and
+// the signature of each of its chunks checked.
+func (v *Verifier) Verify(r *http.Request) (*Result, error) {
+	auth := r.Header.Get("Authorization")
+	query := r.URL.Query()
+	switch {
+	case strings.HasPrefix(auth, v4Algorithm+" "):
+		return v.verifyV4(r, parseV4Authorization(strings.TrimPrefix(auth, v4Algorithm+" ")), false)
+	case strings.HasPrefix(auth, "AWS ") || strings.HasPrefix(auth, "KSS "):
+		return v.verifyV2Header(r, auth[4:])
+	case auth != "":
+		return nil, newError(ErrCodeAuthorizationHeaderMalformed, http.StatusBadRequest, "unsupported authorization type")
+	case query.Get("X-Amz-Algorithm") != "" || query.Get("X-Amz-Signature") != "":
+		return v.verifyV4Query(r, query)
+	case query.Get("Signature") != "":
+		return v.verifyV2Query(r, query)
+	}
+	return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "request is not signed")
+}
+
+func (v *Verifier) verifyV2Header(r *http.Request, credential string) (*Result, error) {
+	i := strings.LastIndex(credential, ":")
+	if i <= 0 {
+		return nil, newError(ErrCodeAuthorizationHeaderMalformed, http.StatusBadRequest, "malformed V2 authorization header")
+	}
+	accessKeyID, signature := credential[:i], credential[i+1:]
+
+	date := r.Header.Get("X-Amz-Date")
+	if date == "" {
+		date = r.Header.Get("Date")
+	}
+	if date == "" {
+		return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "missing Date header")
+	}
+	signTime, err := http.ParseTime(date)
+	if err != nil {
+		return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "invalid Date header "+date)
+	}
+	if err := v.checkSkew(signTime); err != nil {
+		return nil, err
+	}
+
+	result := &Result{AccessKeyID: accessKeyID, SignerVersion: "V2", SignTime: signTime}
+	return result, v.checkV2(r, accessKeyID, signature, false)
+}
+
+func (v *Verifier) verifyV2Query(r *http.Request, query url.Values) (*Result, error) {
+	accessKeyID := query.Get("AWSAccessKeyId")
+	if accessKeyID == "" {
+		accessKeyID = query.Get("KSSAccessKeyId")
+	}
+	expires, err := strconv.ParseInt(query.Get("Expires"), 10, 64)
+	if accessKeyID == "" || err != nil {
+		return nil, newError(ErrCodeAccessDenied, http.StatusForbidden,
+			"presigned URL requires AWSAccessKeyId, Signature and Expires")
+	}
+	expiry := time.Unix(expires, 0)
+	if v.now().After(expiry) {
+		return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "request has expired")
+	}
+
+	result := &Result{AccessKeyID: accessKeyID, SignerVersion: "V2", Presigned: true, Expires: expiry}
+	return result, v.checkV2(r, accessKeyID, query.Get("Signature"), true)
+}
+
+func (v *Verifier) checkV2(r *http.Request, accessKeyID, signature string, presigned bool) error {
+	creds, err := v.credentials(r, accessKeyID)
+	if err != nil {
+		return err
+	}
+	expected := v2.RequestSignature(r, creds.SecretAccessKey, v.bucketInHost(r.Host), presigned)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return newError(ErrCodeSignatureDoesNotMatch, http.StatusForbidden,
+			"the request signature we calculated does not match the signature you provided")
+	}
+	return nil
+}
+
+// v4Params are the signing parameters of a V4 signature, from the
+// Authorization header or the query string.
+type v4Params struct {
+	credential    string
+	signedHeaders string
+	signature     string
+}
+
+func parseV4Authorization(auth string) v4Params {
+	var p v4Params
+	for _, part := range strings.Split(auth, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "Credential":
+			p.credential = kv[1]
+		case "SignedHeaders":
+			p.signedHeaders = kv[1]
+		case "Signature":
+			p.signature = kv[1]
+		}
+	}
+	return p
+}
+
+func (v *Verifier) verifyV4Query(r *http.Request, query url.Values) (*Result, error) {
+	if query.Get("X-Amz-Algorithm") != v4Algorithm {
+		return nil, newError(ErrCodeAuthorizationQueryParametersError, http.StatusBadRequest,
+			"X-Amz-Algorithm only supports "+v4Algorithm)
+	}
+	expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+	if err != nil || expires <= 0 || expires > maxPresignExpires {
+		return nil, newError(ErrCodeAuthorizationQueryParametersError, http.StatusBadRequest,
+			"X-Amz-Expires must be between 1 and 604800 seconds")
+	}
+	return v.verifyV4(r, v4Params{
+		credential:    query.Get("X-Amz-Credential"),
+		signedHeaders: query.Get("X-Amz-SignedHeaders"),
+		signature:     query.Get("X-Amz-Signature"),
+	}, true)
+}
+
+func (v *Verifier) verifyV4(r *http.Request, p v4Params, presigned bool) (*Result, error) {
+	malformed := func(msg string) error {
+		if presigned {
+			return newError(ErrCodeAuthorizationQueryParametersError, http.StatusBadRequest, msg)
+		}
+		return newError(ErrCodeAuthorizationHeaderMalformed, http.StatusBadRequest, msg)
+	}
+
+	credential := strings.Split(p.credential, "/")
+	if len(credential) != 5 || credential[4] != "aws4_request" {
+		return nil, malformed("malformed credential " + p.credential)
+	}
+	if p.signedHeaders == "" || p.signature == "" {
+		return nil, malformed("SignedHeaders and Signature are required")
+	}
+	signedHeaders := strings.Split(p.signedHeaders, ";")
+	if !contains(signedHeaders, "host") {
+		return nil, malformed("the host header must be signed")
+	}
+
+	date := r.Header.Get("X-Amz-Date")
+	if presigned {
+		date = r.URL.Query().Get("X-Amz-Date")
+	}
+	signTime, err := time.Parse(v4TimeFormat, date)
+	if err != nil {
+		return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "missing or invalid X-Amz-Date")
+	}
+	if credential[1] != signTime.Format(v4ShortTimeFormat) {
+		return nil, malformed("the credential date does not match X-Amz-Date")
+	}
+
+	result := &Result{
+		AccessKeyID:   credential[0],
+		SignerVersion: "V4",
+		Presigned:     presigned,
+		SignTime:      signTime,
+		Region:        credential[2],
+		Service:       credential[3],
+	}
+	if presigned {
+		expires, _ := strconv.ParseInt(r.URL.Query().Get("X-Amz-Expires"), 10, 64)
+		result.Expires = signTime.Add(time.Duration(expires) * time.Second)
+		now := v.now()
+		if now.After(result.Expires) {
+			return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "request has expired")
+		}
+		if signTime.After(now.Add(v.maxClockSkew)) {
+			return nil, newError(ErrCodeAccessDenied, http.StatusForbidden, "request is not valid yet")
+		}
+	} else if err := v.checkSkew(signTime); err != nil {
+		return nil, err
+	}
+
+	creds, err := v.credentials(r, result.AccessKeyID)
+	if err != nil {
+		return nil, err
+	}
+	key := v4.SigningKey(creds.SecretAccessKey, signTime, result.Region, result.Service)
+	scope := strings.Join(credential[1:], "/")
+	expected, err := v4.RequestSignature(r, key, signTime, scope, signedHeaders, presigned)
+	if err != nil {
+		return nil, apierr.NewRequestError(apierr.New("IncompleteBody", "failed to read the request body", err),
+			http.StatusBadRequest, "")
+	}
+	if !hmac.Equal([]byte(expected), []byte(p.signature)) {
+		return nil, newError(ErrCodeSignatureDoesNotMatch, http.StatusForbidden,
+			"the request signature we calculated does not match the signature you provided")
+	}
+
+	if !presigned {
+		v4.VerifyBody(r, key, signTime, scope, p.signature)
+	}
+	return result, nil
+}
+
+// credentials looks the credentials of the request up, and checks its
+// security token against the one of temporary credentials.
+func (v *Verifier) credentials(r *http.Request, accessKeyID string) (credentials.Value, error) {
+	creds, err := v.lookup(accessKeyID)
+	if err != nil {
+		return creds, apierr.NewRequestError(apierr.New(ErrCodeInvalidAccessKeyID,
+			"the access key ID you provided does not exist in our records", err), http.StatusForbidden, "")
+	}
+
+	token := r.Header.Get("X-Amz-Security-Token")
+	if token == "" {
+		token = r.URL.Query().Get("X-Amz-Security-Token")
+	}
+	if token == "" {
+		// The V2 signer presigns URLs with the lowercase query parameter.
+		token = r.URL.Query().Get("x-amz-security-token")
+	}
+	if token != creds.SessionToken {
+		return creds, newError(ErrCodeInvalidToken, http.StatusBadRequest, "the provided token is malformed or otherwise invalid")
+	}
+	return creds, nil
+}
+
+func (v *Verifier) checkSkew(signTime time.Time) error {
+	skew := v.now().Sub(signTime)
+	if skew > v.maxClockSkew || skew < -v.maxClockSkew {
+		return newError(ErrCodeRequestTimeTooSkewed, http.StatusForbidden,
+			"the difference between the request time and the current time is too large")
+	}
+	return nil
+}
+
+// bucketInHost returns the bucket addressed by host, a sub domain of one of
+// the endpoints.
+func (v *Verifier) bucketInHost(host string) string {
+	host = strings.ToLower(stripPort(host))
+	for _, endpoint := range v.endpoints {
+		if strings.HasSuffix(host, "."+endpoint) {
+			return strings.TrimSuffix(host, "."+endpoint)
+		}
+	}
+	return ""
+}
+
+func newError(code string, statusCode int, message string) error {
+	return apierr.NewRequestError(apierr.New(code, message, nil), statusCode, "")
+}
+
+func stripPort(hostport string) string {
+	if host, _, err := net.SplitHostPort(hostport); err == nil {
+		return host
+	}
+	return hostport
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package verifier
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+const endpoint = "ks3-cn-beijing.ksyuncs.com"
+
+func newClient(signerVersion string, pathStyle bool) *s3.S3 {
+	return s3.New(&aws.Config{
+		Region:           "BEIJING",
+		Endpoint:         endpoint,
+		SignerVersion:    signerVersion,
+		S3ForcePathStyle: pathStyle,
+		Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	})
+}
+
+func newVerifier() *Verifier {
+	return New(func(accessKeyID string) (credentials.Value, error) {
+		if accessKeyID != "AKID" {
+			return credentials.Value{}, errors.New("unknown key")
+		}
+		return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+	}, Config{Endpoints: []string{endpoint}})
+}
+
+// incoming signs r and returns it the way a server receives it.
+func incoming(t *testing.T, r *aws.Request) *http.Request {
+	assert.NoError(t, r.Sign())
+	return receive(t, r.HTTPRequest)
+}
+
+func receive(t *testing.T, req *http.Request) *http.Request {
+	var buf bytes.Buffer
+	assert.NoError(t, req.Write(&buf))
+	received, err := http.ReadRequest(bufio.NewReader(&buf))
+	assert.NoError(t, err)
+	return received
+}
+
+func assertCode(t *testing.T, err error, code string, statusCode int) {
+	if assert.Error(t, err) {
+		assert.Equal(t, code, err.(awserr.Error).Code())
+		if statusCode != 0 {
+			assert.Equal(t, statusCode, err.(awserr.RequestFailure).StatusCode())
+		}
+	}
+}
+
+func TestVerifyV2(t *testing.T) {
+	v := newVerifier()
+	for _, pathStyle := range []bool{false, true} {
+		r, _ := newClient("V2", pathStyle).PutObjectRequest(&s3.PutObjectInput{
+			Bucket:   aws.String("bucket"),
+			Key:      aws.String("dir/a b.txt"),
+			Body:     strings.NewReader("content"),
+			Metadata: map[string]*string{"Owner": aws.String("alice")},
+		})
+		result, err := v.Verify(incoming(t, r))
+		assert.NoError(t, err)
+		assert.Equal(t, "AKID", result.AccessKeyID)
+		assert.Equal(t, "V2", result.SignerVersion)
+		assert.False(t, result.Presigned)
+
+		r, _ = newClient("V2", pathStyle).GetObjectACLRequest(&s3.GetObjectACLInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		_, err = v.Verify(incoming(t, r))
+		assert.NoError(t, err)
+
+		r, _ = newClient("V2", pathStyle).ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String("bucket")})
+		_, err = v.Verify(incoming(t, r))
+		assert.NoError(t, err)
+	}
+
+	r, _ := newClient("V2", false).GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	req := incoming(t, r)
+	req.Header.Set("X-Amz-Meta-Owner", "mallory")
+	_, err := v.Verify(req)
+	assertCode(t, err, ErrCodeSignatureDoesNotMatch, http.StatusForbidden)
+}
+
+func TestVerifyV2Presigned(t *testing.T) {
+	v := newVerifier()
+	r, _ := newClient("V2", false).GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	url, _, err := r.Presign(15 * time.Minute)
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", url, nil)
+	result, err := v.Verify(receive(t, req))
+	assert.NoError(t, err)
+	assert.True(t, result.Presigned)
+	assert.Equal(t, r.Time.Add(15*time.Minute).Unix(), result.Expires.Unix())
+
+	v.now = func() time.Time { return r.Time.Add(time.Hour) }
+	_, err = v.Verify(receive(t, req))
+	assertCode(t, err, ErrCodeAccessDenied, http.StatusForbidden)
+}
+
+func TestVerifyV2PresignedSessionToken(t *testing.T) {
+	v := New(func(accessKeyID string) (credentials.Value, error) {
+		return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}, nil
+	}, Config{Endpoints: []string{endpoint}})
+	client := s3.New(&aws.Config{
+		Region:        "BEIJING",
+		Endpoint:      endpoint,
+		SignerVersion: "V2",
+		Credentials:   credentials.NewStaticCredentials("AKID", "SECRET", "TOKEN"),
+	})
+	r, _ := client.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	url, _, err := r.Presign(15 * time.Minute)
+	assert.NoError(t, err)
+	assert.Contains(t, url, "x-amz-security-token=TOKEN")
+
+	req, _ := http.NewRequest("GET", url, nil)
+	result, err := v.Verify(receive(t, req))
+	assert.NoError(t, err)
+	assert.True(t, result.Presigned)
+
+	req, _ = http.NewRequest("GET", strings.Replace(url, "TOKEN", "OTHER", 1), nil)
+	_, err = v.Verify(receive(t, req))
+	assertCode(t, err, ErrCodeInvalidToken, http.StatusBadRequest)
+}
+
+func TestVerifyV4(t *testing.T) {
+	v := newVerifier()
+	r, _ := newClient("V4", false).PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("dir/a b.txt"),
+		Body:   strings.NewReader("content"),
+	})
+	req := incoming(t, r)
+	result, err := v.Verify(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "V4", result.SignerVersion)
+	assert.Equal(t, "BEIJING", result.Region)
+	assert.Equal(t, "s3", result.Service)
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(body))
+
+	// The payload is checked while the body is read.
+	r, _ = newClient("V4", false).PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   strings.NewReader("content"),
+	})
+	assert.NoError(t, r.Sign())
+	r.HTTPRequest.Body = io.NopCloser(strings.NewReader("CONTENT"))
+	req = receive(t, r.HTTPRequest)
+	_, err = v.Verify(req)
+	assert.NoError(t, err)
+	_, err = io.ReadAll(req.Body)
+	assertCode(t, err, "XAmzContentSHA256Mismatch", 0)
+}
+
+func TestVerifyV4Streaming(t *testing.T) {
+	v := newVerifier()
+	content := strings.Repeat("0123456789", 10000)
+	newRequest := func() *aws.Request {
+		r, _ := newClient("V4", false).PutObjectRequest(&s3.PutObjectInput{
+			Bucket:        aws.String("bucket"),
+			Key:           aws.String("key"),
+			Body:          aws.StreamBody(strings.NewReader(content)),
+			ContentLength: aws.Long(int64(len(content))),
+		})
+		return r
+	}
+
+	req := incoming(t, newRequest())
+	assert.Equal(t, "STREAMING-AWS4-HMAC-SHA256-PAYLOAD", req.Header.Get("X-Amz-Content-Sha256"))
+	_, err := v.Verify(req)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(body))
+
+	r := newRequest()
+	assert.NoError(t, r.Sign())
+	encoded, err := io.ReadAll(r.HTTPRequest.Body)
+	assert.NoError(t, err)
+	encoded[len(encoded)/2] ^= 1
+	r.HTTPRequest.Body = io.NopCloser(bytes.NewReader(encoded))
+	req = receive(t, r.HTTPRequest)
+	_, err = v.Verify(req)
+	assert.NoError(t, err)
+	_, err = io.ReadAll(req.Body)
+	assertCode(t, err, ErrCodeSignatureDoesNotMatch, 0)
+}
+
+func TestVerifyV4Presigned(t *testing.T) {
+	v := newVerifier()
+	r, _ := newClient("V4", false).PutObjectRequest(&s3.PutObjectInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		Metadata: map[string]*string{"Owner": aws.String("alice")},
+	})
+	url, header, err := r.Presign(15 * time.Minute)
+	assert.NoError(t, err)
+
+	upload := func(header http.Header) *http.Request {
+		req, _ := http.NewRequest("PUT", url, strings.NewReader("content"))
+		req.Header = header
+		return receive(t, req)
+	}
+	result, err := v.Verify(upload(header))
+	assert.NoError(t, err)
+	assert.True(t, result.Presigned)
+	assert.Equal(t, r.Time.Add(15*time.Minute).Unix(), result.Expires.Unix())
+
+	_, err = v.Verify(upload(http.Header{}))
+	assertCode(t, err, ErrCodeSignatureDoesNotMatch, http.StatusForbidden)
+
+	v.now = func() time.Time { return r.Time.Add(time.Hour) }
+	_, err = v.Verify(upload(header))
+	assertCode(t, err, ErrCodeAccessDenied, http.StatusForbidden)
+}
+
+func TestVerifyErrors(t *testing.T) {
+	v := newVerifier()
+
+	req, _ := http.NewRequest("GET", "https://bucket."+endpoint+"/key", nil)
+	assert.False(t, Signed(req))
+	_, err := v.Verify(req)
+	assertCode(t, err, ErrCodeAccessDenied, http.StatusForbidden)
+
+	r, _ := newClient("V4", false).GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	req = incoming(t, r)
+	assert.True(t, Signed(req))
+	v.now = func() time.Time { return r.Time.Add(time.Hour) }
+	_, err = v.Verify(req)
+	assertCode(t, err, ErrCodeRequestTimeTooSkewed, http.StatusForbidden)
+
+	v = newVerifier()
+	r, _ = s3.New(&aws.Config{
+		Region:      "BEIJING",
+		Endpoint:    endpoint,
+		Credentials: credentials.NewStaticCredentials("OTHER", "SECRET", ""),
+	}).GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	_, err = v.Verify(incoming(t, r))
+	assertCode(t, err, ErrCodeInvalidAccessKeyID, http.StatusForbidden)
+
+	req, _ = http.NewRequest("GET", "https://bucket."+endpoint+"/key", nil)
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKID/20151229/BEIJING/s3")
+	_, err = v.Verify(req)
+	assertCode(t, err, ErrCodeAuthorizationHeaderMalformed, http.StatusBadRequest)
+}
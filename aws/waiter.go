@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awsutil"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+)
+
+// ErrCodeResourceNotReady is the error code returned by a waiter when a
+// failure acceptor matches, or when it runs out of attempts.
+const ErrCodeResourceNotReady = "ResourceNotReady"
+
+// A WaiterState is the state a waiter moves to when one of its acceptors
+// matches the outcome of a request.
+type WaiterState int
+
+const (
+	// SuccessWaiterState stops the waiter without error.
+	SuccessWaiterState WaiterState = iota
+	// FailureWaiterState stops the waiter with an ErrCodeResourceNotReady error.
+	FailureWaiterState
+	// RetryWaiterState polls again after a delay.
+	RetryWaiterState
+)
+
+// A WaiterMatchMode is the way an acceptor matches the outcome of a request.
+type WaiterMatchMode int
+
+const (
+	// PathAllWaiterMatch matches if all the values at the Argument path of the
+	// output are equal to Expected.
+	PathAllWaiterMatch WaiterMatchMode = iota
+	// PathWaiterMatch matches if the value at the Argument path of the output
+	// is equal to Expected. A nil Expected matches if the output has no value
+	// at the path.
+	PathWaiterMatch
+	// PathAnyWaiterMatch matches if any of the values at the Argument path of
+	// the output is equal to Expected.
+	PathAnyWaiterMatch
+	// PathContainsWaiterMatch matches if the string at the Argument path of the
+	// output contains the Expected string.
+	PathContainsWaiterMatch
+	// StatusWaiterMatch matches if the HTTP status code of the response is the
+	// Expected int.
+	StatusWaiterMatch
+	// ErrorWaiterMatch matches if the code of the error returned by the request
+	// is the Expected string.
+	ErrorWaiterMatch
+)
+
+// A WaiterAcceptor moves the waiter to State when the outcome of a request
+// matches.
+type WaiterAcceptor struct {
+	State    WaiterState
+	Matcher  WaiterMatchMode
+	Argument string
+	Expected interface{}
+}
+
+// A WaiterOption changes the settings of a Waiter.
+type WaiterOption func(*Waiter)
+
+// WithWaiterMaxAttempts sets the number of requests a waiter sends before
+// giving up, 0 meaning no limit other than the context of the waiter.
+func WithWaiterMaxAttempts(attempts int) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxAttempts = attempts
+	}
+}
+
+// WithWaiterDelay sets the bounds of the delay between two requests of a
+// waiter.
+func WithWaiterDelay(minDelay, maxDelay time.Duration) WaiterOption {
+	return func(w *Waiter) {
+		w.MinDelay = minDelay
+		w.MaxDelay = maxDelay
+	}
+}
+
+// A Waiter polls an operation until its outcome matches one of the acceptors
+// with a success or failure state.
+//
+// The delay between two requests starts at MinDelay, doubles on each attempt
+// up to MaxDelay, and is jittered between MinDelay and that bound.
+type Waiter struct {
+	Name        string
+	MaxAttempts int
+	MinDelay    time.Duration
+	MaxDelay    time.Duration
+	Acceptors   []WaiterAcceptor
+
+	// NewRequest returns a new request of the polled operation for each
+	// attempt.
+	NewRequest func() (*Request, error)
+}
+
+// ApplyOptions applies the options to the waiter.
+func (w *Waiter) ApplyOptions(opts ...WaiterOption) {
+	for _, opt := range opts {
+		opt(w)
+	}
+}
+
+// WaitWithContext polls until a success acceptor matches. It returns an
+// ErrCodeResourceNotReady error if a failure acceptor matches or if the
+// attempts run out, the error of the request if no acceptor matches it, and
+// an ErrCodeRequestCanceled error if ctx is done.
+func (w Waiter) WaitWithContext(ctx Context) error {
+	for attempt := 1; ; attempt++ {
+		req, err := w.NewRequest()
+		if err != nil {
+			return err
+		}
+		req.SetContext(ctx)
+		err = req.Send()
+
+		state, matched := RetryWaiterState, false
+		for _, a := range w.Acceptors {
+			if a.match(req, err) {
+				state, matched = a.State, true
+				break
+			}
+		}
+		if !matched && err != nil {
+			return err
+		}
+
+		switch state {
+		case SuccessWaiterState:
+			return nil
+		case FailureWaiterState:
+			return apierr.New(ErrCodeResourceNotReady,
+				fmt.Sprintf("%s: failed waiting for successful resource state", w.Name), err)
+		}
+
+		if w.MaxAttempts > 0 && attempt >= w.MaxAttempts {
+			return apierr.New(ErrCodeResourceNotReady,
+				fmt.Sprintf("%s: exceeded %d wait attempts", w.Name, w.MaxAttempts), err)
+		}
+		if err := sleepWithContext(ctx, w.delay(attempt)); err != nil {
+			return apierr.New(ErrCodeRequestCanceled, fmt.Sprintf("%s: waiter context canceled", w.Name), err)
+		}
+	}
+}
+
+// delay returns the jittered delay after the given attempt.
+func (w Waiter) delay(attempt int) time.Duration {
+	minDelay, maxDelay := w.MinDelay, w.MaxDelay
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+	ceiling := minDelay
+	for i := 1; i < attempt && ceiling > 0 && ceiling < maxDelay; i++ {
+		ceiling *= 2
+	}
+	if ceiling > maxDelay || ceiling <= 0 {
+		ceiling = maxDelay
+	}
+	if ceiling <= minDelay {
+		return minDelay
+	}
+	return minDelay + time.Duration(rand.Int63n(int64(ceiling-minDelay)+1))
+}
+
+func (a WaiterAcceptor) match(req *Request, err error) bool {
+	switch a.Matcher {
+	case StatusWaiterMatch:
+		expected, ok := a.Expected.(int)
+		return ok && req.HTTPResponse != nil && req.HTTPResponse.StatusCode == expected
+	case ErrorWaiterMatch:
+		var aerr awserr.Error
+		return errors.As(err, &aerr) && aerr.Code() == a.Expected
+	}
+
+	if err != nil {
+		return false
+	}
+	values := awsutil.ValuesAtPath(req.Data, a.Argument)
+	switch a.Matcher {
+	case PathWaiterMatch:
+		if len(values) == 0 {
+			return a.Expected == nil
+		}
+		return waiterValueEqual(values[0], a.Expected)
+	case PathAllWaiterMatch:
+		if len(values) == 0 {
+			return false
+		}
+		for _, v := range values {
+			if !waiterValueEqual(v, a.Expected) {
+				return false
+			}
+		}
+		return true
+	case PathAnyWaiterMatch:
+		for _, v := range values {
+			if waiterValueEqual(v, a.Expected) {
+				return true
+			}
+		}
+	case PathContainsWaiterMatch:
+		expected, _ := a.Expected.(string)
+		for _, v := range values {
+			rv := reflect.Indirect(reflect.ValueOf(v))
+			if rv.IsValid() && rv.Kind() == reflect.String && strings.Contains(rv.String(), expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// waiterValueEqual compares a value of an output, which is usually a pointer,
+// with the expected value of an acceptor.
+func waiterValueEqual(v, expected interface{}) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return expected == nil
+		}
+		rv = rv.Elem()
+	}
+	return reflect.DeepEqual(rv.Interface(), expected)
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+type waiterTestOutput struct {
+	State *string
+}
+
+// newWaiterTestServer answers the n-th request with statuses[n] and the
+// X-State header states[n], repeating the last ones.
+func newWaiterTestServer(statuses []int, states []string) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&count, 1)) - 1
+		if len(states) > 0 {
+			w.Header().Set("X-State", states[clamp(n, len(states))])
+		}
+		w.WriteHeader(statuses[clamp(n, len(statuses))])
+	}))
+	return server, &count
+}
+
+func clamp(i, n int) int {
+	if i >= n {
+		return n - 1
+	}
+	return i
+}
+
+func newWaiter(endpoint string, acceptors ...WaiterAcceptor) Waiter {
+	s := NewService(&Config{
+		Endpoint:    endpoint,
+		Region:      "mock-region",
+		MaxRetries:  -1,
+		RetryRule:   retry.DefaultNoDelayRetryRule,
+		ShouldRetry: retry.ShouldRetry,
+	})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		if state := r.HTTPResponse.Header.Get("X-State"); state != "" {
+			r.Data.(*waiterTestOutput).State = String(state)
+		}
+	})
+	return Waiter{
+		Name:        "WaitUntilTest",
+		MaxAttempts: 5,
+		MinDelay:    time.Millisecond,
+		MaxDelay:    2 * time.Millisecond,
+		Acceptors:   acceptors,
+		NewRequest: func() (*Request, error) {
+			return NewRequest(s, &Operation{Name: "Test", HTTPMethod: "GET", HTTPPath: "/"}, nil, &waiterTestOutput{}), nil
+		},
+	}
+}
+
+func TestWaiterStatus(t *testing.T) {
+	server, count := newWaiterTestServer([]int{404, 404, 200}, nil)
+	defer server.Close()
+
+	w := newWaiter(server.URL,
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: StatusWaiterMatch, Expected: 200},
+		WaiterAcceptor{State: RetryWaiterState, Matcher: StatusWaiterMatch, Expected: 404})
+	assert.NoError(t, w.WaitWithContext(context.Background()))
+	assert.Equal(t, int32(3), atomic.LoadInt32(count))
+}
+
+func TestWaiterPath(t *testing.T) {
+	server, count := newWaiterTestServer([]int{200}, []string{"New", "Active", `ongoing-request="false"`})
+	defer server.Close()
+
+	w := newWaiter(server.URL,
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathContainsWaiterMatch, Argument: "State", Expected: `ongoing-request="false"`},
+		WaiterAcceptor{State: RetryWaiterState, Matcher: PathAnyWaiterMatch, Argument: "State", Expected: "New"})
+	assert.NoError(t, w.WaitWithContext(context.Background()))
+	assert.Equal(t, int32(3), atomic.LoadInt32(count), "no acceptor matching without error retries")
+
+	server, _ = newWaiterTestServer([]int{200}, []string{"New", "Failed"})
+	defer server.Close()
+	w = newWaiter(server.URL,
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "State", Expected: "Complete"},
+		WaiterAcceptor{State: FailureWaiterState, Matcher: PathAllWaiterMatch, Argument: "State", Expected: "Failed"})
+	err := w.WaitWithContext(context.Background())
+	assert.Equal(t, ErrCodeResourceNotReady, err.(awserr.Error).Code())
+
+	server, count = newWaiterTestServer([]int{200}, nil)
+	defer server.Close()
+	w = newWaiter(server.URL,
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathContainsWaiterMatch, Argument: "State", Expected: `ongoing-request="false"`},
+		WaiterAcceptor{State: FailureWaiterState, Matcher: PathWaiterMatch, Argument: "State", Expected: nil})
+	err = w.WaitWithContext(context.Background())
+	assert.Equal(t, ErrCodeResourceNotReady, err.(awserr.Error).Code())
+	assert.Equal(t, int32(1), atomic.LoadInt32(count), "a missing value fails at once")
+}
+
+func TestWaiterErrors(t *testing.T) {
+	server, count := newWaiterTestServer([]int{404}, nil)
+	defer server.Close()
+
+	w := newWaiter(server.URL, WaiterAcceptor{State: RetryWaiterState, Matcher: StatusWaiterMatch, Expected: 404})
+	err := w.WaitWithContext(context.Background())
+	assert.Equal(t, ErrCodeResourceNotReady, err.(awserr.Error).Code())
+	assert.Equal(t, int32(5), atomic.LoadInt32(count))
+
+	// An error no acceptor matches is returned as is.
+	w = newWaiter(server.URL, WaiterAcceptor{State: SuccessWaiterState, Matcher: StatusWaiterMatch, Expected: 200})
+	err = w.WaitWithContext(context.Background())
+	assert.Equal(t, 404, err.(awserr.RequestFailure).StatusCode())
+
+	w = newWaiter(server.URL, WaiterAcceptor{State: RetryWaiterState, Matcher: ErrorWaiterMatch, Expected: "UnmarshaleError"})
+	w.ApplyOptions(WithWaiterMaxAttempts(0), WithWaiterDelay(time.Hour, time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = w.WaitWithContext(ctx)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+}
+
+func TestWaiterDelay(t *testing.T) {
+	w := Waiter{MinDelay: time.Second, MaxDelay: 10 * time.Second}
+	for attempt := 1; attempt < 10; attempt++ {
+		ceiling := time.Second << uint(attempt-1)
+		if ceiling > 10*time.Second {
+			ceiling = 10 * time.Second
+		}
+		for i := 0; i < 20; i++ {
+			d := w.delay(attempt)
+			assert.True(t, d >= time.Second && d <= ceiling, "attempt "+strconv.Itoa(attempt)+": "+d.String())
+		}
+	}
+	assert.Equal(t, time.Second, w.delay(1))
+}
@@ -26,3 +26,39 @@ func TestServicesInCN(t *testing.T) {
 		assert.Equal(t, name+"."+region+".amazonaws.com.cn", ep)
 	}
 }
+
+func TestKS3Endpoints(t *testing.T) {
+	ep, ok := KS3Endpoint("cn-beijing", false, false)
+	assert.True(t, ok)
+	assert.Equal(t, "ks3-cn-beijing.ksyuncs.com", ep)
+
+	ep, ok = KS3Endpoint("cn-shanghai", true, false)
+	assert.True(t, ok)
+	assert.Equal(t, "ks3-cn-shanghai-internal.ksyuncs.com", ep)
+
+	ep, ok = KS3Endpoint("cn-guangzhou", false, true)
+	assert.True(t, ok)
+	assert.Equal(t, "ks3-cn-accelerate.ksyuncs.com", ep)
+
+	_, ok = KS3Endpoint("ap-singapore-1", false, true)
+	assert.False(t, ok)
+	_, ok = KS3Endpoint("mock-region-1", false, false)
+	assert.False(t, ok)
+}
+
+func TestKS3RegionAliases(t *testing.T) {
+	for alias, region := range map[string]string{
+		"BEIJING":     "cn-beijing",
+		"HONGKONG":    "cn-hongkong-2",
+		"JR_SHANGHAI": "cn-shanghai-fin",
+		"CN-Beijing":  "cn-beijing",
+	} {
+		r, ok := KS3Region(alias)
+		assert.True(t, ok, alias)
+		assert.Equal(t, region, r, alias)
+	}
+
+	ep, _ := KS3Endpoint("SINGAPORE", false, false)
+	assert.Equal(t, "ks3-sgp.ksyuncs.com", ep)
+	assert.Contains(t, KS3Regions(), "cn-beijing")
+}
@@ -0,0 +1,122 @@
+package endpoints
+
+import (
+	"sort"
+	"strings"
+)
+
+// ks3Endpoint is the set of KS3 endpoints of a region.
+type ks3Endpoint struct {
+	Public     string
+	Internal   string
+	Accelerate string
+}
+
+// ks3AccelerateEndpoint is the transfer acceleration endpoint, shared by the
+// regions of mainland China.
+const ks3AccelerateEndpoint = "ks3-cn-accelerate.ksyuncs.com"
+
+var ks3Endpoints = map[string]ks3Endpoint{
+	"cn-beijing": {
+		Public:     "ks3-cn-beijing.ksyuncs.com",
+		Internal:   "ks3-cn-beijing-internal.ksyuncs.com",
+		Accelerate: ks3AccelerateEndpoint,
+	},
+	"cn-shanghai": {
+		Public:     "ks3-cn-shanghai.ksyuncs.com",
+		Internal:   "ks3-cn-shanghai-internal.ksyuncs.com",
+		Accelerate: ks3AccelerateEndpoint,
+	},
+	"cn-guangzhou": {
+		Public:     "ks3-cn-guangzhou.ksyuncs.com",
+		Internal:   "ks3-cn-guangzhou-internal.ksyuncs.com",
+		Accelerate: ks3AccelerateEndpoint,
+	},
+	"cn-qingyang": {
+		Public:   "ks3-cn-qingyang.ksyuncs.com",
+		Internal: "ks3-cn-qingyang-internal.ksyuncs.com",
+	},
+	"cn-hongkong-2": {
+		Public:   "ks3-cn-hk-1.ksyuncs.com",
+		Internal: "ks3-cn-hk-1-internal.ksyuncs.com",
+	},
+	"ap-singapore-1": {
+		Public:   "ks3-sgp.ksyuncs.com",
+		Internal: "ks3-sgp-internal.ksyuncs.com",
+	},
+	"eu-east-1": {
+		Public:   "ks3-rus.ksyuncs.com",
+		Internal: "ks3-rus-internal.ksyuncs.com",
+	},
+	"cn-beijing-fin": {
+		Public:   "ks3-jr-beijing.ksyuncs.com",
+		Internal: "ks3-jr-beijing-internal.ksyuncs.com",
+	},
+	"cn-shanghai-fin": {
+		Public:   "ks3-jr-shanghai.ksyuncs.com",
+		Internal: "ks3-jr-shanghai-internal.ksyuncs.com",
+	},
+	"cn-northwest-1": {
+		Public:   "ks3-gov-beijing.ksyuncs.com",
+		Internal: "ks3-gov-beijing-internal.ksyuncs.com",
+	},
+}
+
+// ks3RegionAliases maps the region names used by older SDKs and by the
+// documentation of KS3 to the region identifiers of the table.
+var ks3RegionAliases = map[string]string{
+	"beijing":     "cn-beijing",
+	"shanghai":    "cn-shanghai",
+	"guangzhou":   "cn-guangzhou",
+	"qingyang":    "cn-qingyang",
+	"hongkong":    "cn-hongkong-2",
+	"singapore":   "ap-singapore-1",
+	"sgp":         "ap-singapore-1",
+	"russia":      "eu-east-1",
+	"rus":         "eu-east-1",
+	"jr_beijing":  "cn-beijing-fin",
+	"jr_shanghai": "cn-shanghai-fin",
+	"gov_beijing": "cn-northwest-1",
+}
+
+// KS3Region returns the region identifier of the KS3 table region refers to,
+// either directly or through one of its aliases, such as BEIJING.
+func KS3Region(region string) (string, bool) {
+	region = strings.ToLower(region)
+	if alias, ok := ks3RegionAliases[region]; ok {
+		region = alias
+	}
+	_, ok := ks3Endpoints[region]
+	return region, ok
+}
+
+// KS3Endpoint returns the KS3 endpoint of region. The internal endpoint is
+// only reachable from the VPCs of the region, and the accelerate endpoint
+// is only available for some regions. ok is false if the region or the
+// variant is unknown.
+func KS3Endpoint(region string, internal, accelerate bool) (endpoint string, ok bool) {
+	region, ok = KS3Region(region)
+	if !ok {
+		return "", false
+	}
+	e := ks3Endpoints[region]
+	switch {
+	case accelerate:
+		endpoint = e.Accelerate
+	case internal:
+		endpoint = e.Internal
+	default:
+		endpoint = e.Public
+	}
+	return endpoint, endpoint != ""
+}
+
+// KS3Regions returns the sorted identifiers of the regions of the KS3 table.
+func KS3Regions() []string {
+	regions := make([]string, 0, len(ks3Endpoints))
+	for region := range ks3Endpoints {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
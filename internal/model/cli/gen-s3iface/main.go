@@ -0,0 +1,310 @@
+// Command gen-s3iface generates the S3API interface of the s3iface package,
+// and its mock, from the exported methods of s3.S3.
+//
+// The methods declared in files with build constraints are left out, so that
+// the interface builds with every Go version the SDK supports.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.\n\n"
+
+const s3ImportPath = "github.com/ks3sdklib/aws-sdk-go/service/s3"
+
+// A method is an exported method of s3.S3.
+type method struct {
+	name     string
+	params   []string // types of the parameters, qualified for the s3iface package
+	results  []string
+	variadic bool
+	imports  map[string]string
+}
+
+func main() {
+	path := flag.String("path", "../", "directory of the s3 package")
+	out := flag.String("out", ".", "directory of the s3iface package")
+	flag.Parse()
+
+	methods, err := parseMethods(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for name, src := range map[string][]byte{
+		"interface.go": generateInterface(methods),
+		"mock.go":      generateMock(methods),
+	} {
+		formatted, err := format.Source(src)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "format %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*out, name), formatted, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// parseMethods returns the exported methods of *S3 declared in the package in
+// dir, sorted by name.
+func parseMethods(dir string) ([]method, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	pkg := pkgs["s3"]
+	if pkg == nil {
+		return nil, fmt.Errorf("no s3 package in %s", dir)
+	}
+
+	var methods []method
+	for _, file := range pkg.Files {
+		if hasBuildConstraint(file) {
+			continue
+		}
+		imports := map[string]string{}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() || !isS3Receiver(fn.Recv) {
+				continue
+			}
+			m := method{name: fn.Name.Name, imports: map[string]string{}}
+			q := qualifier{imports: imports, used: m.imports}
+			for _, field := range fn.Type.Params.List {
+				if _, ok := field.Type.(*ast.Ellipsis); ok {
+					m.variadic = true
+				}
+				for i := 0; i < fieldCount(field); i++ {
+					m.params = append(m.params, q.expr(field.Type))
+				}
+			}
+			if fn.Type.Results != nil {
+				for _, field := range fn.Type.Results.List {
+					for i := 0; i < fieldCount(field); i++ {
+						m.results = append(m.results, q.expr(field.Type))
+					}
+				}
+			}
+			methods = append(methods, m)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+	return methods, nil
+}
+
+func hasBuildConstraint(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, c := range group.List {
+			if strings.HasPrefix(c.Text, "//go:build") || strings.HasPrefix(c.Text, "// +build") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isS3Receiver(recv *ast.FieldList) bool {
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "S3"
+}
+
+func fieldCount(field *ast.Field) int {
+	if len(field.Names) == 0 {
+		return 1
+	}
+	return len(field.Names)
+}
+
+// A qualifier prints type expressions of the s3 package the way they are
+// written in the s3iface package, and records the packages they use.
+type qualifier struct {
+	imports map[string]string
+	used    map[string]string
+}
+
+func (q qualifier) expr(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			q.used["s3"] = s3ImportPath
+			return "s3." + e.Name
+		}
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + q.expr(e.X)
+	case *ast.SelectorExpr:
+		pkg := e.X.(*ast.Ident).Name
+		q.used[pkg] = q.imports[pkg]
+		return pkg + "." + e.Sel.Name
+	case *ast.ArrayType:
+		if e.Len == nil {
+			return "[]" + q.expr(e.Elt)
+		}
+		return "[" + e.Len.(*ast.BasicLit).Value + "]" + q.expr(e.Elt)
+	case *ast.MapType:
+		return "map[" + q.expr(e.Key) + "]" + q.expr(e.Value)
+	case *ast.Ellipsis:
+		return "..." + q.expr(e.Elt)
+	case *ast.ChanType:
+		switch e.Dir {
+		case ast.SEND:
+			return "chan<- " + q.expr(e.Value)
+		case ast.RECV:
+			return "<-chan " + q.expr(e.Value)
+		}
+		return "chan " + q.expr(e.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.FuncType:
+		var params, results []string
+		for _, field := range e.Params.List {
+			for i := 0; i < fieldCount(field); i++ {
+				params = append(params, q.expr(field.Type))
+			}
+		}
+		if e.Results != nil {
+			for _, field := range e.Results.List {
+				for i := 0; i < fieldCount(field); i++ {
+					results = append(results, q.expr(field.Type))
+				}
+			}
+		}
+		return "func(" + strings.Join(params, ", ") + ")" + resultList(results)
+	}
+	panic(fmt.Sprintf("unsupported type expression %T", e))
+}
+
+func resultList(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	}
+	return " (" + strings.Join(results, ", ") + ")"
+}
+
+func (m method) signature() string {
+	return "(" + strings.Join(m.params, ", ") + ")" + resultList(m.results)
+}
+
+func writeImports(buf *bytes.Buffer, methods []method) {
+	paths := map[string]bool{}
+	for _, m := range methods {
+		for _, path := range m.imports {
+			paths[path] = true
+		}
+	}
+	var sorted []string
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+	// The standard library first, then the other packages.
+	sort.Slice(sorted, func(i, j int) bool {
+		si, sj := isStd(sorted[i]), isStd(sorted[j])
+		if si != sj {
+			return si
+		}
+		return sorted[i] < sorted[j]
+	})
+	buf.WriteString("import (\n")
+	for i, path := range sorted {
+		if i > 0 && isStd(path) != isStd(sorted[i-1]) {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func isStd(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+func generateInterface(methods []method) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("// Package s3iface provides an interface for the Amazon Simple Storage Service.\n")
+	buf.WriteString("//\n// The interface and its mock are generated from the methods of s3.S3 by\n")
+	buf.WriteString("// internal/model/cli/gen-s3iface, run with go generate.\npackage s3iface\n\n")
+	writeImports(&buf, methods)
+
+	buf.WriteString("// S3API is the interface type for s3.S3.\ntype S3API interface {\n")
+	for i, m := range methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "\t%s%s\n", m.name, m.signature())
+	}
+	buf.WriteString("}\n\nvar _ S3API = (*s3.S3)(nil)\n")
+	return buf.Bytes()
+}
+
+func generateMock(methods []method) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package s3iface\n\n")
+	writeImports(&buf, methods)
+
+	buf.WriteString("// MockS3API is an S3API for tests, whose methods call the function of the\n")
+	buf.WriteString("// field named after them, such as PutObjectFunc for PutObject. Calling a\n")
+	buf.WriteString("// method whose function is not set panics.\ntype MockS3API struct {\n")
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t%sFunc func%s\n", m.name, m.signature())
+	}
+	buf.WriteString("}\n\nvar _ S3API = (*MockS3API)(nil)\n")
+
+	for _, m := range methods {
+		var params, args []string
+		for i, typ := range m.params {
+			name := "p" + strconv.Itoa(i)
+			params = append(params, name+" "+typ)
+			if m.variadic && i == len(m.params)-1 {
+				name += "..."
+			}
+			args = append(args, name)
+		}
+		ret := "return "
+		if len(m.results) == 0 {
+			ret = ""
+		}
+		fmt.Fprintf(&buf, "\nfunc (m *MockS3API) %s(%s)%s {\n", m.name, strings.Join(params, ", "), resultList(m.results))
+		fmt.Fprintf(&buf, "\tif m.%sFunc == nil {\n\t\tpanic(\"s3iface: MockS3API.%sFunc is not set\")\n\t}\n", m.name, m.name)
+		fmt.Fprintf(&buf, "\t%sm.%sFunc(%s)\n}\n", ret, m.name, strings.Join(args, ", "))
+	}
+	return buf.Bytes()
+}
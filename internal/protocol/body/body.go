@@ -7,6 +7,14 @@ import (
 	"github.com/ks3sdklib/aws-sdk-go/internal/protocol/restxml"
 )
 
+// Named versions of the body protocol handlers.
+var (
+	BuildHandler          = aws.NamedHandler{Name: "body.Build", Fn: Build}
+	UnmarshalBodyHandler  = aws.NamedHandler{Name: "body.UnmarshalBody", Fn: UnmarshalBody}
+	UnmarshalMetaHandler  = aws.NamedHandler{Name: "body.UnmarshalMeta", Fn: UnmarshalMeta}
+	UnmarshalErrorHandler = aws.NamedHandler{Name: "body.UnmarshalError", Fn: UnmarshalError}
+)
+
 // Build builds the REST component of a service request.
 func Build(r *aws.Request) {
 	if r.ContentType == "application/json" {
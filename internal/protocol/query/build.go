@@ -11,6 +11,14 @@ import (
 	"github.com/ks3sdklib/aws-sdk-go/internal/protocol/query/queryutil"
 )
 
+// Named versions of the query protocol handlers.
+var (
+	BuildHandler          = aws.NamedHandler{Name: "query.Build", Fn: Build}
+	UnmarshalHandler      = aws.NamedHandler{Name: "query.Unmarshal", Fn: Unmarshal}
+	UnmarshalMetaHandler  = aws.NamedHandler{Name: "query.UnmarshalMeta", Fn: UnmarshalMeta}
+	UnmarshalErrorHandler = aws.NamedHandler{Name: "query.UnmarshalError", Fn: UnmarshalError}
+)
+
 // Build builds a request for an AWS Query service.
 func Build(r *aws.Request) {
 	body := url.Values{
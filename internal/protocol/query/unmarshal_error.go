@@ -1,11 +1,14 @@
 package query
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
 	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 	"io"
+	"net/http"
 	"regexp"
 	"strings"
 )
@@ -17,6 +20,7 @@ type XmlErrorResponse struct {
 	Message    string   `xml:"Message"`
 	Resource   string   `xml:"Resource"`
 	RequestID  string   `xml:"RequestId"`
+	HostID     string   `xml:"HostId"`
 }
 
 type Ks3BillJsonErrorResponse struct {
@@ -88,21 +92,54 @@ func UnmarshalError(r *aws.Request) {
 		return
 	}
 
-	err = xml.Unmarshal(body, &resp)
+	// STS的错误响应为<ErrorResponse><Error>...</Error><RequestId>...</RequestId></ErrorResponse>
+	if bytes.Contains(body, []byte("<ErrorResponse")) {
+		wrapped := &Ks3BillXmlErrorResponse{}
+		if err = xml.Unmarshal(body, wrapped); err == nil {
+			resp.Code, resp.Message, resp.RequestID = wrapped.Error.Code, wrapped.Error.Message, wrapped.RequestID
+		}
+	} else {
+		err = xml.Unmarshal(body, &resp)
+	}
 	resp.StatusCode = r.HTTPResponse.StatusCode
 
 	// head请求无法从body中获取request id，如果是head请求，则从header中获取
 	if resp.RequestID == "" && r.HTTPRequest.Method == "HEAD" {
 		resp.RequestID = r.HTTPResponse.Header.Get("X-Kss-Request-Id")
 	}
+	// head请求的错误响应没有body，根据状态码推断错误码
+	if resp.Code == "" && r.HTTPRequest.Method == "HEAD" {
+		resp.Code = headErrorCode(r)
+		resp.Message = http.StatusText(r.HTTPResponse.StatusCode)
+	}
 
 	if err != nil && err != io.EOF {
 		r.Error = apierr.New("Unmarshal", "failed to decode query XML error response", err)
 	} else {
-		r.Error = apierr.NewRequestError(
+		r.Error = awserr.NewServiceError(
 			apierr.New(resp.Code, resp.Message, nil),
 			r.HTTPResponse.StatusCode,
 			resp.RequestID,
+			resp.HostID,
+			resp.Resource,
 		)
 	}
 }
+
+// headErrorCode returns the error code of a HEAD error response, which has no
+// body, derived from its status code. A 404 is NoSuchKey for the operations
+// on an object, NoSuchBucket otherwise.
+func headErrorCode(r *aws.Request) string {
+	switch r.HTTPResponse.StatusCode {
+	case http.StatusNotFound:
+		if strings.Contains(r.Operation.HTTPPath, "{Key") {
+			return "NoSuchKey"
+		}
+		return "NoSuchBucket"
+	case http.StatusForbidden:
+		return "AccessDenied"
+	case http.StatusPreconditionFailed:
+		return "PreconditionFailed"
+	}
+	return ""
+}
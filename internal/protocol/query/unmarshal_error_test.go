@@ -0,0 +1,45 @@
+package query_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/protocol/query"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalErrorHead(t *testing.T) {
+	s := aws.NewService(&aws.Config{Endpoint: "http://localhost", Region: "mock-region"})
+	cases := []struct {
+		path   string
+		status int
+		code   awserr.ErrorCode
+	}{
+		{"/{Bucket}/{Key+}", http.StatusNotFound, s3.ErrNoSuchKey},
+		{"/{Bucket}", http.StatusNotFound, s3.ErrNoSuchBucket},
+		{"/{Bucket}/{Key+}", http.StatusForbidden, s3.ErrAccessDenied},
+		{"/{Bucket}/{Key+}", http.StatusPreconditionFailed, s3.ErrPreconditionFailed},
+	}
+	for _, c := range cases {
+		r := aws.NewRequest(s, &aws.Operation{Name: "Head", HTTPMethod: "HEAD", HTTPPath: c.path}, nil, nil)
+		r.HTTPResponse = &http.Response{
+			StatusCode: c.status,
+			Header:     http.Header{"X-Kss-Request-Id": []string{"request-id"}},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}
+		query.UnmarshalError(r)
+
+		assert.True(t, errors.Is(r.Error, c.code), "%s %d: %v", c.path, c.status, r.Error)
+		var reqErr *awserr.RequestError
+		if assert.True(t, errors.As(r.Error, &reqErr)) {
+			assert.Equal(t, c.status, reqErr.StatusCode())
+			assert.Equal(t, "request-id", reqErr.RequestID())
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Package sdk provides the utilities shared by the packages of the SDK.
+package sdk
+
+import "time"
+
+// NowTime returns the current time. The credentials providers and their
+// refreshing read the time through it, so that tests can stub it to control
+// when credentials expire.
+var NowTime = time.Now
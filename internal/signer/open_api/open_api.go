@@ -11,6 +11,10 @@ import (
 	"time"
 )
 
+// SignRequestHandler is a named request handler signing the requests of the
+// open API, such as the ones of the KS3 bill service.
+var SignRequestHandler = aws.NamedHandler{Name: "open_api.Sign", Fn: Sign}
+
 func Sign(req *aws.Request) {
 	if req.Config.Credentials == credentials.AnonymousCredentials {
 		return
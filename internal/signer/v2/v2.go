@@ -22,6 +22,7 @@ import (
 const (
 	authHeaderPrefix = "AWS"
 	timeFormat       = "Mon, 02 Jan 2006 15:04:05 GMT"
+	securityTokenKey = "x-amz-security-token"
 )
 
 var signQuerys = map[string]bool{
@@ -114,7 +115,16 @@ type signer struct {
 	awsRequest        *aws.Request
 }
 
+// SignRequestHandler is a named request handler signing requests with
+// signature version 2.
+var SignRequestHandler = aws.NamedHandler{Name: "v2.Sign", Fn: Sign}
+
 func Sign(req *aws.Request) {
+	if req.BodyLengthUnknown() {
+		// Sent with chunked transfer encoding, KS3 would reject it.
+		req.Error = aws.ErrMissingContentLength
+		return
+	}
 	if req.Service.Config.Credentials == credentials.AnonymousCredentials {
 		return
 	}
@@ -143,6 +153,10 @@ func Sign(req *aws.Request) {
 		Logger:      req.Service.Config.Logger,
 		awsRequest:  req,
 	}
+	if expiry := req.PresignExpiry(); expiry > 0 {
+		// V2 signs the time at which the URL expires.
+		s.ExpireTime = req.Time.Add(expiry).Unix()
+	}
 
 	req.Error = s.sign()
 }
@@ -175,6 +189,19 @@ func (v2 *signer) sign() error {
 		return err
 	}
 
+	// The session token of temporary credentials is sent, and signed, as the
+	// x-amz-security-token header, or query parameter of presigned URLs.
+	delete(v2.Request.Header, securityTokenKey)
+	v2.Request.Header.Del(securityTokenKey)
+	v2.Query.Del(securityTokenKey)
+	if token := v2.CredValues.SessionToken; token != "" {
+		if v2.isPresign {
+			v2.Query.Set(securityTokenKey, token)
+		} else {
+			v2.Request.Header[securityTokenKey] = []string{token}
+		}
+	}
+
 	v2.build()
 
 	v2.logSigningInfo()
@@ -184,13 +211,19 @@ func (v2 *signer) sign() error {
 
 func (v2 *signer) logSigningInfo() {
 	cfg := v2.Service.Config
-	cfg.LogDebug("%s", "---[ STRING TO SIGN ]--------------------------------")
-	cfg.LogDebug("%s", v2.stringToSign)
+	if cfg.LogLevel < aws.Debug {
+		return
+	}
+	stringToSign, signedURL := v2.stringToSign, v2.Request.URL.String()
+	if cfg.RedactLog() {
+		stringToSign = aws.RedactHeaderLines(stringToSign)
+		signedURL = aws.RedactURL(v2.Request.URL)
+	}
+	msg := "---[ STRING TO SIGN ]--------------------------------\n" + stringToSign
 	if v2.isPresign {
-		cfg.LogDebug("---[ SIGNED URL ]--------------------------------")
-		cfg.LogDebug("%s", v2.Request.URL)
+		msg += "\n---[ SIGNED URL ]--------------------------------\n" + signedURL
 	}
-	cfg.LogDebug("-----------------------------------------------------")
+	v2.awsRequest.Log(aws.Debug, msg+"\n-----------------------------------------------------")
 }
 
 func (v2 *signer) build() {
@@ -229,22 +262,36 @@ func (v2 *signer) buildCanonicalHeaders() {
 			key := strings.ToLower(http.CanonicalHeaderKey(k))
 			v2.Request.Header[key] = v
 			v2.Request.Header.Del(http.CanonicalHeaderKey(k))
-			headers = append(headers, key)
 		}
 	}
+	for k := range v2.Request.Header {
+		// Including the headers lowered when the request was signed before.
+		if strings.HasPrefix(k, "x-amz-") {
+			headers = append(headers, k)
+		}
+	}
+	if token := v2.Query.Get(securityTokenKey); token != "" {
+		// Presigned URLs carry the session token in the query string, it is
+		// signed as the header.
+		headers = append(headers, securityTokenKey)
+	}
 	sort.Strings(headers)
 
 	headerValues := make([]string, len(headers))
 	for i, k := range headers {
 		key := strings.ToLower(http.CanonicalHeaderKey(k))
-		headerValues[i] = key + ":" + strings.Join(v2.Request.Header[key], ",")
+		values := v2.Request.Header[key]
+		if key == securityTokenKey && v2.isPresign {
+			values = v2.Query[securityTokenKey]
+		}
+		headerValues[i] = key + ":" + strings.Join(values, ",")
 	}
 
 	v2.canonicalHeaders = strings.Join(headerValues, "\n")
 }
 
 func (v2 *signer) buildCanonicalResource() {
-	endpoint := v2.Service.Endpoint
+	endpoint := v2.awsRequest.EndpointURL()
 
 	v2.Request.URL.RawQuery = strings.Replace(v2.Query.Encode(), "+", "%20", -1)
 	url := v2.Request.URL.String()
@@ -373,7 +420,14 @@ func (v2 *signer) removePresign() {
 	v2.Query.Del("AWSAccessKeyId")
 	v2.Query.Del("Signature")
 	v2.Query.Del("Expires")
+	v2.Query.Del(securityTokenKey)
+
+}
 
+// SignPolicy returns the Signature field of a browser POST upload, policy
+// being the base64 encoded POST policy.
+func SignPolicy(secret, policy string) string {
+	return string(base64Encode(makeHmac([]byte(secret), []byte(policy))))
 }
 
 func makeHmac(key []byte, data []byte) []byte {
@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRequest(method string) *aws.Request {
+	svc := aws.NewService(&aws.Config{
+		Endpoint:    "http://ks3-cn-beijing.ksyuncs.com",
+		Region:      "BEIJING",
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+	})
+	svc.ServiceName = "s3"
+	svc.Handlers.Sign.PushBackNamed(SignRequestHandler)
+	r := aws.NewRequest(svc, &aws.Operation{Name: "Operation", HTTPMethod: method, HTTPPath: "/bucket/key"}, nil, nil)
+	r.Time = time.Unix(0, 0)
+	return r
+}
+
+func expectedSignature(stringToSign string) string {
+	mac := hmac.New(sha1.New, []byte("SECRET"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestSignSessionToken(t *testing.T) {
+	r := newTestRequest("PUT")
+	r.HTTPRequest.Header.Set("X-Amz-Meta-Owner", "alice")
+	assert.NoError(t, r.Sign())
+
+	assert.Equal(t, []string{"SESSION"}, r.HTTPRequest.Header["x-amz-security-token"])
+	stringToSign := "PUT\n\n\nThu, 01 Jan 1970 00:00:00 GMT\n" +
+		"x-amz-meta-owner:alice\nx-amz-security-token:SESSION\n/bucket/key"
+	assert.Equal(t, "AWS AKID:"+expectedSignature(stringToSign), r.HTTPRequest.Header.Get("Authorization"))
+}
+
+func TestPresignSessionToken(t *testing.T) {
+	r := newTestRequest("GET")
+	url, _, err := r.Presign(time.Minute)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	query := r.HTTPRequest.URL.Query()
+	assert.Equal(t, "SESSION", query.Get("x-amz-security-token"), url)
+	assert.Empty(t, r.HTTPRequest.Header["x-amz-security-token"])
+	stringToSign := "GET\n\n\n60\nx-amz-security-token:SESSION\n/bucket/key"
+	assert.Equal(t, expectedSignature(stringToSign), query.Get("Signature"))
+}
@@ -0,0 +1,94 @@
+package v2
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// RequestSignature returns the signature of an incoming request, computed the
+// way the signer computes it on the client side. bucketInHost is the bucket
+// addressed by the host of the request, if any.
+func RequestSignature(r *http.Request, secret, bucketInHost string, presigned bool) string {
+	date := r.Header.Get("Date")
+	if presigned {
+		date = r.URL.Query().Get("Expires")
+	} else if r.Header.Get("X-Amz-Date") != "" {
+		// The x-amz-date header is signed among the canonical headers.
+		date = ""
+	}
+
+	header := r.Header
+	if token := r.URL.Query().Get(securityTokenKey); presigned && token != "" {
+		// Presigned URLs carry the session token in the query string, it is
+		// signed as the header.
+		header = header.Clone()
+		header.Set(securityTokenKey, token)
+	}
+
+	signItems := []string{r.Method, r.Header.Get("Content-Md5"), r.Header.Get("Content-Type"), date}
+	if headers := canonicalAmzHeaders(header); headers != "" {
+		signItems = append(signItems, headers)
+	}
+	signItems = append(signItems, canonicalResource(r, bucketInHost))
+
+	return string(base64Encode(makeHmac([]byte(secret), []byte(strings.Join(signItems, "\n")))))
+}
+
+func canonicalAmzHeaders(header http.Header) string {
+	var names []string
+	for k := range header {
+		if strings.HasPrefix(strings.ToLower(k), "x-amz-") {
+			names = append(names, k)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+
+	lines := make([]string, len(names))
+	for i, k := range names {
+		lines[i] = strings.ToLower(k) + ":" + strings.Join(header[k], ",")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// canonicalResource returns the path of the request prefixed by the bucket
+// of its host, followed by the sub-resources of its query.
+func canonicalResource(r *http.Request, bucketInHost string) string {
+	uri := r.URL.EscapedPath()
+	if bucketInHost != "" {
+		if uri == "" {
+			uri = "/"
+		}
+		uri = "/" + bucketInHost + uri
+	} else if strings.Count(uri, "/") == 1 && len(uri) > 1 {
+		// A bucket addressed in the path is followed by a slash.
+		uri += "/"
+	}
+	if uri == "" {
+		uri = "/"
+	}
+
+	query := r.URL.Query()
+	var keys []string
+	for k := range query {
+		if signQuerys[k] {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return uri
+	}
+	sort.Strings(keys)
+
+	values := make([]string, len(keys))
+	for i, k := range keys {
+		if v := strings.Join(query[k], ","); v != "" {
+			values[i] = k + "=" + v
+		} else {
+			values[i] = k
+		}
+	}
+	return uri + "?" + strings.Join(values, "&")
+}
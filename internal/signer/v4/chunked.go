@@ -0,0 +1,116 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// streamingPayload is the payload hash of requests whose body is signed
+	// chunk by chunk.
+	streamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+	chunkAlgorithm = "AWS4-HMAC-SHA256-PAYLOAD"
+
+	// chunkSize is the size of the chunks of a streamed body, except for the
+	// last ones.
+	chunkSize = 64 * 1024
+)
+
+var emptySha256 = hex.EncodeToString(makeSha256(nil))
+
+// chunkedReader encodes a body with the aws-chunked content encoding, each
+// chunk carrying a signature chained to the one of the previous chunk, the
+// first one to the signature of the request.
+type chunkedReader struct {
+	src      io.ReadCloser
+	key      []byte
+	time     string
+	scope    string
+	seed     string
+	prevSig  string
+	buf      []byte
+	out      bytes.Buffer
+	finished bool
+}
+
+func newChunkedReader(src io.ReadCloser, key []byte, time, scope, seedSignature string) *chunkedReader {
+	return &chunkedReader{
+		src:     src,
+		key:     key,
+		time:    time,
+		scope:   scope,
+		seed:    seedSignature,
+		prevSig: seedSignature,
+		buf:     make([]byte, chunkSize),
+	}
+}
+
+// reset restarts the encoding, once the source was seeked back to the start
+// of the body to send it again.
+func (c *chunkedReader) reset() {
+	c.prevSig = c.seed
+	c.out.Reset()
+	c.finished = false
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for c.out.Len() == 0 {
+		if c.finished {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(c.src, c.buf)
+		switch err {
+		case nil:
+			c.writeChunk(c.buf[:n])
+		case io.EOF, io.ErrUnexpectedEOF:
+			if n > 0 {
+				c.writeChunk(c.buf[:n])
+			}
+			c.writeChunk(nil)
+			c.finished = true
+		default:
+			return 0, err
+		}
+	}
+	return c.out.Read(p)
+}
+
+func (c *chunkedReader) Close() error {
+	return c.src.Close()
+}
+
+func (c *chunkedReader) writeChunk(data []byte) {
+	stringToSign := strings.Join([]string{
+		chunkAlgorithm,
+		c.time,
+		c.scope,
+		c.prevSig,
+		emptySha256,
+		hex.EncodeToString(makeSha256(data)),
+	}, "\n")
+	c.prevSig = hex.EncodeToString(makeHmac(c.key, []byte(stringToSign)))
+
+	fmt.Fprintf(&c.out, "%x;chunk-signature=%s\r\n", len(data), c.prevSig)
+	c.out.Write(data)
+	c.out.WriteString("\r\n")
+}
+
+// chunkedLength returns the length of a body of decodedLength bytes once
+// encoded by a chunkedReader.
+func chunkedLength(decodedLength int64) int64 {
+	// Each chunk is "<hex size>;chunk-signature=<64 hex digits>\r\n<data>\r\n".
+	chunkOverhead := func(size int64) int64 {
+		return int64(len(strconv.FormatInt(size, 16))) + int64(len(";chunk-signature=")) + 64 + 4
+	}
+	full := decodedLength / chunkSize
+	length := full * (chunkSize + chunkOverhead(chunkSize))
+	if rest := decodedLength % chunkSize; rest > 0 {
+		length += rest + chunkOverhead(rest)
+	}
+	return length + chunkOverhead(0)
+}
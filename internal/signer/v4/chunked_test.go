@@ -0,0 +1,192 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/aws/retry"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestChunkedReaderSignatures checks the chunk signatures of the example of
+// the STREAMING-AWS4-HMAC-SHA256-PAYLOAD documentation.
+func TestChunkedReaderSignatures(t *testing.T) {
+	v4 := signer{
+		Region:             "us-east-1",
+		ServiceName:        "s3",
+		CredValues:         credentials.Value{SecretAccessKey: "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"},
+		formattedShortTime: "20130524",
+	}
+	data := bytes.Repeat([]byte{'a'}, 65*1024)
+	r := newChunkedReader(ioutil.NopCloser(bytes.NewReader(data)), v4.signingKey(), "20130524T000000Z",
+		"20130524/us-east-1/s3/aws4_request", "4f232c4386841ef735655705268965c44a0e4690baa4adea153f7db9fa80a0a9")
+	encoded, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(66824), chunkedLength(int64(len(data))))
+	assert.Equal(t, 66824, len(encoded))
+
+	sigs := regexp.MustCompile(`([0-9a-f]+);chunk-signature=([0-9a-f]{64})\r\n`).FindAllStringSubmatch(string(encoded), -1)
+	if assert.Len(t, sigs, 3) {
+		assert.Equal(t, []string{"10000", "ad80c730a21e5b8d04586a2213dd63b9a0e99e0e2307b0ade35a65485a288648"}, sigs[0][1:])
+		assert.Equal(t, []string{"400", "0055627c9e194cb4542bae2aa5492e3c1575bbb81b612b7d234b86a503ef5497"}, sigs[1][1:])
+		assert.Equal(t, []string{"0", "b6c6ea8a5354eaf15b3cb7646744f4275b71ea724fed81ceb9323e279d449df9"}, sigs[2][1:])
+	}
+}
+
+func TestChunkedLength(t *testing.T) {
+	for _, n := range []int64{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 17} {
+		data := bytes.Repeat([]byte{'b'}, int(n))
+		r := newChunkedReader(ioutil.NopCloser(bytes.NewReader(data)), []byte("key"), "t", "s", "seed")
+		encoded, _ := ioutil.ReadAll(r)
+		assert.Equal(t, int64(len(encoded)), chunkedLength(n), "length %d", n)
+	}
+}
+
+func newStreamingRequest(body io.Reader, contentLength string) *aws.Request {
+	svc := aws.NewService(&aws.Config{
+		Credentials:   credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:        "cn-beijing",
+		SignerVersion: "V4",
+	})
+	svc.ServiceName = "s3"
+	r := aws.NewRequest(svc, &aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.SetReaderBody(aws.StreamBody(body))
+	if contentLength != "" {
+		r.HTTPRequest.Header.Set("Content-Length", contentLength)
+	}
+	r.Time = time.Unix(1700000000, 0)
+	aws.BuildContentLength(r)
+	Sign(r)
+	return r
+}
+
+func TestSignStreamingBodyUnknownLength(t *testing.T) {
+	r := newStreamingRequest(strings.NewReader("hello world"), "")
+	assert.Equal(t, aws.ErrMissingContentLength, r.Error)
+	assert.Empty(t, r.HTTPRequest.Header.Get("Authorization"))
+}
+
+func TestSignStreamingBody(t *testing.T) {
+	r := newStreamingRequest(strings.NewReader("hello world"), "11")
+	assert.NoError(t, r.Error)
+
+	h := r.HTTPRequest.Header
+	assert.Equal(t, streamingPayload, h.Get("X-Amz-Content-Sha256"))
+	assert.Equal(t, "aws-chunked", h.Get("Content-Encoding"))
+	assert.Contains(t, h.Get("Authorization"), "content-encoding")
+	assert.Equal(t, "11", h.Get("X-Amz-Decoded-Content-Length"))
+	assert.Equal(t, chunkedLength(11), r.HTTPRequest.ContentLength)
+
+	// Signing again, after the credentials expired, keeps the encoding.
+	r.Service.Config.Credentials.Expire()
+	aws.BuildContentLength(r)
+	Sign(r)
+	assert.Equal(t, "11", h.Get("X-Amz-Decoded-Content-Length"))
+	assert.Equal(t, "aws-chunked", h.Get("Content-Encoding"))
+	assert.Equal(t, chunkedLength(11), r.HTTPRequest.ContentLength)
+
+	encoded, err := ioutil.ReadAll(r.HTTPRequest.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, chunkedLength(11), int64(len(encoded)))
+	assert.Regexp(t, "^b;chunk-signature=[0-9a-f]{64}\r\nhello world\r\n0;chunk-signature=[0-9a-f]{64}\r\n\r\n$", string(encoded))
+}
+
+// countingBody counts the bytes read from a seekable body.
+type countingBody struct {
+	r    *strings.Reader
+	read int
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += n
+	return n, err
+}
+
+func (b *countingBody) Seek(offset int64, whence int) (int64, error) {
+	return b.r.Seek(offset, whence)
+}
+
+// sendWithRetry sends a signed PUT of body to a server failing the first
+// attempt, and returns the payloads it received, decoded and checked. The
+// payload hash, if any, is set as computed while the body was read before.
+func sendWithRetry(t *testing.T, body io.ReadSeeker, threshold int64, payloadHash string) []string {
+	signTime := time.Unix(1700000000, 0)
+	key := SigningKey("SECRET", signTime, "cn-beijing", "s3")
+	scope := signTime.UTC().Format(shortTimeFormat) + "/cn-beijing/s3/aws4_request"
+
+	var payloads []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		seed := auth[strings.LastIndex(auth, "Signature=")+len("Signature="):]
+		VerifyBody(r, key, signTime, scope, seed)
+		payload, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		payloads = append(payloads, string(payload))
+		if len(payloads) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	svc := aws.NewService(&aws.Config{
+		Endpoint:               server.URL,
+		Credentials:            credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:                 "cn-beijing",
+		SignerVersion:          "V4",
+		StreamingSignThreshold: threshold,
+		MaxRetries:             1,
+		RetryRule:              retry.DefaultNoDelayRetryRule,
+		ShouldRetry:            retry.ShouldRetry,
+	})
+	svc.ServiceName = "s3"
+	svc.Handlers.Sign.PushBackNamed(SignRequestHandler)
+	svc.Handlers.UnmarshalError.PushBack(func(r *aws.Request) {
+		r.Error = apierr.NewRequestError(apierr.New("InternalError", "server error", nil), r.HTTPResponse.StatusCode, "")
+	})
+	r := aws.NewRequest(svc, &aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.SetReaderBody(body)
+	r.Time = signTime
+	if payloadHash != "" {
+		assert.NoError(t, r.Build())
+		r.SetPayloadHash(payloadHash)
+	}
+	assert.NoError(t, r.Send())
+	if threshold == 0 {
+		assert.Equal(t, hex.EncodeToString(makeSha256([]byte(payloads[0]))), r.PayloadHash())
+	}
+	return payloads
+}
+
+func TestSignLargeBodyStreaming(t *testing.T) {
+	data := strings.Repeat("large body ", 2*chunkSize/10)
+
+	// With the digest computed while reading the body, it is only read to be
+	// sent.
+	body := &countingBody{r: strings.NewReader(data)}
+	hash := hex.EncodeToString(makeSha256([]byte(data)))
+	assert.Equal(t, []string{data, data}, sendWithRetry(t, body, 0, hash))
+	assert.Equal(t, 2*len(data), body.read)
+
+	// Without it, the signer hashes the body once for the two attempts.
+	body = &countingBody{r: strings.NewReader(data)}
+	assert.Equal(t, []string{data, data}, sendWithRetry(t, body, 0, ""))
+	assert.Equal(t, 3*len(data), body.read)
+
+	// Over the threshold, it is only read to be sent.
+	body = &countingBody{r: strings.NewReader(data)}
+	assert.Equal(t, []string{data, data}, sendWithRetry(t, body, chunkSize, ""))
+	assert.Equal(t, 2*len(data), body.read)
+}
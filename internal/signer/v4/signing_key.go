@@ -0,0 +1,50 @@
+package v4
+
+import (
+	"crypto/sha256"
+	"sync"
+)
+
+// maxCachedSigningKeys bounds the number of signing keys kept by the cache.
+// A key is valid for a day, so the cache only grows with the number of
+// credentials, regions and services in use; it is emptied when full.
+const maxCachedSigningKeys = 256
+
+// signingKeyScope identifies a signing key. The digest of the secret is part
+// of it, so that rotated credentials do not reuse the key of the previous
+// secret, without keeping the secrets themselves in the cache.
+type signingKeyScope struct {
+	secret    [sha256.Size]byte
+	shortTime string
+	region    string
+	service   string
+}
+
+// signingKeys caches the signing keys, which are derived by four HMACs each
+// time otherwise.
+var signingKeys = struct {
+	sync.Mutex
+	keys map[signingKeyScope][]byte
+}{keys: map[signingKeyScope][]byte{}}
+
+// cachedSigningKey returns the signing key of the secret for the day, region
+// and service, deriving it on first use.
+func cachedSigningKey(secret, shortTime, regionName, serviceName string) []byte {
+	scope := signingKeyScope{secret: sha256.Sum256([]byte(secret)), shortTime: shortTime, region: regionName, service: serviceName}
+
+	signingKeys.Lock()
+	key, ok := signingKeys.keys[scope]
+	signingKeys.Unlock()
+	if ok {
+		return key
+	}
+
+	key = deriveSigningKey(secret, shortTime, regionName, serviceName)
+	signingKeys.Lock()
+	if len(signingKeys.keys) >= maxCachedSigningKeys {
+		signingKeys.keys = map[signingKeyScope][]byte{}
+	}
+	signingKeys.keys[scope] = key
+	signingKeys.Unlock()
+	return key
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
 	"github.com/ks3sdklib/aws-sdk-go/internal/protocol/rest"
 
 	"github.com/ks3sdklib/aws-sdk-go/aws"
@@ -51,6 +52,7 @@ type signer struct {
 
 	isPresign          bool
 	isSignBody         bool
+	isStreaming        bool
 	formattedTime      string
 	formattedShortTime string
 
@@ -63,12 +65,22 @@ type signer struct {
 	authorization    string
 }
 
+// SignRequestHandler is a named request handler signing requests with
+// signature version 4.
+var SignRequestHandler = aws.NamedHandler{Name: "v4.Sign", Fn: Sign}
+
 // Sign requests with signature version 4.
 //
 // Will sign the requests with the service config's Credentials object
 // Signing is skipped if the credentials is the credentials.AnonymousCredentials
 // object.
 func Sign(req *aws.Request) {
+	if req.BodyLengthUnknown() {
+		// Streamed chunk by chunk, the payload still requires the
+		// x-amz-decoded-content-length header.
+		req.Error = aws.ErrMissingContentLength
+		return
+	}
 	// If the request does not need to be signed ignore the signing of the
 	// request if the AnonymousCredentials object is used.
 	if req.Service.Config.Credentials == credentials.AnonymousCredentials {
@@ -99,6 +111,13 @@ func Sign(req *aws.Request) {
 		Logger:      req.Service.Config.Logger,
 		awsRequest:  req,
 	}
+	if expiry := req.PresignExpiry(); expiry > 0 {
+		if expiry > aws.MaxPresignExpiry {
+			req.Error = apierr.New("InvalidParameter", "presign expiry must not exceed 7 days in V4 signature", nil)
+			return
+		}
+		s.ExpireTime = int64(expiry / time.Second)
+	}
 	if req.Service.Config.SignerVersion == "V4_UNSIGNED_PAYLOAD_SIGNER" {
 		s.isSignBody = false
 	} else {
@@ -115,7 +134,11 @@ func (v4 *signer) sign() error {
 	if v4.isRequestSigned() {
 		if !v4.Credentials.IsExpired() {
 			// If the request is already signed, and the credentials have not
-			// expired yet ignore the signing request.
+			// expired yet ignore the signing request. A streamed body is
+			// encoded again from its start for the retry.
+			if chunked, ok := v4.Request.Body.(*chunkedReader); ok {
+				chunked.reset()
+			}
 			return nil
 		}
 
@@ -146,8 +169,18 @@ func (v4 *signer) sign() error {
 		v4.Request.Header.Set("X-Amz-Security-Token", v4.CredValues.SessionToken)
 	}
 
+	v4.isStreaming = !v4.isPresign && v4.isSignBody && v4.awsRequest.SignType != "policy" &&
+		(!v4.awsRequest.BodySeekable() || v4.streamsLargeBody())
+	if v4.isStreaming {
+		v4.buildStreamingHeaders()
+	}
+
 	v4.build()
 
+	if v4.isStreaming {
+		v4.buildStreamingBody()
+	}
+
 	v4.logSigningInfo()
 
 	return nil
@@ -155,15 +188,21 @@ func (v4 *signer) sign() error {
 
 func (v4 *signer) logSigningInfo() {
 	cfg := v4.Service.Config
-	cfg.LogDebug("%s", "---[ CANONICAL STRING ]-----------------------------")
-	cfg.LogDebug("%s", v4.canonicalString)
-	cfg.LogDebug("%s", "---[ STRING TO SIGN ]--------------------------------")
-	cfg.LogDebug("%s", v4.stringToSign)
+	if cfg.LogLevel < aws.Debug {
+		return
+	}
+	canonicalString, stringToSign, signedURL := v4.canonicalString, v4.stringToSign, v4.Request.URL.String()
+	if cfg.RedactLog() {
+		canonicalString = aws.RedactHeaderLines(canonicalString)
+		stringToSign = aws.RedactHeaderLines(stringToSign)
+		signedURL = aws.RedactURL(v4.Request.URL)
+	}
+	msg := "---[ CANONICAL STRING ]-----------------------------\n" + canonicalString +
+		"\n---[ STRING TO SIGN ]--------------------------------\n" + stringToSign
 	if v4.isPresign {
-		cfg.LogDebug("---[ SIGNED URL ]--------------------------------")
-		cfg.LogDebug("%s", v4.Request.URL)
+		msg += "\n---[ SIGNED URL ]--------------------------------\n" + signedURL
 	}
-	cfg.LogDebug("-----------------------------------------------------")
+	v4.awsRequest.Log(aws.Debug, msg+"\n-----------------------------------------------------")
 }
 
 func (v4 *signer) build() {
@@ -313,15 +352,90 @@ func (v4 *signer) buildStringToSign() {
 }
 
 func (v4 *signer) buildSignature() {
-	secret := v4.CredValues.SecretAccessKey
-	date := makeHmac([]byte("AWS4"+secret), []byte(v4.formattedShortTime))
-	region := makeHmac(date, []byte(v4.Region))
-	service := makeHmac(region, []byte(v4.ServiceName))
-	credentials := makeHmac(service, []byte("aws4_request"))
-	signature := makeHmac(credentials, []byte(v4.stringToSign))
+	signature := makeHmac(v4.signingKey(), []byte(v4.stringToSign))
 	v4.signature = hex.EncodeToString(signature)
 }
 
+func (v4 *signer) signingKey() []byte {
+	return cachedSigningKey(v4.CredValues.SecretAccessKey, v4.formattedShortTime, v4.Region, v4.ServiceName)
+}
+
+func deriveSigningKey(secret, shortTime, regionName, serviceName string) []byte {
+	date := makeHmac([]byte("AWS4"+secret), []byte(shortTime))
+	region := makeHmac(date, []byte(regionName))
+	service := makeHmac(region, []byte(serviceName))
+	return makeHmac(service, []byte("aws4_request"))
+}
+
+// PostCredential returns the x-amz-credential and x-amz-date fields of a
+// browser POST upload signed at signTime.
+func PostCredential(accessKeyID, region, serviceName string, signTime time.Time) (credential, date string) {
+	scope := strings.Join([]string{
+		signTime.UTC().Format(shortTimeFormat),
+		region,
+		serviceName,
+		"aws4_request",
+	}, "/")
+	return accessKeyID + "/" + scope, signTime.UTC().Format(timeFormat)
+}
+
+// SignPolicy returns the x-amz-signature field of a browser POST upload,
+// policy being the base64 encoded POST policy.
+func SignPolicy(secret, region, serviceName string, signTime time.Time, policy string) string {
+	key := cachedSigningKey(secret, signTime.UTC().Format(shortTimeFormat), region, serviceName)
+	return hex.EncodeToString(makeHmac(key, []byte(policy)))
+}
+
+// streamsLargeBody returns if the seekable body of the request is long enough
+// to be signed chunk by chunk while being sent, rather than read once to be
+// hashed and once more to be sent.
+func (v4 *signer) streamsLargeBody() bool {
+	threshold := v4.Service.Config.StreamingSignThreshold
+	if threshold <= 0 {
+		return false
+	}
+	hash := v4.Request.Header.Get("X-Amz-Content-Sha256")
+	if hash == streamingPayload {
+		// Signed again: the length is the one of the body before encoding.
+		return true
+	}
+	return hash == "" && v4.Request.ContentLength >= threshold
+}
+
+// buildStreamingHeaders sets the headers of a request whose body cannot seek,
+// so that it is signed chunk by chunk while being sent.
+func (v4 *signer) buildStreamingHeaders() {
+	v4.Request.Header.Set("X-Amz-Content-Sha256", streamingPayload)
+
+	encodings := v4.Request.Header.Get("Content-Encoding")
+	if encodings == "" {
+		v4.Request.Header.Set("Content-Encoding", "aws-chunked")
+	} else if !strings.HasPrefix(encodings, "aws-chunked") {
+		v4.Request.Header.Set("Content-Encoding", "aws-chunked,"+encodings)
+	}
+
+	decoded := v4.Request.ContentLength
+	if s := v4.Request.Header.Get("X-Amz-Decoded-Content-Length"); s != "" {
+		// The request was signed before, its length is already encoded.
+		decoded, _ = strconv.ParseInt(s, 10, 64)
+	}
+	v4.Request.Header.Set("X-Amz-Decoded-Content-Length", strconv.FormatInt(decoded, 10))
+	v4.Request.ContentLength = chunkedLength(decoded)
+	v4.Request.Header.Set("Content-Length", strconv.FormatInt(v4.Request.ContentLength, 10))
+}
+
+// buildStreamingBody wraps the body of the request to encode and sign its
+// chunks, seeded with the signature of the request.
+func (v4 *signer) buildStreamingBody() {
+	body := v4.Request.Body
+	if chunked, ok := body.(*chunkedReader); ok {
+		// The request is signed again before any of its body was sent.
+		body = chunked.src
+	}
+	v4.Request.Body = newChunkedReader(body, v4.signingKey(), v4.formattedTime,
+		v4.credentialString, v4.signature)
+}
+
 func (v4 *signer) bodyDigest() string {
 	hash := v4.Request.Header.Get("X-Amz-Content-Sha256")
 	if hash == "" {
@@ -329,10 +443,14 @@ func (v4 *signer) bodyDigest() string {
 			hash = "UNSIGNED-PAYLOAD"
 		} else {
 			if v4.isSignBody {
-				if v4.Body == nil {
-					hash = hex.EncodeToString(makeSha256([]byte{}))
-				} else {
-					hash = hex.EncodeToString(makeSha256Reader(v4.Body))
+				hash = v4.awsRequest.PayloadHash()
+				if hash == "" {
+					if v4.Body == nil {
+						hash = hex.EncodeToString(makeSha256([]byte{}))
+					} else {
+						hash = hex.EncodeToString(makeSha256Reader(v4.Body))
+					}
+					v4.awsRequest.SetPayloadHash(hash)
 				}
 			} else {
 				hash = "UNSIGNED-PAYLOAD"
@@ -1,7 +1,9 @@
 package v4
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -18,10 +20,18 @@ func buildSigner(serviceName string, region string, signTime time.Time, expireTi
 	req.URL.Opaque = "//example.org/bucket/key-._~,!@#$%^&*()"
 	req.Header.Add("X-Amz-Target", "prefix.Operation")
 	req.Header.Add("Content-Type", "application/x-amz-json-1.0")
-	req.Header.Add("Content-Length", string(len(body)))
+	req.Header.Add("Content-Length", strconv.Itoa(len(body)))
 	req.Header.Add("X-Amz-Meta-Other-Header", "some-value=!@#$%^&* (+)")
 
+	svc := aws.NewService(&aws.Config{Region: region})
+	svc.ServiceName = serviceName
+	r := aws.NewRequest(svc, &aws.Operation{Name: "Operation", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+	r.HTTPRequest = req
+	r.SetReaderBody(reader)
+
 	return signer{
+		Service:     svc,
+		awsRequest:  r,
 		Request:     req,
 		Time:        signTime,
 		ExpireTime:  int64(expireTime),
@@ -30,6 +40,7 @@ func buildSigner(serviceName string, region string, signTime time.Time, expireTi
 		ServiceName: serviceName,
 		Region:      region,
 		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		isSignBody:  true,
 	}
 }
 
@@ -218,3 +229,87 @@ func BenchmarkSignRequest(b *testing.B) {
 		signer.sign()
 	}
 }
+
+func TestPresignExpiry(t *testing.T) {
+	svc := aws.NewService(&aws.Config{
+		Region:      "BEIJING",
+		Endpoint:    "https://ks3-cn-beijing.ksyuncs.com",
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	})
+	svc.ServiceName = "s3"
+	svc.Handlers.Sign.PushBack(Sign)
+
+	r := aws.NewRequest(svc, &aws.Operation{Name: "UploadPart", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.HTTPRequest.Header.Set("X-Amz-Meta-Owner", "alice")
+	r.HTTPRequest.Header.Set("Content-Md5", "1B2M2Y8AsgTpgAmY7PhCfg==")
+	url, header, err := r.Presign(15 * time.Minute)
+	assert.NoError(t, err)
+
+	q := r.HTTPRequest.URL.Query()
+	assert.Equal(t, "900", q.Get("X-Amz-Expires"))
+	assert.Equal(t, r.Time.UTC().Format(timeFormat), q.Get("X-Amz-Date"))
+	assert.Equal(t, "1B2M2Y8AsgTpgAmY7PhCfg==", q.Get("Content-Md5"))
+	assert.NotEmpty(t, q.Get("X-Amz-Signature"))
+	assert.Equal(t, r.HTTPRequest.URL.String(), url)
+	assert.Equal(t, http.Header{"X-Amz-Meta-Owner": {"alice"}}, header)
+
+	r = aws.NewRequest(svc, &aws.Operation{Name: "HeadObject", HTTPMethod: "HEAD", HTTPPath: "/bucket/key"}, nil, nil)
+	_, _, err = r.Presign(aws.MaxPresignExpiry + time.Second)
+	assert.Error(t, err)
+}
+
+func TestSignPolicy(t *testing.T) {
+	signTime := time.Date(2015, 12, 29, 0, 0, 0, 0, time.UTC)
+	credential, date := PostCredential("AKID", "us-east-1", "s3", signTime)
+	assert.Equal(t, "AKID/20151229/us-east-1/s3/aws4_request", credential)
+	assert.Equal(t, "20151229T000000Z", date)
+
+	assert.Equal(t, "b029594ad7b89ce29911e66f56452050c8d68e552e36eb378e0eb587b3ebadaa",
+		SignPolicy("SECRET", "us-east-1", "s3", signTime, "eyJjb25kaXRpb25zIjpbXX0="))
+}
+
+func TestCachedSigningKey(t *testing.T) {
+	key := cachedSigningKey("SECRET", "20261018", "cn-beijing-6", "s3")
+	assert.Equal(t, deriveSigningKey("SECRET", "20261018", "cn-beijing-6", "s3"), key)
+	assert.True(t, &key[0] == &cachedSigningKey("SECRET", "20261018", "cn-beijing-6", "s3")[0], "the key is derived once")
+
+	// A rotated secret, or another day, gets its own key.
+	assert.Equal(t, deriveSigningKey("SECRET2", "20261018", "cn-beijing-6", "s3"),
+		cachedSigningKey("SECRET2", "20261018", "cn-beijing-6", "s3"))
+	assert.Equal(t, deriveSigningKey("SECRET", "20261019", "cn-beijing-6", "s3"),
+		cachedSigningKey("SECRET", "20261019", "cn-beijing-6", "s3"))
+
+	signingKeys.Lock()
+	defer signingKeys.Unlock()
+	for scope := range signingKeys.keys {
+		assert.NotContains(t, fmt.Sprintf("%+v", scope), "SECRET", "the secrets are not kept")
+	}
+}
+
+type fieldsLogger struct {
+	fields [][]aws.LogField
+}
+
+func (l *fieldsLogger) Log(level uint, msg string, fields ...aws.LogField) {
+	l.fields = append(l.fields, fields)
+}
+
+func TestLogSigningInfoFields(t *testing.T) {
+	logger := &fieldsLogger{}
+	r := aws.NewRequest(
+		aws.NewService(&aws.Config{
+			Credentials:   credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Region:        "cn-beijing-6",
+			LogLevel:      aws.Debug,
+			LeveledLogger: logger,
+		}),
+		&aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"},
+		nil,
+		nil,
+	)
+	Sign(r)
+
+	if assert.Len(t, logger.fields, 1) {
+		assert.Contains(t, logger.fields[0], aws.LogField{Key: aws.LogFieldOperation, Value: "PutObject"})
+	}
+}
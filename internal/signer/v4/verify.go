@@ -0,0 +1,250 @@
+package v4
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/protocol/rest"
+)
+
+// maxChunkSize bounds the size of the chunks accepted from a streamed body.
+const maxChunkSize = 16 * 1024 * 1024
+
+// SigningKey returns the key deriving the signatures of the requests signed
+// on the day of signTime for the region and the service.
+func SigningKey(secret string, signTime time.Time, region, serviceName string) []byte {
+	return deriveSigningKey(secret, signTime.UTC().Format(shortTimeFormat), region, serviceName)
+}
+
+// RequestSignature returns the signature of an incoming request, computed the
+// way the signer computes it on the client side. signTime is the time of the
+// X-Amz-Date value, scope the credential scope without the access key, and
+// signedHeaders the lower case names listed by the request.
+func RequestSignature(r *http.Request, key []byte, signTime time.Time, scope string, signedHeaders []string, presigned bool) (string, error) {
+	serviceName := ""
+	if parts := strings.Split(scope, "/"); len(parts) == 4 {
+		serviceName = parts[2]
+	}
+
+	uri := r.URL.EscapedPath()
+	if serviceName != "s3" {
+		uri = rest.EscapePath(r.URL.Path, false)
+	}
+	if uri == "" {
+		uri = "/"
+	}
+
+	query := r.URL.Query()
+	query.Del("X-Amz-Signature")
+
+	headerValues := make([]string, len(signedHeaders))
+	for i, name := range signedHeaders {
+		switch name {
+		case "host":
+			headerValues[i] = "host:" + r.Host
+		case "content-length":
+			headerValues[i] = "content-length:" + strconv.FormatInt(r.ContentLength, 10)
+		default:
+			headerValues[i] = name + ":" + strings.Join(r.Header[http.CanonicalHeaderKey(name)], ",")
+		}
+	}
+
+	payload, err := payloadHash(r, signedHeaders, serviceName, presigned)
+	if err != nil {
+		return "", err
+	}
+
+	canonicalString := strings.Join([]string{
+		r.Method,
+		uri,
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		strings.Join(headerValues, "\n") + "\n",
+		strings.Join(signedHeaders, ";"),
+		payload,
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		authHeaderPrefix,
+		signTime.UTC().Format(timeFormat),
+		scope,
+		hex.EncodeToString(makeSha256([]byte(canonicalString))),
+	}, "\n")
+	return hex.EncodeToString(makeHmac(key, []byte(stringToSign))), nil
+}
+
+// payloadHash returns the payload hash a client signed for the request,
+// hashing its body when the client did not send the hash.
+func payloadHash(r *http.Request, signedHeaders []string, serviceName string, presigned bool) (string, error) {
+	if presigned && serviceName == "s3" {
+		for _, name := range signedHeaders {
+			if name == "x-amz-content-sha256" {
+				return r.Header.Get("X-Amz-Content-Sha256"), nil
+			}
+		}
+		return "UNSIGNED-PAYLOAD", nil
+	}
+	if hash := r.Header.Get("X-Amz-Content-Sha256"); hash != "" {
+		return hash, nil
+	}
+	if r.Body == nil || r.Body == http.NoBody {
+		return emptySha256, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return hex.EncodeToString(makeSha256(body)), nil
+}
+
+// VerifyBody replaces the body of a request whose signature was verified so
+// that reading it checks the payload against the X-Amz-Content-Sha256 value.
+// A streamed body is decoded and the signature of each of its chunks checked,
+// seedSignature being the signature of the request.
+func VerifyBody(r *http.Request, key []byte, signTime time.Time, scope, seedSignature string) {
+	if r.Body == nil {
+		return
+	}
+	payload := r.Header.Get("X-Amz-Content-Sha256")
+	switch {
+	case payload == streamingPayload:
+		r.Body = &chunkedDecoder{
+			src:     bufio.NewReader(r.Body),
+			closer:  r.Body,
+			key:     key,
+			time:    signTime.UTC().Format(timeFormat),
+			scope:   scope,
+			prevSig: seedSignature,
+		}
+		r.ContentLength = -1
+		if n, err := strconv.ParseInt(r.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64); err == nil {
+			r.ContentLength = n
+		}
+	case len(payload) == sha256.Size*2:
+		if expected, err := hex.DecodeString(payload); err == nil {
+			r.Body = &hashCheckReader{src: r.Body, hash: sha256.New(), expected: expected}
+		}
+	}
+}
+
+// hashCheckReader fails at the end of the body if its SHA-256 digest is not
+// the expected one.
+type hashCheckReader struct {
+	src      io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+}
+
+func (h *hashCheckReader) Read(p []byte) (int, error) {
+	n, err := h.src.Read(p)
+	h.hash.Write(p[:n])
+	if err == io.EOF && !hmac.Equal(h.hash.Sum(nil), h.expected) {
+		return n, apierr.New("XAmzContentSHA256Mismatch",
+			"the provided x-amz-content-sha256 header does not match the computed hash of the body", nil)
+	}
+	return n, err
+}
+
+func (h *hashCheckReader) Close() error {
+	return h.src.Close()
+}
+
+// chunkedDecoder decodes a body encoded with the aws-chunked content encoding,
+// the way a chunkedReader encodes it, and checks the chained signature of each
+// chunk.
+type chunkedDecoder struct {
+	src      *bufio.Reader
+	closer   io.Closer
+	key      []byte
+	time     string
+	scope    string
+	prevSig  string
+	data     []byte
+	finished bool
+	err      error
+}
+
+func (d *chunkedDecoder) Read(p []byte) (int, error) {
+	for len(d.data) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		if d.finished {
+			return 0, io.EOF
+		}
+		d.err = d.next()
+	}
+	n := copy(p, d.data)
+	d.data = d.data[n:]
+	return n, nil
+}
+
+func (d *chunkedDecoder) Close() error {
+	return d.closer.Close()
+}
+
+// next reads the next chunk, "<hex size>;chunk-signature=<signature>\r\n"
+// followed by the data and "\r\n".
+func (d *chunkedDecoder) next() error {
+	line, err := d.src.ReadSlice('\n')
+	if err != nil {
+		return d.malformed(err)
+	}
+	header := strings.TrimSuffix(string(line), "\r\n")
+	sizeHex, signature, ok := cut(header, ";chunk-signature=")
+	if !ok {
+		return d.malformed(nil)
+	}
+	size, err := strconv.ParseInt(sizeHex, 16, 64)
+	if err != nil || size < 0 || size > maxChunkSize {
+		return d.malformed(err)
+	}
+
+	data := make([]byte, size+2)
+	if _, err := io.ReadFull(d.src, data); err != nil {
+		return d.malformed(err)
+	}
+	if !bytes.HasSuffix(data, []byte("\r\n")) {
+		return d.malformed(nil)
+	}
+	data = data[:size]
+
+	stringToSign := strings.Join([]string{
+		chunkAlgorithm,
+		d.time,
+		d.scope,
+		d.prevSig,
+		emptySha256,
+		hex.EncodeToString(makeSha256(data)),
+	}, "\n")
+	expected := hex.EncodeToString(makeHmac(d.key, []byte(stringToSign)))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return apierr.New("SignatureDoesNotMatch", "the signature of a chunk of the body does not match", nil)
+	}
+	d.prevSig = signature
+	d.data = data
+	d.finished = size == 0
+	return nil
+}
+
+func (d *chunkedDecoder) malformed(err error) error {
+	return apierr.New("IncompleteBody", "malformed aws-chunked body", err)
+}
+
+// cut slices s around the first instance of sep.
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
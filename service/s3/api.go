@@ -394,38 +394,31 @@ func (c *S3) DeleteBucketPrefixWithContext(ctx aws.Context, input *DeleteBucketP
 	if input == nil {
 		input = &DeleteBucketPrefixInput{}
 	}
-	marker := aws.String("")
-	maxKeys := aws.Long(100)
+	maxKeys := int64(100)
 	if input.MaxKeys != nil {
-		maxKeys = input.MaxKeys
+		maxKeys = *input.MaxKeys
 	}
-	for {
-		resp, err := c.ListObjectsWithContext(ctx, &ListObjectsInput{
-			Bucket:  input.Bucket,
-			Prefix:  input.Prefix,
-			Marker:  marker,
-			MaxKeys: maxKeys,
-		})
-		if err == nil {
-			for _, t := range resp.Contents {
-				_, err := c.DeleteObjectWithContext(ctx, &DeleteObjectInput{Bucket: input.Bucket, Key: t.Key})
-				if input.IsReTurnResults != nil && *input.IsReTurnResults == true {
-					if err != nil {
-						aerr, _ := err.(awserr.Error)
-						errors = append(errors, &Error{Key: t.Key, Code: aws.String(aerr.Code()), Message: aws.String(aerr.Message())})
-						output.Errors = errors
-					} else {
-						okList = append(okList, &DeletedObject{Key: t.Key})
-						output.Deleted = okList
-					}
+	pager := c.NewListObjectsPaginator(&ListObjectsInput{
+		Bucket: input.Bucket,
+		Prefix: input.Prefix,
+	}, aws.WithPageSize(maxKeys))
+	for pager.HasMorePages() {
+		resp, err := pager.Next(ctx)
+		if err != nil {
+			return output, err
+		}
+		for _, t := range resp.Contents {
+			_, err := c.DeleteObjectWithContext(ctx, &DeleteObjectInput{Bucket: input.Bucket, Key: t.Key})
+			if input.IsReTurnResults != nil && *input.IsReTurnResults == true {
+				if err != nil {
+					aerr, _ := err.(awserr.Error)
+					errors = append(errors, &Error{Key: t.Key, Code: aws.String(aerr.Code()), Message: aws.String(aerr.Message())})
+					output.Errors = errors
+				} else {
+					okList = append(okList, &DeletedObject{Key: t.Key})
+					output.Deleted = okList
 				}
 			}
-			if *resp.IsTruncated == false {
-				break
-			}
-			marker = resp.Contents[*maxKeys-1].Key
-		} else {
-			return output, err
 		}
 	}
 	return output, nil
@@ -492,7 +485,7 @@ func (c *S3) GetBucketLocationRequest(input *GetBucketLocationInput) (req *aws.R
 	}
 
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.PushFront(buildGetBucketLocation)
+	req.Handlers.Unmarshal.PushFrontNamed(GetBucketLocationHandler)
 	output = &GetBucketLocationOutput{}
 	req.Data = output
 	return
@@ -1455,7 +1448,7 @@ func (c *S3) PutObjectRequest(input *PutObjectInput) (req *aws.Request, output *
 
 	req = c.newRequest(op, input, output)
 	if c.Config.CrcCheckEnabled {
-		req.Handlers.CheckCrc64.PushBack(CheckUploadCrc64)
+		req.Handlers.CheckCrc64.PushBackNamed(CheckUploadCrc64Handler)
 	}
 	if input.ProgressFn != nil {
 		req.ProgressFn = input.ProgressFn
@@ -1493,7 +1486,7 @@ func (c *S3) PutReaderRequest(input *PutReaderInput) (req *aws.Request, output *
 
 	req = c.newRequest(op, input, output)
 	if c.Config.CrcCheckEnabled {
-		req.Handlers.CheckCrc64.PushBack(CheckUploadCrc64)
+		req.Handlers.CheckCrc64.PushBackNamed(CheckUploadCrc64Handler)
 	}
 	if input.ProgressFn != nil {
 		req.ProgressFn = input.ProgressFn
@@ -1552,17 +1545,12 @@ func (c *S3) GeneratePresignedUrl(input *GeneratePresignedUrlInput) (url string,
 		return "", apierr.New("InvalidParameter", "Expires is required and must bigger than 0", nil)
 	}
 
-	if IsV4Signature(c.Config.SignerVersion) {
-		if input.Expires > 604800 {
-			return "", apierr.New("InvalidParameter", "Expires must between 1 and 604800 in V4 signature", nil)
-		}
-		req.ExpireTime = input.Expires
-	} else {
-		req.ExpireTime = input.Expires + time.Now().Unix()
+	if IsV4Signature(c.Config.SignerVersion) && input.Expires > 604800 {
+		return "", apierr.New("InvalidParameter", "Expires must between 1 and 604800 in V4 signature", nil)
 	}
-	req.Sign()
 
-	return req.HTTPRequest.URL.String(), nil
+	url, _, err = req.Presign(time.Duration(input.Expires) * time.Second)
+	return url, err
 }
 
 // GeneratePresignedUrlInput generates a presigned url for the object
@@ -1574,14 +1562,8 @@ func (c *S3) GeneratePresignedUrlInput(input *GeneratePresignedUrlInput) (url st
 	}
 	output := &GeneratePresignedUrlOutput{}
 	req := c.newRequest(opGeneratePresigned, input, output)
-	now := time.Now().Unix()
-	if c.Config.SignerVersion == "V4" || c.Config.SignerVersion == "V4_UNSIGNED_PAYLOAD_SIGNER" {
-		req.ExpireTime = input.Expires
-	} else {
-		req.ExpireTime = input.Expires + now
-	}
-	req.Sign()
-	return req.HTTPRequest.URL.String()
+	url, _, _ = req.Presign(time.Duration(input.Expires) * time.Second)
+	return url
 }
 
 func (c *S3) PutObjectPreassignedInput(input *PutObjectInput) (*http.Request, error) {
@@ -1668,7 +1650,7 @@ func (c *S3) UploadPartRequest(input *UploadPartInput) (req *aws.Request, output
 
 	req = c.newRequest(op, input, output)
 	if c.Config.CrcCheckEnabled {
-		req.Handlers.CheckCrc64.PushBack(CheckUploadCrc64)
+		req.Handlers.CheckCrc64.PushBackNamed(CheckUploadCrc64Handler)
 	}
 	if input.ProgressFn != nil {
 		req.ProgressFn = input.ProgressFn
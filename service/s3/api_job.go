@@ -419,6 +419,11 @@ func (c *S3) ListJobsRequest(input *ListJobsInput) (req *aws.Request, output *Li
 		Name:       "ListJobs",
 		HTTPMethod: "GET",
 		HTTPPath:   "/?jobs",
+		Paginator: &aws.Paginator{
+			InputTokens:  []string{"NextToken"},
+			OutputTokens: []string{"ListJobsResult.NextToken"},
+			LimitToken:   "MaxResults",
+		},
 	}
 
 	if input == nil {
@@ -9,6 +9,10 @@ import (
 
 var reBucketLocation = regexp.MustCompile(`>([^<>]+)<\/LocationConstraint`)
 
+// GetBucketLocationHandler is a named request handler unmarshaling the
+// location of the bucket from the response of GetBucketLocation.
+var GetBucketLocationHandler = aws.NamedHandler{Name: "s3.GetBucketLocation", Fn: buildGetBucketLocation}
+
 func buildGetBucketLocation(r *aws.Request) {
 	if r.DataFilled() {
 		out := r.Data.(*GetBucketLocationOutput)
@@ -149,7 +149,10 @@ func (c *S3) CopyFile(request *CopyFileInput) (*CopyFileOutput, error) {
 }
 
 func (c *S3) CopyFileWithContext(ctx context.Context, request *CopyFileInput) (*CopyFileOutput, error) {
-	return newCopier(c, ctx, request).copyFile()
+	ctx, span := c.startTransferSpan(ctx, "s3.CopyFile", request)
+	resp, err := newCopier(c, ctx, request).copyFile()
+	endSpan(span, err)
+	return resp, err
 }
 
 func (c *S3) CopyFileAcrossRegion(request *CopyFileInput, dstClient *S3) (*UploadFileOutput, error) {
@@ -157,6 +160,13 @@ func (c *S3) CopyFileAcrossRegion(request *CopyFileInput, dstClient *S3) (*Uploa
 }
 
 func (c *S3) CopyFileAcrossRegionWithContext(ctx context.Context, request *CopyFileInput, dstClient *S3) (*UploadFileOutput, error) {
+	ctx, span := c.startTransferSpan(ctx, "s3.CopyFileAcrossRegion", request)
+	resp, err := c.copyFileAcrossRegion(ctx, request, dstClient)
+	endSpan(span, err)
+	return resp, err
+}
+
+func (c *S3) copyFileAcrossRegion(ctx context.Context, request *CopyFileInput, dstClient *S3) (*UploadFileOutput, error) {
 	uploadFileRequest, err := c.buildUploadFileRequest(ctx, request)
 	if err != nil {
 		return nil, err
@@ -599,7 +609,9 @@ func (c *Copier) runTask(tasks <-chan CopyPartTask, wg *sync.WaitGroup) {
 			return
 		}
 
-		partETag, err := c.copyPart(task)
+		ctx, span := c.client.startPartSpan(c.context, task.partNumber, task.actualPartSize)
+		partETag, err := c.copyPart(ctx, task)
+		endSpan(span, err)
 		if err != nil {
 			c.setError(err)
 			return
@@ -609,14 +621,14 @@ func (c *Copier) runTask(tasks <-chan CopyPartTask, wg *sync.WaitGroup) {
 	}
 }
 
-func (c *Copier) copyPart(task CopyPartTask) (CompletedPart, error) {
+func (c *Copier) copyPart(ctx context.Context, task CopyPartTask) (CompletedPart, error) {
 	request := c.copyFileRequest
 	ccp := c.copyCheckpoint
 	var partETag CompletedPart
 
 	start := task.offset
 	end := task.offset + task.actualPartSize - 1
-	resp, err := c.client.UploadPartCopyWithContext(c.context, &UploadPartCopyInput{
+	resp, err := c.client.UploadPartCopyWithContext(ctx, &UploadPartCopyInput{
 		Bucket:                         aws.String(ccp.BucketName),
 		Key:                            aws.String(ccp.ObjectKey),
 		SourceBucket:                   aws.String(ccp.SrcBucketName),
@@ -8,6 +8,10 @@ import (
 	"strconv"
 )
 
+// CheckUploadCrc64Handler is a named request handler checking the CRC64 of
+// the uploaded data against the one returned by the server.
+var CheckUploadCrc64Handler = aws.NamedHandler{Name: "s3.CheckUploadCrc64", Fn: CheckUploadCrc64}
+
 func CheckUploadCrc64(r *aws.Request) {
 	clientCrc := r.Crc64.Sum64()
 	serverCrc := uint64(0)
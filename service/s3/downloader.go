@@ -131,7 +131,10 @@ func (c *S3) DownloadFile(request *DownloadFileInput) (*DownloadFileOutput, erro
 }
 
 func (c *S3) DownloadFileWithContext(ctx context.Context, request *DownloadFileInput) (*DownloadFileOutput, error) {
-	return newDownloader(c, ctx, request).downloadFile()
+	ctx, span := c.startTransferSpan(ctx, "s3.DownloadFile", request)
+	resp, err := newDownloader(c, ctx, request).downloadFile()
+	endSpan(span, err)
+	return resp, err
 }
 
 type Downloader struct {
@@ -338,7 +341,9 @@ func (d *Downloader) runTask(tasks <-chan DownloadPartTask, wg *sync.WaitGroup)
 			return
 		}
 
-		partETag, err := d.downloadPart(task)
+		ctx, span := d.client.startPartSpan(d.context, task.partNumber, task.actualPartSize)
+		partETag, err := d.downloadPart(ctx, task)
+		endSpan(span, err)
 		if err != nil {
 			d.setError(err)
 			return
@@ -348,12 +353,12 @@ func (d *Downloader) runTask(tasks <-chan DownloadPartTask, wg *sync.WaitGroup)
 	}
 }
 
-func (d *Downloader) downloadPart(task DownloadPartTask) (CompletedPart, error) {
+func (d *Downloader) downloadPart(ctx context.Context, task DownloadPartTask) (CompletedPart, error) {
 	request := d.downloadFileRequest
 	dcp := d.downloadCheckpoint
 	tempFilePath := dcp.DownloadFilePath + TempFileSuffix
 	var completedPart CompletedPart
-	resp, err := d.client.GetObjectWithContext(d.context, &GetObjectInput{
+	resp, err := d.client.GetObjectWithContext(ctx, &GetObjectInput{
 		Bucket:                     aws.String(dcp.BucketName),
 		Key:                        aws.String(dcp.ObjectKey),
 		Range:                      aws.String(fmt.Sprintf("bytes=%d-%d", task.start, task.end)),
@@ -0,0 +1,106 @@
+package s3
+
+import "github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+
+// KS3返回的错误码。
+const (
+	// ErrCodeAccessDenied 拒绝访问，请求者没有操作该资源的权限。
+	ErrCodeAccessDenied = "AccessDenied"
+
+	// ErrCodeBucketNotEmpty 删除的存储空间不为空。
+	ErrCodeBucketNotEmpty = "BucketNotEmpty"
+
+	// ErrCodeEntityTooLarge 上传的数据超过允许的最大值。
+	ErrCodeEntityTooLarge = "EntityTooLarge"
+
+	// ErrCodeEntityTooSmall 上传的分块小于允许的最小值。
+	ErrCodeEntityTooSmall = "EntityTooSmall"
+
+	// ErrCodeInternalError 服务端内部错误。
+	ErrCodeInternalError = "InternalError"
+
+	// ErrCodeInvalidAccessKeyID AccessKeyID不存在。
+	ErrCodeInvalidAccessKeyID = "InvalidAccessKeyId"
+
+	// ErrCodeInvalidArgument 请求参数不合法。
+	ErrCodeInvalidArgument = "InvalidArgument"
+
+	// ErrCodeInvalidBucketName 存储空间名称不合法。
+	ErrCodeInvalidBucketName = "InvalidBucketName"
+
+	// ErrCodeInvalidDigest Content-MD5与上传的数据不一致。
+	ErrCodeInvalidDigest = "InvalidDigest"
+
+	// ErrCodeInvalidPart 合并分块时指定的分块不存在或ETag不一致。
+	ErrCodeInvalidPart = "InvalidPart"
+
+	// ErrCodeInvalidPartOrder 合并分块时分块未按分块号升序排列。
+	ErrCodeInvalidPartOrder = "InvalidPartOrder"
+
+	// ErrCodeInvalidRange 请求的范围无法满足。
+	ErrCodeInvalidRange = "InvalidRange"
+
+	// ErrCodeMalformedXML 请求体的XML格式不正确。
+	ErrCodeMalformedXML = "MalformedXML"
+
+	// ErrCodeMethodNotAllowed 资源不支持该请求方法。
+	ErrCodeMethodNotAllowed = "MethodNotAllowed"
+
+	// ErrCodeNoSuchBucketPolicy 存储空间未设置空间策略。
+	ErrCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
+
+	// ErrCodeNoSuchCORSConfiguration 存储空间未设置跨域规则。
+	ErrCodeNoSuchCORSConfiguration = "NoSuchCORSConfiguration"
+
+	// ErrCodeNoSuchLifecycleConfiguration 存储空间未设置生命周期规则。
+	ErrCodeNoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+
+	// ErrCodePreconditionFailed 请求的前提条件（如If-Match）不满足。
+	ErrCodePreconditionFailed = "PreconditionFailed"
+
+	// ErrCodeRequestTimeTooSkewed 请求时间与服务端时间相差过大。
+	ErrCodeRequestTimeTooSkewed = "RequestTimeTooSkewed"
+
+	// ErrCodeSignatureDoesNotMatch 请求签名与服务端计算的签名不一致。
+	ErrCodeSignatureDoesNotMatch = "SignatureDoesNotMatch"
+
+	// ErrCodeTooManyBuckets 存储空间数量超过上限。
+	ErrCodeTooManyBuckets = "TooManyBuckets"
+)
+
+// 可通过errors.Is判断错误码的错误值，例如：
+//
+//	if errors.Is(err, s3.ErrNoSuchKey) {
+//	    // 对象不存在
+//	}
+//
+// 错误的状态码、RequestID、HostID及Resource可通过errors.As获取*awserr.RequestError。
+var (
+	ErrAccessDenied                 = awserr.ErrorCode(ErrCodeAccessDenied)
+	ErrBucketAlreadyExists          = awserr.ErrorCode(ErrCodeBucketAlreadyExists)
+	ErrBucketAlreadyOwnedByYou      = awserr.ErrorCode(ErrCodeBucketAlreadyOwnedByYou)
+	ErrBucketNotEmpty               = awserr.ErrorCode(ErrCodeBucketNotEmpty)
+	ErrEntityTooLarge               = awserr.ErrorCode(ErrCodeEntityTooLarge)
+	ErrEntityTooSmall               = awserr.ErrorCode(ErrCodeEntityTooSmall)
+	ErrInternalError                = awserr.ErrorCode(ErrCodeInternalError)
+	ErrInvalidAccessKeyID           = awserr.ErrorCode(ErrCodeInvalidAccessKeyID)
+	ErrInvalidArgument              = awserr.ErrorCode(ErrCodeInvalidArgument)
+	ErrInvalidBucketName            = awserr.ErrorCode(ErrCodeInvalidBucketName)
+	ErrInvalidDigest                = awserr.ErrorCode(ErrCodeInvalidDigest)
+	ErrInvalidObjectState           = awserr.ErrorCode(ErrCodeInvalidObjectState)
+	ErrInvalidPart                  = awserr.ErrorCode(ErrCodeInvalidPart)
+	ErrInvalidPartOrder             = awserr.ErrorCode(ErrCodeInvalidPartOrder)
+	ErrInvalidRange                 = awserr.ErrorCode(ErrCodeInvalidRange)
+	ErrMalformedXML                 = awserr.ErrorCode(ErrCodeMalformedXML)
+	ErrMethodNotAllowed             = awserr.ErrorCode(ErrCodeMethodNotAllowed)
+	ErrNoSuchBucket                 = awserr.ErrorCode(ErrCodeNoSuchBucket)
+	ErrNoSuchBucketPolicy           = awserr.ErrorCode(ErrCodeNoSuchBucketPolicy)
+	ErrNoSuchCORSConfiguration      = awserr.ErrorCode(ErrCodeNoSuchCORSConfiguration)
+	ErrNoSuchKey                    = awserr.ErrorCode(ErrCodeNoSuchKey)
+	ErrNoSuchLifecycleConfiguration = awserr.ErrorCode(ErrCodeNoSuchLifecycleConfiguration)
+	ErrNoSuchUpload                 = awserr.ErrorCode(ErrCodeNoSuchUpload)
+	ErrPreconditionFailed           = awserr.ErrorCode(ErrCodePreconditionFailed)
+	ErrRequestTimeTooSkewed         = awserr.ErrorCode(ErrCodeRequestTimeTooSkewed)
+	ErrSignatureDoesNotMatch        = awserr.ErrorCode(ErrCodeSignatureDoesNotMatch)
+	ErrTooManyBuckets               = awserr.ErrorCode(ErrCodeTooManyBuckets)
+)
@@ -245,6 +245,11 @@ func (c *S3) ListBucketInventoryRequest(input *ListBucketInventoryInput) (req *a
 		Name:       "ListBucketInventory",
 		HTTPMethod: "GET",
 		HTTPPath:   "/{Bucket}?inventory",
+		Paginator: &aws.Paginator{
+			InputTokens:     []string{"ContinuationToken"},
+			OutputTokens:    []string{"InventoryConfigurationsResult.NextContinuationToken"},
+			TruncationToken: "InventoryConfigurationsResult.IsTruncated",
+		},
 	}
 
 	if input == nil {
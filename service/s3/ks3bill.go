@@ -299,7 +299,7 @@ func (c *S3) QueryKs3DataRequest(input *QueryKs3DataInput) (req *aws.Request, ou
 	req.ContentType = "application/json"
 	req.HTTPRequest.URL.Host = c.Config.Ks3BillEndpoint
 	req.Handlers.Sign.Clear()
-	req.Handlers.Sign.PushBack(open_api.Sign)
+	req.Handlers.Sign.PushBackNamed(open_api.SignRequestHandler)
 	output = &QueryKs3DataOutput{
 		Ks3DataResult: &Ks3DataResult{},
 	}
@@ -420,7 +420,7 @@ func (c *S3) QueryBucketRankRequest(input *QueryBucketRankInput) (req *aws.Reque
 	req.ContentType = "application/json"
 	req.HTTPRequest.URL.Host = c.Config.Ks3BillEndpoint
 	req.Handlers.Sign.Clear()
-	req.Handlers.Sign.PushBack(open_api.Sign)
+	req.Handlers.Sign.PushBackNamed(open_api.SignRequestHandler)
 	output = &QueryBucketRankOutput{
 		BucketRankResult: &BucketRankResult{},
 	}
@@ -74,13 +74,10 @@ func (c *S3) GenerateShareUrl(input *GenerateShareUrlInput) (string, error) {
 	}
 	input.ExtendQueryParams["X-Amz-Policy"] = aws.String(GetBase64Str(*input.Policy))
 
-	if IsV4Signature(c.Config.SignerVersion) {
-		req.ExpireTime = *input.Expires
-	} else {
-		req.ExpireTime = *input.Expires + time.Now().Unix()
+	url, _, err := req.Presign(time.Duration(*input.Expires) * time.Second)
+	if err != nil {
+		return "", err
 	}
-	req.Sign()
-	url := req.HTTPRequest.URL.String()
 
 	accessCode := aws.ToString(input.AccessCode)
 	if accessCode != "" {
@@ -0,0 +1,227 @@
+package s3
+
+import (
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+)
+
+// 列举操作的分页器逐页发送请求：每页的分页标记自动设置到下一页的请求中，
+// aws.WithPageSize设置每页的最大数量（ListBuckets及ListBucketInventory不支持，
+// 设置后返回InvalidParameter错误），aws.WithStartingTokens传入NextTokens的返回值，
+// 可从中断处继续列举。
+
+// ListBucketsPaginator 逐页列举存储空间，该操作只有一页。
+type ListBucketsPaginator struct {
+	aws.Pager
+}
+
+// NewListBucketsPaginator 返回ListBuckets操作的分页器，不支持aws.WithPageSize。
+func (c *S3) NewListBucketsPaginator(input *ListBucketsInput, opts ...aws.PagerOption) *ListBucketsPaginator {
+	p := &ListBucketsPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListBucketsRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListBucketsPaginator) Next(ctx aws.Context) (*ListBucketsOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListBucketsOutput), err
+}
+
+// ListObjectsPaginator 逐页列举对象。
+type ListObjectsPaginator struct {
+	aws.Pager
+}
+
+// NewListObjectsPaginator 返回ListObjects操作的分页器。
+func (c *S3) NewListObjectsPaginator(input *ListObjectsInput, opts ...aws.PagerOption) *ListObjectsPaginator {
+	p := &ListObjectsPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListObjectsRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListObjectsPaginator) Next(ctx aws.Context) (*ListObjectsOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListObjectsOutput), err
+}
+
+// ListObjectsV2Paginator 逐页列举对象。
+type ListObjectsV2Paginator struct {
+	aws.Pager
+}
+
+// NewListObjectsV2Paginator 返回ListObjectsV2操作的分页器。
+func (c *S3) NewListObjectsV2Paginator(input *ListObjectsV2Input, opts ...aws.PagerOption) *ListObjectsV2Paginator {
+	p := &ListObjectsV2Paginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListObjectsV2Request(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListObjectsV2Paginator) Next(ctx aws.Context) (*ListObjectsV2Output, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListObjectsV2Output), err
+}
+
+// ListObjectVersionsPaginator 逐页列举对象的版本。
+type ListObjectVersionsPaginator struct {
+	aws.Pager
+}
+
+// NewListObjectVersionsPaginator 返回ListObjectVersions操作的分页器。
+func (c *S3) NewListObjectVersionsPaginator(input *ListObjectVersionsInput, opts ...aws.PagerOption) *ListObjectVersionsPaginator {
+	p := &ListObjectVersionsPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListObjectVersionsRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListObjectVersionsPaginator) Next(ctx aws.Context) (*ListObjectVersionsOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListObjectVersionsOutput), err
+}
+
+// ListMultipartUploadsPaginator 逐页列举未完成的分块上传。
+type ListMultipartUploadsPaginator struct {
+	aws.Pager
+}
+
+// NewListMultipartUploadsPaginator 返回ListMultipartUploads操作的分页器。
+func (c *S3) NewListMultipartUploadsPaginator(input *ListMultipartUploadsInput, opts ...aws.PagerOption) *ListMultipartUploadsPaginator {
+	p := &ListMultipartUploadsPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListMultipartUploadsRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListMultipartUploadsPaginator) Next(ctx aws.Context) (*ListMultipartUploadsOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListMultipartUploadsOutput), err
+}
+
+// ListPartsPaginator 逐页列举已上传的分块。
+type ListPartsPaginator struct {
+	aws.Pager
+}
+
+// NewListPartsPaginator 返回ListParts操作的分页器。
+func (c *S3) NewListPartsPaginator(input *ListPartsInput, opts ...aws.PagerOption) *ListPartsPaginator {
+	p := &ListPartsPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListPartsRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListPartsPaginator) Next(ctx aws.Context) (*ListPartsOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListPartsOutput), err
+}
+
+// ListRetentionPaginator 逐页列举回收站中的对象。
+type ListRetentionPaginator struct {
+	aws.Pager
+}
+
+// NewListRetentionPaginator 返回ListRetention操作的分页器。
+func (c *S3) NewListRetentionPaginator(input *ListRetentionInput, opts ...aws.PagerOption) *ListRetentionPaginator {
+	p := &ListRetentionPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListRetentionRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListRetentionPaginator) Next(ctx aws.Context) (*ListRetentionOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListRetentionOutput), err
+}
+
+// ListJobsPaginator 逐页列举批量处理任务。
+type ListJobsPaginator struct {
+	aws.Pager
+}
+
+// NewListJobsPaginator 返回ListJobs操作的分页器。
+func (c *S3) NewListJobsPaginator(input *ListJobsInput, opts ...aws.PagerOption) *ListJobsPaginator {
+	p := &ListJobsPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListJobsRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListJobsPaginator) Next(ctx aws.Context) (*ListJobsOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListJobsOutput), err
+}
+
+// ListBucketInventoryPaginator 逐页列举存储空间的清单规则。
+type ListBucketInventoryPaginator struct {
+	aws.Pager
+}
+
+// NewListBucketInventoryPaginator 返回ListBucketInventory操作的分页器，
+// 该操作不支持限制每页的数量，设置aws.WithPageSize时返回InvalidParameter错误。
+func (c *S3) NewListBucketInventoryPaginator(input *ListBucketInventoryInput, opts ...aws.PagerOption) *ListBucketInventoryPaginator {
+	p := &ListBucketInventoryPaginator{aws.Pager{NewRequest: func() *aws.Request {
+		req, _ := c.ListBucketInventoryRequest(input)
+		return req
+	}}}
+	p.ApplyOptions(opts...)
+	return p
+}
+
+// Next 发送下一页的请求并返回该页的结果。
+func (p *ListBucketInventoryPaginator) Next(ctx aws.Context) (*ListBucketInventoryOutput, error) {
+	req, err := p.NextPage(ctx)
+	if req == nil {
+		return nil, err
+	}
+	return req.Data.(*ListBucketInventoryOutput), err
+}
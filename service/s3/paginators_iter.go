@@ -0,0 +1,91 @@
+//go:build go1.23
+// +build go1.23
+
+package s3
+
+import (
+	"iter"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+)
+
+// ListBucketsItems 返回逐个返回存储空间的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListBucketsItems(ctx aws.Context, input *ListBucketsInput, opts ...aws.PagerOption) iter.Seq2[*Bucket, error] {
+	p := c.NewListBucketsPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*Bucket {
+		return r.Data.(*ListBucketsOutput).Buckets
+	})
+}
+
+// ListObjectsItems 返回逐个返回对象的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListObjectsItems(ctx aws.Context, input *ListObjectsInput, opts ...aws.PagerOption) iter.Seq2[*Object, error] {
+	p := c.NewListObjectsPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*Object {
+		return r.Data.(*ListObjectsOutput).Contents
+	})
+}
+
+// ListObjectsV2Items 返回逐个返回对象的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListObjectsV2Items(ctx aws.Context, input *ListObjectsV2Input, opts ...aws.PagerOption) iter.Seq2[*Object, error] {
+	p := c.NewListObjectsV2Paginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*Object {
+		return r.Data.(*ListObjectsV2Output).Contents
+	})
+}
+
+// ListObjectVersionsItems 返回逐个返回对象版本，不包含删除标记的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListObjectVersionsItems(ctx aws.Context, input *ListObjectVersionsInput, opts ...aws.PagerOption) iter.Seq2[*ObjectVersion, error] {
+	p := c.NewListObjectVersionsPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*ObjectVersion {
+		return r.Data.(*ListObjectVersionsOutput).Versions
+	})
+}
+
+// ListMultipartUploadsItems 返回逐个返回分块上传的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListMultipartUploadsItems(ctx aws.Context, input *ListMultipartUploadsInput, opts ...aws.PagerOption) iter.Seq2[*MultipartUpload, error] {
+	p := c.NewListMultipartUploadsPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*MultipartUpload {
+		return r.Data.(*ListMultipartUploadsOutput).Uploads
+	})
+}
+
+// ListPartsItems 返回逐个返回分块的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListPartsItems(ctx aws.Context, input *ListPartsInput, opts ...aws.PagerOption) iter.Seq2[*Part, error] {
+	p := c.NewListPartsPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*Part {
+		return r.Data.(*ListPartsOutput).Parts
+	})
+}
+
+// ListRetentionItems 返回逐个返回回收站中的对象的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListRetentionItems(ctx aws.Context, input *ListRetentionInput, opts ...aws.PagerOption) iter.Seq2[*RetentionObject, error] {
+	p := c.NewListRetentionPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*RetentionObject {
+		if result := r.Data.(*ListRetentionOutput).ListRetentionResult; result != nil {
+			return result.Contents
+		}
+		return nil
+	})
+}
+
+// ListJobsItems 返回逐个返回批量处理任务的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListJobsItems(ctx aws.Context, input *ListJobsInput, opts ...aws.PagerOption) iter.Seq2[*JobMember, error] {
+	p := c.NewListJobsPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*JobMember {
+		if result := r.Data.(*ListJobsOutput).ListJobsResult; result != nil && result.Jobs != nil {
+			return result.Jobs.Members
+		}
+		return nil
+	})
+}
+
+// ListBucketInventoryItems 返回逐个返回清单规则的迭代器，请求出错时返回错误后结束。
+func (c *S3) ListBucketInventoryItems(ctx aws.Context, input *ListBucketInventoryInput, opts ...aws.PagerOption) iter.Seq2[*InventoryConfiguration, error] {
+	p := c.NewListBucketInventoryPaginator(input, opts...)
+	return aws.PagerItems(ctx, &p.Pager, func(r *aws.Request) []*InventoryConfiguration {
+		if result := r.Data.(*ListBucketInventoryOutput).InventoryConfigurationsResult; result != nil {
+			return result.InventoryConfigurations
+		}
+		return nil
+	})
+}
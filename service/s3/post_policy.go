@@ -0,0 +1,218 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/internal/apierr"
+	"github.com/ks3sdklib/aws-sdk-go/internal/signer/v2"
+	"github.com/ks3sdklib/aws-sdk-go/internal/signer/v4"
+)
+
+// PostPolicy 浏览器表单上传（PostObject）的策略，描述表单中各字段需满足的条件。
+// 通过Set*方法设置的字段会同时加入策略条件及PresignPostObject返回的表单字段
+type PostPolicy struct {
+	conditions []interface{}
+	fields     map[string]string
+	err        error
+}
+
+// NewPostPolicy 创建空的表单上传策略
+func NewPostPolicy() *PostPolicy {
+	return &PostPolicy{fields: map[string]string{}}
+}
+
+// SetField 设置表单字段，并要求上传时该字段的值与value相同
+func (p *PostPolicy) SetField(field, value string) *PostPolicy {
+	p.conditions = append(p.conditions, map[string]string{field: value})
+	p.fields[field] = value
+	return p
+}
+
+// AddStartsWith 要求表单字段的值以prefix开头，prefix为空时表示该字段可为任意值，字段的值由浏览器填写
+func (p *PostPolicy) AddStartsWith(field, prefix string) *PostPolicy {
+	p.conditions = append(p.conditions, []interface{}{"starts-with", "$" + field, prefix})
+	return p
+}
+
+// SetKeyStartsWith 要求上传对象的key以prefix开头
+func (p *PostPolicy) SetKeyStartsWith(prefix string) *PostPolicy {
+	return p.AddStartsWith("key", prefix)
+}
+
+// SetACL 设置上传对象的访问权限，如public-read
+func (p *PostPolicy) SetACL(acl string) *PostPolicy {
+	return p.SetField("acl", acl)
+}
+
+// SetContentType 设置上传对象的Content-Type
+func (p *PostPolicy) SetContentType(contentType string) *PostPolicy {
+	return p.SetField("Content-Type", contentType)
+}
+
+// SetContentTypeStartsWith 要求上传对象的Content-Type以prefix开头，如image/，字段的值由浏览器填写
+func (p *PostPolicy) SetContentTypeStartsWith(prefix string) *PostPolicy {
+	return p.AddStartsWith("Content-Type", prefix)
+}
+
+// SetContentLengthRange 限制上传文件的大小，单位为字节
+func (p *PostPolicy) SetContentLengthRange(min, max int64) *PostPolicy {
+	if min < 0 || min > max {
+		p.setErr(fmt.Sprintf("invalid content-length-range [%d, %d]", min, max))
+		return p
+	}
+	p.conditions = append(p.conditions, []interface{}{"content-length-range", min, max})
+	return p
+}
+
+// SetSuccessActionStatus 设置上传成功后返回的状态码，可为200、201或204
+func (p *PostPolicy) SetSuccessActionStatus(status int) *PostPolicy {
+	if status != 200 && status != 201 && status != 204 {
+		p.setErr(fmt.Sprintf("invalid success_action_status %d, must be 200, 201 or 204", status))
+		return p
+	}
+	return p.SetField("success_action_status", fmt.Sprint(status))
+}
+
+// SetSuccessActionRedirect 设置上传成功后浏览器跳转的地址
+func (p *PostPolicy) SetSuccessActionRedirect(url string) *PostPolicy {
+	return p.SetField("success_action_redirect", url)
+}
+
+// SetMetadata 设置上传对象的自定义元数据
+func (p *PostPolicy) SetMetadata(name, value string) *PostPolicy {
+	return p.SetField("x-amz-meta-"+strings.ToLower(name), value)
+}
+
+// SetCallback 设置上传成功后的回调地址及回调内容
+func (p *PostPolicy) SetCallback(url, body string) *PostPolicy {
+	p.SetField("x-kss-callbackurl", url)
+	return p.SetField("x-kss-callbackbody", body)
+}
+
+func (p *PostPolicy) setErr(msg string) {
+	if p.err == nil {
+		p.err = apierr.New("InvalidParameter", msg, nil)
+	}
+}
+
+type PresignPostObjectInput struct {
+	// 存储空间名称
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// 上传对象的key，可包含${filename}，上传时替换为所选文件的文件名
+	Key *string `locationName:"Key" type:"string" required:"true"`
+
+	// 表单的过期时间，单位为秒
+	Expires int64 `locationName:"Expires" type:"integer"`
+
+	// 表单上传策略，为空时只限制存储空间及key
+	Policy *PostPolicy
+}
+
+type PresignPostObjectOutput struct {
+	// 表单提交地址
+	URL string
+
+	// 表单字段，需全部在file字段之前提交
+	Fields map[string]string
+}
+
+// PresignPostObject 生成浏览器表单上传（PostObject）所需的提交地址及表单字段，
+// 浏览器无需密钥即可在过期时间内按策略上传对象
+func (c *S3) PresignPostObject(input *PresignPostObjectInput) (*PresignPostObjectOutput, error) {
+	if input == nil {
+		input = &PresignPostObjectInput{}
+	}
+
+	op := &aws.Operation{
+		Name:       "PostObject",
+		HTTPMethod: "POST",
+		HTTPPath:   "/{Bucket}",
+	}
+	req := c.newRequest(op, input, nil)
+	req.Build()
+	if req.Error != nil {
+		return nil, req.Error
+	}
+
+	if input.Expires <= 0 {
+		return nil, apierr.New("InvalidParameter", "Expires is required and must bigger than 0", nil)
+	}
+	policy := input.Policy
+	if policy == nil {
+		policy = NewPostPolicy()
+	}
+	if policy.err != nil {
+		return nil, policy.err
+	}
+
+	creds, err := c.Config.Credentials.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	key := *input.Key
+	fields := map[string]string{"key": key}
+	conditions := []interface{}{map[string]string{"bucket": *input.Bucket}}
+	if i := strings.Index(key, "${filename}"); i >= 0 {
+		conditions = append(conditions, []interface{}{"starts-with", "$key", key[:i]})
+	} else {
+		conditions = append(conditions, map[string]string{"key": key})
+	}
+	for field, value := range policy.fields {
+		fields[field] = value
+	}
+	conditions = append(conditions, policy.conditions...)
+
+	v4Signature := IsV4Signature(c.Config.SignerVersion)
+	region, serviceName := c.SigningRegion, c.SigningName
+	if region == "" {
+		region = c.Config.Region
+	}
+	if serviceName == "" {
+		serviceName = c.ServiceName
+	}
+	var signFields [][2]string
+	if v4Signature {
+		credential, date := v4.PostCredential(creds.AccessKeyID, region, serviceName, req.Time)
+		signFields = [][2]string{
+			{"x-amz-algorithm", "AWS4-HMAC-SHA256"},
+			{"x-amz-credential", credential},
+			{"x-amz-date", date},
+		}
+	}
+	if creds.SessionToken != "" {
+		// 临时凭证的会话令牌，V2及V4签名均作为表单字段上传，并加入policy的条件
+		signFields = append(signFields, [2]string{"x-amz-security-token", creds.SessionToken})
+	}
+	for _, f := range signFields {
+		fields[f[0]] = f[1]
+		conditions = append(conditions, map[string]string{f[0]: f[1]})
+	}
+
+	document, err := json.Marshal(map[string]interface{}{
+		"expiration": req.Time.Add(time.Duration(input.Expires) * time.Second).UTC().Format("2006-01-02T15:04:05.000Z"),
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, apierr.New("Marshal", "failed to encode the POST policy", err)
+	}
+	encoded := GetBase64Str(string(document))
+
+	if v4Signature {
+		fields["policy"] = encoded
+		fields["x-amz-signature"] = v4.SignPolicy(creds.SecretAccessKey, region, serviceName, req.Time, encoded)
+	} else {
+		fields["KSSAccessKeyId"] = creds.AccessKeyID
+		fields["Policy"] = encoded
+		fields["Signature"] = v2.SignPolicy(creds.SecretAccessKey, encoded)
+	}
+
+	u := *req.HTTPRequest.URL
+	u.RawQuery = ""
+	return &PresignPostObjectOutput{URL: u.String(), Fields: fields}, nil
+}
@@ -150,6 +150,12 @@ func (c *S3) ListRetentionRequest(input *ListRetentionInput) (req *aws.Request,
 		Name:       "ListRetention",
 		HTTPMethod: "GET",
 		HTTPPath:   "/{Bucket}?recycle",
+		Paginator: &aws.Paginator{
+			InputTokens:     []string{"Marker"},
+			OutputTokens:    []string{"ListRetentionResult.NextMarker || ListRetentionResult.Contents[-1].Key"},
+			LimitToken:      "MaxKeys",
+			TruncationToken: "ListRetentionResult.IsTruncated",
+		},
 	}
 
 	if input == nil {
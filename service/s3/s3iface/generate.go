@@ -0,0 +1,3 @@
+package s3iface
+
+//go:generate go run ../../../internal/model/cli/gen-s3iface/main.go -path=.. -out=.
@@ -1,231 +1,832 @@
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
 
 // Package s3iface provides an interface for the Amazon Simple Storage Service.
+//
+// The interface and its mock are generated from the methods of s3.S3 by
+// internal/model/cli/gen-s3iface, run with go generate.
 package s3iface
 
 import (
+	"context"
+	"net/http"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
 	"github.com/ks3sdklib/aws-sdk-go/service/s3"
 )
 
 // S3API is the interface type for s3.S3.
 type S3API interface {
+	AbortBucketWorm(*s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error)
+
+	AbortBucketWormRequest(*s3.AbortBucketWormInput) (*aws.Request, *s3.AbortBucketWormOutput)
+
+	AbortBucketWormWithContext(aws.Context, *s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error)
+
 	AbortMultipartUpload(*s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
 
-	AppendObject(input *s3.AppendObjectInput) (*s3.AppendObjectOutput, error)
+	AbortMultipartUploadRequest(*s3.AbortMultipartUploadInput) (*aws.Request, *s3.AbortMultipartUploadOutput)
 
-	AbortBucketWorm(*s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error)
+	AbortMultipartUploadWithContext(aws.Context, *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
 
-	CompleteMultipartUpload(*s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
+	AppendObject(*s3.AppendObjectInput) (*s3.AppendObjectOutput, error)
+
+	AppendObjectRequest(*s3.AppendObjectInput) (*aws.Request, *s3.AppendObjectOutput)
+
+	AppendObjectWithContext(aws.Context, *s3.AppendObjectInput) (*s3.AppendObjectOutput, error)
+
+	ClearObject(*s3.ClearObjectInput) (*s3.ClearObjectOutput, error)
+
+	ClearObjectRequest(*s3.ClearObjectInput) (*aws.Request, *s3.ClearObjectOutput)
+
+	ClearObjectWithContext(aws.Context, *s3.ClearObjectInput) (*s3.ClearObjectOutput, error)
 
 	CompleteBucketWorm(*s3.CompleteBucketWormInput) (*s3.CompleteBucketWormOutput, error)
 
+	CompleteBucketWormRequest(*s3.CompleteBucketWormInput) (*aws.Request, *s3.CompleteBucketWormOutput)
+
+	CompleteBucketWormWithContext(aws.Context, *s3.CompleteBucketWormInput) (*s3.CompleteBucketWormOutput, error)
+
+	CompleteMultipartUpload(*s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
+
+	CompleteMultipartUploadRequest(*s3.CompleteMultipartUploadInput) (*aws.Request, *s3.CompleteMultipartUploadOutput)
+
+	CompleteMultipartUploadWithContext(aws.Context, *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
+
+	CopyFile(*s3.CopyFileInput) (*s3.CopyFileOutput, error)
+
+	CopyFileAcrossRegion(*s3.CopyFileInput, *s3.S3) (*s3.UploadFileOutput, error)
+
+	CopyFileAcrossRegionWithContext(context.Context, *s3.CopyFileInput, *s3.S3) (*s3.UploadFileOutput, error)
+
+	CopyFileWithContext(context.Context, *s3.CopyFileInput) (*s3.CopyFileOutput, error)
+
 	CopyObject(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
 
+	CopyObjectRequest(*s3.CopyObjectInput) (*aws.Request, *s3.CopyObjectOutput)
+
+	CopyObjectWithContext(aws.Context, *s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
+
 	CreateBucket(*s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
 
-	CreateMultipartUpload(*s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
+	CreateBucketRequest(*s3.CreateBucketInput) (*aws.Request, *s3.CreateBucketOutput)
+
+	CreateBucketWithContext(aws.Context, *s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
 
 	CreateJob(*s3.CreateJobInput) (*s3.CreateJobOutput, error)
 
-	ClearObject(*s3.ClearObjectInput) (*s3.ClearObjectOutput, error)
+	CreateJobRequest(*s3.CreateJobInput) (*aws.Request, *s3.CreateJobOutput)
+
+	CreateJobWithContext(aws.Context, *s3.CreateJobInput) (*s3.CreateJobOutput, error)
+
+	CreateMultipartUpload(*s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
+
+	CreateMultipartUploadRequest(*s3.CreateMultipartUploadInput) (*aws.Request, *s3.CreateMultipartUploadOutput)
+
+	CreateMultipartUploadWithContext(aws.Context, *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
 
 	DeleteBucket(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
 
 	DeleteBucketCORS(*s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
 
+	DeleteBucketCORSRequest(*s3.DeleteBucketCORSInput) (*aws.Request, *s3.DeleteBucketCORSOutput)
+
+	DeleteBucketCORSWithContext(aws.Context, *s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
+
+	DeleteBucketDataAccelerator(*s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error)
+
+	DeleteBucketDataAcceleratorRequest(*s3.DeleteBucketDataAcceleratorInput) (*aws.Request, *s3.DeleteBucketDataAcceleratorOutput)
+
+	DeleteBucketDataAcceleratorWithContext(aws.Context, *s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error)
+
+	DeleteBucketDecompressPolicy(*s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error)
+
+	DeleteBucketDecompressPolicyRequest(*s3.DeleteBucketDecompressPolicyInput) (*aws.Request, *s3.DeleteBucketDecompressPolicyOutput)
+
+	DeleteBucketDecompressPolicyWithContext(aws.Context, *s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error)
+
+	DeleteBucketEncryption(*s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error)
+
+	DeleteBucketEncryptionRequest(*s3.DeleteBucketEncryptionInput) (*aws.Request, *s3.DeleteBucketEncryptionOutput)
+
+	DeleteBucketEncryptionWithContext(aws.Context, *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error)
+
+	DeleteBucketInventory(*s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error)
+
+	DeleteBucketInventoryRequest(*s3.DeleteBucketInventoryInput) (*aws.Request, *s3.DeleteBucketInventoryOutput)
+
+	DeleteBucketInventoryWithContext(aws.Context, *s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error)
+
 	DeleteBucketLifecycle(*s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
 
+	DeleteBucketLifecycleRequest(*s3.DeleteBucketLifecycleInput) (*aws.Request, *s3.DeleteBucketLifecycleOutput)
+
+	DeleteBucketLifecycleWithContext(aws.Context, *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
+
+	DeleteBucketMirror(*s3.DeleteBucketMirrorInput) (*s3.DeleteBucketMirrorOutput, error)
+
+	DeleteBucketMirrorRequest(*s3.DeleteBucketMirrorInput) (*aws.Request, *s3.DeleteBucketMirrorOutput)
+
+	DeleteBucketMirrorWithContext(aws.Context, *s3.DeleteBucketMirrorInput) (*s3.DeleteBucketMirrorOutput, error)
+
 	DeleteBucketNotification(*s3.DeleteBucketNotificationInput) (*s3.DeleteBucketNotificationOutput, error)
 
+	DeleteBucketNotificationRequest(*s3.DeleteBucketNotificationInput) (*aws.Request, *s3.DeleteBucketNotificationOutput)
+
+	DeleteBucketNotificationWithContext(aws.Context, *s3.DeleteBucketNotificationInput) (*s3.DeleteBucketNotificationOutput, error)
+
 	DeleteBucketPolicy(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
 
+	DeleteBucketPolicyRequest(*s3.DeleteBucketPolicyInput) (*aws.Request, *s3.DeleteBucketPolicyOutput)
+
+	DeleteBucketPolicyWithContext(aws.Context, *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
+
+	DeleteBucketPrefix(*s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+
+	DeleteBucketPrefixWithContext(aws.Context, *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+
+	DeleteBucketPublicNetworkBlock(*s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error)
+
+	DeleteBucketPublicNetworkBlockRequest(*s3.DeleteBucketPublicNetworkBlockInput) (*aws.Request, *s3.DeleteBucketPublicNetworkBlockOutput)
+
+	DeleteBucketPublicNetworkBlockWithContext(aws.Context, *s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error)
+
+	DeleteBucketQos(*s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error)
+
+	DeleteBucketQosRequest(*s3.DeleteBucketQosInput) (*aws.Request, *s3.DeleteBucketQosOutput)
+
+	DeleteBucketQosWithContext(aws.Context, *s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error)
+
+	DeleteBucketQuota(*s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error)
+
+	DeleteBucketQuotaRequest(*s3.DeleteBucketQuotaInput) (*aws.Request, *s3.DeleteBucketQuotaOutput)
+
+	DeleteBucketQuotaWithContext(aws.Context, *s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error)
+
 	DeleteBucketReplication(*s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
 
+	DeleteBucketReplicationRequest(*s3.DeleteBucketReplicationInput) (*aws.Request, *s3.DeleteBucketReplicationOutput)
+
+	DeleteBucketReplicationWithContext(aws.Context, *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
+
+	DeleteBucketRequest(*s3.DeleteBucketInput) (*aws.Request, *s3.DeleteBucketOutput)
+
 	DeleteBucketTagging(*s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
 
+	DeleteBucketTaggingRequest(*s3.DeleteBucketTaggingInput) (*aws.Request, *s3.DeleteBucketTaggingOutput)
+
+	DeleteBucketTaggingWithContext(aws.Context, *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
+
 	DeleteBucketWebsite(*s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
 
-	DeleteBucketDecompressPolicy(*s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error)
+	DeleteBucketWebsiteRequest(*s3.DeleteBucketWebsiteInput) (*aws.Request, *s3.DeleteBucketWebsiteOutput)
 
-	DeleteBucketInventory(*s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error)
+	DeleteBucketWebsiteWithContext(aws.Context, *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
 
-	DeleteBucketQos(*s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error)
+	DeleteBucketWithContext(aws.Context, *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
 
-	DeleteRequesterQos(*s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error)
+	DeleteJob(*s3.DeleteJobInput) (*s3.DeleteJobOutput, error)
 
-	DeleteBucketEncryption(*s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error)
+	DeleteJobRequest(*s3.DeleteJobInput) (*aws.Request, *s3.DeleteJobOutput)
 
-	DeleteBucketDataAccelerator(*s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error)
+	DeleteJobWithContext(aws.Context, *s3.DeleteJobInput) (*s3.DeleteJobOutput, error)
 
-	DeleteVpcAccessBlock(*s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error)
+	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 
-	DeleteBucketQuota(*s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error)
+	DeleteObjectRequest(*s3.DeleteObjectInput) (*aws.Request, *s3.DeleteObjectOutput)
 
-	DeletePublicNetworkBlock(*s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error)
+	DeleteObjectTagging(*s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error)
 
-	DeleteBucketPublicNetworkBlock(*s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error)
+	DeleteObjectTaggingRequest(*s3.DeleteObjectTaggingInput) (*aws.Request, *s3.DeleteObjectTaggingOutput)
 
-	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+	DeleteObjectTaggingWithContext(aws.Context, *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error)
+
+	DeleteObjectWithContext(aws.Context, *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 
 	DeleteObjects(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 
-	DeleteJob(*s3.DeleteJobInput) (*s3.DeleteJobOutput, error)
+	DeleteObjectsRequest(*s3.DeleteObjectsInput) (*aws.Request, *s3.DeleteObjectsOutput)
+
+	DeleteObjectsWithContext(aws.Context, *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+
+	DeletePublicNetworkBlock(*s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error)
+
+	DeletePublicNetworkBlockRequest(*s3.DeletePublicNetworkBlockInput) (*aws.Request, *s3.DeletePublicNetworkBlockOutput)
+
+	DeletePublicNetworkBlockWithContext(aws.Context, *s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error)
+
+	DeleteRequesterQos(*s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error)
+
+	DeleteRequesterQosRequest(*s3.DeleteRequesterQosInput) (*aws.Request, *s3.DeleteRequesterQosOutput)
+
+	DeleteRequesterQosWithContext(aws.Context, *s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error)
+
+	DeleteVpcAccessBlock(*s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error)
+
+	DeleteVpcAccessBlockRequest(*s3.DeleteVpcAccessBlockInput) (*aws.Request, *s3.DeleteVpcAccessBlockOutput)
+
+	DeleteVpcAccessBlockWithContext(aws.Context, *s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error)
 
 	DescribeJob(*s3.DescribeJobInput) (*s3.DescribeJobOutput, error)
 
+	DescribeJobRequest(*s3.DescribeJobInput) (*aws.Request, *s3.DescribeJobOutput)
+
+	DescribeJobWithContext(aws.Context, *s3.DescribeJobInput) (*s3.DescribeJobOutput, error)
+
+	DownloadFile(*s3.DownloadFileInput) (*s3.DownloadFileOutput, error)
+
+	DownloadFileWithContext(context.Context, *s3.DownloadFileInput) (*s3.DownloadFileOutput, error)
+
+	ExtendBucketWorm(*s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error)
+
+	ExtendBucketWormRequest(*s3.ExtendBucketWormInput) (*aws.Request, *s3.ExtendBucketWormOutput)
+
+	ExtendBucketWormWithContext(aws.Context, *s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error)
+
+	FetchObject(*s3.FetchObjectInput) (*s3.FetchObjectOutput, error)
+
+	FetchObjectRequest(*s3.FetchObjectInput) (*aws.Request, *s3.FetchObjectOutput)
+
+	FetchObjectWithContext(aws.Context, *s3.FetchObjectInput) (*s3.FetchObjectOutput, error)
+
+	GeneratePresignedUrl(*s3.GeneratePresignedUrlInput) (string, error)
+
+	GeneratePresignedUrlInput(*s3.GeneratePresignedUrlInput) string
+
+	GenerateShareUrl(*s3.GenerateShareUrlInput) (string, error)
+
 	GetBucketACL(*s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
 
+	GetBucketACLRequest(*s3.GetBucketACLInput) (*aws.Request, *s3.GetBucketACLOutput)
+
+	GetBucketACLWithContext(aws.Context, *s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
+
+	GetBucketAccessMonitor(*s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error)
+
+	GetBucketAccessMonitorRequest(*s3.GetBucketAccessMonitorInput) (*aws.Request, *s3.GetBucketAccessMonitorOutput)
+
+	GetBucketAccessMonitorWithContext(aws.Context, *s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error)
+
 	GetBucketCORS(*s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
 
+	GetBucketCORSRequest(*s3.GetBucketCORSInput) (*aws.Request, *s3.GetBucketCORSOutput)
+
+	GetBucketCORSWithContext(aws.Context, *s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
+
 	GetBucketDataAccelerator(*s3.GetBucketDataAcceleratorInput) (*s3.GetBucketDataAcceleratorOutput, error)
 
+	GetBucketDataAcceleratorRequest(*s3.GetBucketDataAcceleratorInput) (*aws.Request, *s3.GetBucketDataAcceleratorOutput)
+
+	GetBucketDataAcceleratorWithContext(aws.Context, *s3.GetBucketDataAcceleratorInput) (*s3.GetBucketDataAcceleratorOutput, error)
+
+	GetBucketDataRedundancySwitch(*s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error)
+
+	GetBucketDataRedundancySwitchRequest(*s3.GetBucketDataRedundancySwitchInput) (*aws.Request, *s3.GetBucketDataRedundancySwitchOutput)
+
+	GetBucketDataRedundancySwitchWithContext(aws.Context, *s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error)
+
+	GetBucketDecompressPolicy(*s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error)
+
+	GetBucketDecompressPolicyRequest(*s3.GetBucketDecompressPolicyInput) (*aws.Request, *s3.GetBucketDecompressPolicyOutput)
+
+	GetBucketDecompressPolicyWithContext(aws.Context, *s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error)
+
+	GetBucketEncryption(*s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+
+	GetBucketEncryptionRequest(*s3.GetBucketEncryptionInput) (*aws.Request, *s3.GetBucketEncryptionOutput)
+
+	GetBucketEncryptionWithContext(aws.Context, *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+
+	GetBucketInventory(*s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error)
+
+	GetBucketInventoryRequest(*s3.GetBucketInventoryInput) (*aws.Request, *s3.GetBucketInventoryOutput)
+
+	GetBucketInventoryWithContext(aws.Context, *s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error)
+
 	GetBucketLifecycle(*s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
 
-	GetBucketAccessMonitor(*s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error)
+	GetBucketLifecycleRequest(*s3.GetBucketLifecycleInput) (*aws.Request, *s3.GetBucketLifecycleOutput)
+
+	GetBucketLifecycleWithContext(aws.Context, *s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
 
 	GetBucketLocation(*s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
 
+	GetBucketLocationRequest(*s3.GetBucketLocationInput) (*aws.Request, *s3.GetBucketLocationOutput)
+
+	GetBucketLocationWithContext(aws.Context, *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
+
 	GetBucketLogging(*s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
 
+	GetBucketLoggingRequest(*s3.GetBucketLoggingInput) (*aws.Request, *s3.GetBucketLoggingOutput)
+
+	GetBucketLoggingWithContext(aws.Context, *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
+
+	GetBucketMirror(*s3.GetBucketMirrorInput) (*s3.GetBucketMirrorOutput, error)
+
+	GetBucketMirrorRequest(*s3.GetBucketMirrorInput) (*aws.Request, *s3.GetBucketMirrorOutput)
+
+	GetBucketMirrorWithContext(aws.Context, *s3.GetBucketMirrorInput) (*s3.GetBucketMirrorOutput, error)
+
 	GetBucketNotification(*s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error)
 
+	GetBucketNotificationRequest(*s3.GetBucketNotificationInput) (*aws.Request, *s3.GetBucketNotificationOutput)
+
+	GetBucketNotificationWithContext(aws.Context, *s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error)
+
 	GetBucketPolicy(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
 
+	GetBucketPolicyRequest(*s3.GetBucketPolicyInput) (*aws.Request, *s3.GetBucketPolicyOutput)
+
+	GetBucketPolicyWithContext(aws.Context, *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
+
+	GetBucketPublicNetworkBlock(*s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error)
+
+	GetBucketPublicNetworkBlockRequest(*s3.GetBucketPublicNetworkBlockInput) (*aws.Request, *s3.GetBucketPublicNetworkBlockOutput)
+
+	GetBucketPublicNetworkBlockWithContext(aws.Context, *s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error)
+
+	GetBucketQos(*s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error)
+
+	GetBucketQosRequest(*s3.GetBucketQosInput) (*aws.Request, *s3.GetBucketQosOutput)
+
+	GetBucketQosWithContext(aws.Context, *s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error)
+
+	GetBucketQuota(*s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error)
+
+	GetBucketQuotaRequest(*s3.GetBucketQuotaInput) (*aws.Request, *s3.GetBucketQuotaOutput)
+
+	GetBucketQuotaWithContext(aws.Context, *s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error)
+
 	GetBucketReplication(*s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
 
+	GetBucketReplicationRequest(*s3.GetBucketReplicationInput) (*aws.Request, *s3.GetBucketReplicationOutput)
+
+	GetBucketReplicationWithContext(aws.Context, *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
+
 	GetBucketRequestPayment(*s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
 
+	GetBucketRequestPaymentRequest(*s3.GetBucketRequestPaymentInput) (*aws.Request, *s3.GetBucketRequestPaymentOutput)
+
+	GetBucketRequestPaymentWithContext(aws.Context, *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
+
+	GetBucketRetention(*s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error)
+
+	GetBucketRetentionRequest(*s3.GetBucketRetentionInput) (*aws.Request, *s3.GetBucketRetentionOutput)
+
+	GetBucketRetentionWithContext(aws.Context, *s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error)
+
 	GetBucketTagging(*s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
 
+	GetBucketTaggingRequest(*s3.GetBucketTaggingInput) (*aws.Request, *s3.GetBucketTaggingOutput)
+
+	GetBucketTaggingWithContext(aws.Context, *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
+
+	GetBucketTransferAcceleration(*s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error)
+
+	GetBucketTransferAccelerationRequest(*s3.GetBucketTransferAccelerationInput) (*aws.Request, *s3.GetBucketTransferAccelerationOutput)
+
+	GetBucketTransferAccelerationWithContext(aws.Context, *s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error)
+
 	GetBucketVersioning(*s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
 
-	GetBucketWorm(*s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error)
+	GetBucketVersioningRequest(*s3.GetBucketVersioningInput) (*aws.Request, *s3.GetBucketVersioningOutput)
 
-	ExtendBucketWorm(*s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error)
+	GetBucketVersioningWithContext(aws.Context, *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
 
 	GetBucketWebsite(*s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
 
-	GetBucketDecompressPolicy(*s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error)
+	GetBucketWebsiteRequest(*s3.GetBucketWebsiteInput) (*aws.Request, *s3.GetBucketWebsiteOutput)
 
-	GetBucketRetention(*s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error)
+	GetBucketWebsiteWithContext(aws.Context, *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
 
-	GetBucketInventory(*s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error)
+	GetBucketWorm(*s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error)
 
-	GetBucketDataRedundancySwitch(*s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error)
+	GetBucketWormRequest(*s3.GetBucketWormInput) (*aws.Request, *s3.GetBucketWormOutput)
 
-	GetBucketQos(*s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error)
+	GetBucketWormWithContext(aws.Context, *s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error)
 
-	GetRequesterQos(*s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error)
+	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
 
-	GetBucketEncryption(*s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+	GetObjectACL(*s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
 
-	GetBucketTransferAcceleration(*s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error)
+	GetObjectACLRequest(*s3.GetObjectACLInput) (*aws.Request, *s3.GetObjectACLOutput)
 
-	GetVpcAccessBlock(*s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error)
+	GetObjectACLWithContext(aws.Context, *s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
 
-	GetBucketQuota(*s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error)
+	GetObjectMigration(*s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error)
 
-	GetPublicNetworkBlock(*s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error)
+	GetObjectMigrationRequest(*s3.GetObjectMigrationInput) (*aws.Request, *s3.GetObjectMigrationOutput)
 
-	GetBucketPublicNetworkBlock(*s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error)
+	GetObjectMigrationWithContext(aws.Context, *s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error)
 
-	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	GetObjectRequest(*s3.GetObjectInput) (*aws.Request, *s3.GetObjectOutput)
 
-	GetObjectMigration(*s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error)
+	GetObjectTagging(*s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error)
 
-	GetObjectACL(*s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
+	GetObjectTaggingRequest(*s3.GetObjectTaggingInput) (*aws.Request, *s3.GetObjectTaggingOutput)
+
+	GetObjectTaggingWithContext(aws.Context, *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error)
+
+	GetObjectToFile(*s3.GetObjectInput, string) error
+
+	GetObjectToFileWithContext(aws.Context, *s3.GetObjectInput, string) error
 
 	GetObjectTorrent(*s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
 
-	InitiateBucketWorm(*s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error)
+	GetObjectTorrentRequest(*s3.GetObjectTorrentInput) (*aws.Request, *s3.GetObjectTorrentOutput)
+
+	GetObjectTorrentWithContext(aws.Context, *s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
+
+	GetObjectWithContext(aws.Context, *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+
+	GetPublicNetworkBlock(*s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error)
+
+	GetPublicNetworkBlockRequest(*s3.GetPublicNetworkBlockInput) (*aws.Request, *s3.GetPublicNetworkBlockOutput)
+
+	GetPublicNetworkBlockWithContext(aws.Context, *s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error)
+
+	GetRequesterQos(*s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error)
+
+	GetRequesterQosRequest(*s3.GetRequesterQosInput) (*aws.Request, *s3.GetRequesterQosOutput)
+
+	GetRequesterQosWithContext(aws.Context, *s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error)
+
+	GetVpcAccessBlock(*s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error)
+
+	GetVpcAccessBlockRequest(*s3.GetVpcAccessBlockInput) (*aws.Request, *s3.GetVpcAccessBlockOutput)
+
+	GetVpcAccessBlockWithContext(aws.Context, *s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error)
 
 	HeadBucket(*s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
 
+	HeadBucketExist(string) (bool, error)
+
+	HeadBucketExistWithContext(aws.Context, string) (bool, error)
+
+	HeadBucketRequest(*s3.HeadBucketInput) (*aws.Request, *s3.HeadBucketOutput)
+
+	HeadBucketWithContext(aws.Context, *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+
 	HeadObject(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 
+	HeadObjectRequest(*s3.HeadObjectInput) (*aws.Request, *s3.HeadObjectOutput)
+
+	HeadObjectWithContext(aws.Context, *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+
+	InitiateBucketWorm(*s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error)
+
+	InitiateBucketWormRequest(*s3.InitiateBucketWormInput) (*aws.Request, *s3.InitiateBucketWormOutput)
+
+	InitiateBucketWormWithContext(aws.Context, *s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error)
+
+	ListBucketInventory(*s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error)
+
+	ListBucketInventoryRequest(*s3.ListBucketInventoryInput) (*aws.Request, *s3.ListBucketInventoryOutput)
+
+	ListBucketInventoryWithContext(aws.Context, *s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error)
+
 	ListBuckets(*s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
 
+	ListBucketsRequest(*s3.ListBucketsInput) (*aws.Request, *s3.ListBucketsOutput)
+
+	ListBucketsWithContext(aws.Context, *s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
+
+	ListJobs(*s3.ListJobsInput) (*s3.ListJobsOutput, error)
+
+	ListJobsRequest(*s3.ListJobsInput) (*aws.Request, *s3.ListJobsOutput)
+
+	ListJobsWithContext(aws.Context, *s3.ListJobsInput) (*s3.ListJobsOutput, error)
+
 	ListMultipartUploads(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsPages(*s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool) error
+
+	ListMultipartUploadsRequest(*s3.ListMultipartUploadsInput) (*aws.Request, *s3.ListMultipartUploadsOutput)
+
+	ListMultipartUploadsWithContext(aws.Context, *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
+
 	ListObjectVersions(*s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 
+	ListObjectVersionsPages(*s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool) error
+
+	ListObjectVersionsRequest(*s3.ListObjectVersionsInput) (*aws.Request, *s3.ListObjectVersionsOutput)
+
+	ListObjectVersionsWithContext(aws.Context, *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+
 	ListObjects(*s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
 
+	ListObjectsPages(*s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool) error
+
+	ListObjectsRequest(*s3.ListObjectsInput) (*aws.Request, *s3.ListObjectsOutput)
+
 	ListObjectsV2(*s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 
+	ListObjectsV2Pages(*s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool) error
+
+	ListObjectsV2Request(*s3.ListObjectsV2Input) (*aws.Request, *s3.ListObjectsV2Output)
+
+	ListObjectsV2WithContext(aws.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+
+	ListObjectsWithContext(aws.Context, *s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
+
 	ListParts(*s3.ListPartsInput) (*s3.ListPartsOutput, error)
 
+	ListPartsPages(*s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool) error
+
+	ListPartsRequest(*s3.ListPartsInput) (*aws.Request, *s3.ListPartsOutput)
+
+	ListPartsWithContext(aws.Context, *s3.ListPartsInput) (*s3.ListPartsOutput, error)
+
 	ListRetention(*s3.ListRetentionInput) (*s3.ListRetentionOutput, error)
 
-	ListBucketInventory(*s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error)
+	ListRetentionRequest(*s3.ListRetentionInput) (*aws.Request, *s3.ListRetentionOutput)
 
-	ListJobs(*s3.ListJobsInput) (*s3.ListJobsOutput, error)
+	ListRetentionWithContext(aws.Context, *s3.ListRetentionInput) (*s3.ListRetentionOutput, error)
+
+	NewListBucketInventoryPaginator(*s3.ListBucketInventoryInput, ...aws.PagerOption) *s3.ListBucketInventoryPaginator
+
+	NewListBucketsPaginator(*s3.ListBucketsInput, ...aws.PagerOption) *s3.ListBucketsPaginator
+
+	NewListJobsPaginator(*s3.ListJobsInput, ...aws.PagerOption) *s3.ListJobsPaginator
+
+	NewListMultipartUploadsPaginator(*s3.ListMultipartUploadsInput, ...aws.PagerOption) *s3.ListMultipartUploadsPaginator
+
+	NewListObjectVersionsPaginator(*s3.ListObjectVersionsInput, ...aws.PagerOption) *s3.ListObjectVersionsPaginator
+
+	NewListObjectsPaginator(*s3.ListObjectsInput, ...aws.PagerOption) *s3.ListObjectsPaginator
+
+	NewListObjectsV2Paginator(*s3.ListObjectsV2Input, ...aws.PagerOption) *s3.ListObjectsV2Paginator
+
+	NewListPartsPaginator(*s3.ListPartsInput, ...aws.PagerOption) *s3.ListPartsPaginator
+
+	NewListRetentionPaginator(*s3.ListRetentionInput, ...aws.PagerOption) *s3.ListRetentionPaginator
+
+	PresignPostObject(*s3.PresignPostObjectInput) (*s3.PresignPostObjectOutput, error)
 
 	PutBucketACL(*s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
 
+	PutBucketACLRequest(*s3.PutBucketACLInput) (*aws.Request, *s3.PutBucketACLOutput)
+
+	PutBucketACLWithContext(aws.Context, *s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
+
+	PutBucketAccessMonitor(*s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error)
+
+	PutBucketAccessMonitorRequest(*s3.PutBucketAccessMonitorInput) (*aws.Request, *s3.PutBucketAccessMonitorOutput)
+
+	PutBucketAccessMonitorWithContext(aws.Context, *s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error)
+
 	PutBucketCORS(*s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
 
+	PutBucketCORSRequest(*s3.PutBucketCORSInput) (*aws.Request, *s3.PutBucketCORSOutput)
+
+	PutBucketCORSWithContext(aws.Context, *s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
+
+	PutBucketDataAccelerator(*s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error)
+
+	PutBucketDataAcceleratorRequest(*s3.PutBucketDataAcceleratorInput) (*aws.Request, *s3.PutBucketDataAcceleratorOutput)
+
+	PutBucketDataAcceleratorWithContext(aws.Context, *s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error)
+
+	PutBucketDataRedundancySwitch(*s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error)
+
+	PutBucketDataRedundancySwitchRequest(*s3.PutBucketDataRedundancySwitchInput) (*aws.Request, *s3.PutBucketDataRedundancySwitchOutput)
+
+	PutBucketDataRedundancySwitchWithContext(aws.Context, *s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error)
+
+	PutBucketDecompressPolicy(*s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error)
+
+	PutBucketDecompressPolicyRequest(*s3.PutBucketDecompressPolicyInput) (*aws.Request, *s3.PutBucketDecompressPolicyOutput)
+
+	PutBucketDecompressPolicyWithContext(aws.Context, *s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error)
+
+	PutBucketEncryption(*s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error)
+
+	PutBucketEncryptionRequest(*s3.PutBucketEncryptionInput) (*aws.Request, *s3.PutBucketEncryptionOutput)
+
+	PutBucketEncryptionWithContext(aws.Context, *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error)
+
+	PutBucketInventory(*s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error)
+
+	PutBucketInventoryRequest(*s3.PutBucketInventoryInput) (*aws.Request, *s3.PutBucketInventoryOutput)
+
+	PutBucketInventoryWithContext(aws.Context, *s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error)
+
 	PutBucketLifecycle(*s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
 
-	PutBucketAccessMonitor(*s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error)
+	PutBucketLifecycleRequest(*s3.PutBucketLifecycleInput) (*aws.Request, *s3.PutBucketLifecycleOutput)
+
+	PutBucketLifecycleWithContext(aws.Context, *s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
 
 	PutBucketLogging(*s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
 
+	PutBucketLoggingRequest(*s3.PutBucketLoggingInput) (*aws.Request, *s3.PutBucketLoggingOutput)
+
+	PutBucketLoggingWithContext(aws.Context, *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
+
+	PutBucketMirror(*s3.PutBucketMirrorInput) (*s3.PutBucketMirrorOutput, error)
+
+	PutBucketMirrorRequest(*s3.PutBucketMirrorInput) (*aws.Request, *s3.PutBucketMirrorOutput)
+
+	PutBucketMirrorWithContext(aws.Context, *s3.PutBucketMirrorInput) (*s3.PutBucketMirrorOutput, error)
+
 	PutBucketNotification(*s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
 
+	PutBucketNotificationRequest(*s3.PutBucketNotificationInput) (*aws.Request, *s3.PutBucketNotificationOutput)
+
+	PutBucketNotificationWithContext(aws.Context, *s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
+
 	PutBucketPolicy(*s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
 
+	PutBucketPolicyRequest(*s3.PutBucketPolicyInput) (*aws.Request, *s3.PutBucketPolicyOutput)
+
+	PutBucketPolicyWithContext(aws.Context, *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
+
+	PutBucketPublicNetworkBlock(*s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error)
+
+	PutBucketPublicNetworkBlockRequest(*s3.PutBucketPublicNetworkBlockInput) (*aws.Request, *s3.PutBucketPublicNetworkBlockOutput)
+
+	PutBucketPublicNetworkBlockWithContext(aws.Context, *s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error)
+
+	PutBucketQos(*s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error)
+
+	PutBucketQosRequest(*s3.PutBucketQosInput) (*aws.Request, *s3.PutBucketQosOutput)
+
+	PutBucketQosWithContext(aws.Context, *s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error)
+
+	PutBucketQuota(*s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error)
+
+	PutBucketQuotaRequest(*s3.PutBucketQuotaInput) (*aws.Request, *s3.PutBucketQuotaOutput)
+
+	PutBucketQuotaWithContext(aws.Context, *s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error)
+
 	PutBucketReplication(*s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
 
+	PutBucketReplicationRequest(*s3.PutBucketReplicationInput) (*aws.Request, *s3.PutBucketReplicationOutput)
+
+	PutBucketReplicationWithContext(aws.Context, *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
+
 	PutBucketRequestPayment(*s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
 
+	PutBucketRequestPaymentRequest(*s3.PutBucketRequestPaymentInput) (*aws.Request, *s3.PutBucketRequestPaymentOutput)
+
+	PutBucketRequestPaymentWithContext(aws.Context, *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
+
+	PutBucketRetention(*s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error)
+
+	PutBucketRetentionRequest(*s3.PutBucketRetentionInput) (*aws.Request, *s3.PutBucketRetentionOutput)
+
+	PutBucketRetentionWithContext(aws.Context, *s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error)
+
 	PutBucketTagging(*s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
 
+	PutBucketTaggingRequest(*s3.PutBucketTaggingInput) (*aws.Request, *s3.PutBucketTaggingOutput)
+
+	PutBucketTaggingWithContext(aws.Context, *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
+
+	PutBucketTransferAcceleration(*s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error)
+
+	PutBucketTransferAccelerationRequest(*s3.PutBucketTransferAccelerationInput) (*aws.Request, *s3.PutBucketTransferAccelerationOutput)
+
+	PutBucketTransferAccelerationWithContext(aws.Context, *s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error)
+
 	PutBucketVersioning(*s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
 
+	PutBucketVersioningRequest(*s3.PutBucketVersioningInput) (*aws.Request, *s3.PutBucketVersioningOutput)
+
+	PutBucketVersioningWithContext(aws.Context, *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
+
 	PutBucketWebsite(*s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
 
-	PutBucketDecompressPolicy(*s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error)
+	PutBucketWebsiteRequest(*s3.PutBucketWebsiteInput) (*aws.Request, *s3.PutBucketWebsiteOutput)
 
-	PutBucketRetention(*s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error)
+	PutBucketWebsiteWithContext(aws.Context, *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
 
-	PutBucketInventory(*s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error)
+	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
 
-	PutBucketDataRedundancySwitch(*s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error)
+	PutObjectACL(*s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
 
-	PutBucketQos(*s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error)
+	PutObjectACLRequest(*s3.PutObjectACLInput) (*aws.Request, *s3.PutObjectACLOutput)
 
-	PutRequesterQos(*s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error)
+	PutObjectACLWithContext(aws.Context, *s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
 
-	PutBucketEncryption(*s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error)
+	PutObjectDataRedundancyTransition(*s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error)
 
-	PutBucketDataAccelerator(*s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error)
+	PutObjectDataRedundancyTransitionRequest(*s3.PutObjectDataRedundancyTransitionInput) (*aws.Request, *s3.PutObjectDataRedundancyTransitionOutput)
 
-	PutBucketTransferAcceleration(*s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error)
+	PutObjectDataRedundancyTransitionWithContext(aws.Context, *s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error)
 
-	PutVpcAccessBlock(*s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error)
+	PutObjectMigration(*s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error)
 
-	PutBucketQuota(*s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error)
+	PutObjectMigrationRequest(*s3.PutObjectMigrationInput) (*aws.Request, *s3.PutObjectMigrationOutput)
+
+	PutObjectMigrationWithContext(aws.Context, *s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error)
+
+	PutObjectPreassignedInput(*s3.PutObjectInput) (*http.Request, error)
+
+	PutObjectRequest(*s3.PutObjectInput) (*aws.Request, *s3.PutObjectOutput)
+
+	PutObjectTagging(*s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error)
+
+	PutObjectTaggingRequest(*s3.PutObjectTaggingInput) (*aws.Request, *s3.PutObjectTaggingOutput)
+
+	PutObjectTaggingWithContext(aws.Context, *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error)
+
+	PutObjectWithContext(aws.Context, *s3.PutObjectInput) (*s3.PutObjectOutput, error)
 
 	PutPublicNetworkBlock(*s3.PutPublicNetworkBlockInput) (*s3.PutPublicNetworkBlockOutput, error)
 
-	PutBucketPublicNetworkBlock(*s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error)
+	PutPublicNetworkBlockRequest(*s3.PutPublicNetworkBlockInput) (*aws.Request, *s3.PutPublicNetworkBlockOutput)
 
-	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
+	PutPublicNetworkBlockWithContext(aws.Context, *s3.PutPublicNetworkBlockInput) (*s3.PutPublicNetworkBlockOutput, error)
 
-	PutObjectMigration(*s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error)
+	PutReader(*s3.PutReaderInput) (*s3.PutObjectOutput, error)
 
-	PutObjectACL(*s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
+	PutReaderRequest(*s3.PutReaderInput) (*aws.Request, *s3.PutObjectOutput)
 
-	PutObjectDataRedundancyTransition(*s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error)
+	PutReaderWithContext(aws.Context, *s3.PutReaderInput) (*s3.PutObjectOutput, error)
 
-	RestoreObject(*s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
+	PutRequesterQos(*s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error)
+
+	PutRequesterQosRequest(*s3.PutRequesterQosInput) (*aws.Request, *s3.PutRequesterQosOutput)
+
+	PutRequesterQosWithContext(aws.Context, *s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error)
+
+	PutVpcAccessBlock(*s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error)
+
+	PutVpcAccessBlockRequest(*s3.PutVpcAccessBlockInput) (*aws.Request, *s3.PutVpcAccessBlockOutput)
+
+	PutVpcAccessBlockWithContext(aws.Context, *s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error)
+
+	QueryBucketRank(*s3.QueryBucketRankInput) (*s3.QueryBucketRankOutput, error)
+
+	QueryBucketRankRequest(*s3.QueryBucketRankInput) (*aws.Request, *s3.QueryBucketRankOutput)
+
+	QueryBucketRankWithContext(aws.Context, *s3.QueryBucketRankInput) (*s3.QueryBucketRankOutput, error)
+
+	QueryKs3Data(*s3.QueryKs3DataInput) (*s3.QueryKs3DataOutput, error)
+
+	QueryKs3DataRequest(*s3.QueryKs3DataInput) (*aws.Request, *s3.QueryKs3DataOutput)
+
+	QueryKs3DataWithContext(aws.Context, *s3.QueryKs3DataInput) (*s3.QueryKs3DataOutput, error)
 
 	RecoverObject(*s3.RecoverObjectInput) (*s3.RecoverObjectOutput, error)
 
+	RecoverObjectRequest(*s3.RecoverObjectInput) (*aws.Request, *s3.RecoverObjectOutput)
+
+	RecoverObjectWithContext(aws.Context, *s3.RecoverObjectInput) (*s3.RecoverObjectOutput, error)
+
+	RestoreObject(*s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
+
+	RestoreObjectRequest(*s3.RestoreObjectInput) (*aws.Request, *s3.RestoreObjectOutput)
+
+	RestoreObjectWithContext(aws.Context, *s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
+
+	SaveObjectToFile(string, *s3.GetObjectInput, *s3.GetObjectOutput) error
+
+	TryDeleteBucketPrefix(*s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+
+	TryDeleteBucketPrefixWithContext(aws.Context, *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+
+	UpdateJobPriority(*s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error)
+
+	UpdateJobPriorityRequest(*s3.UpdateJobPriorityInput) (*aws.Request, *s3.UpdateJobPriorityOutput)
+
+	UpdateJobPriorityWithContext(aws.Context, *s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error)
+
+	UploadFile(*s3.UploadFileInput) (*s3.UploadFileOutput, error)
+
+	UploadFileWithContext(context.Context, *s3.UploadFileInput) (*s3.UploadFileOutput, error)
+
 	UploadPart(*s3.UploadPartInput) (*s3.UploadPartOutput, error)
 
 	UploadPartCopy(*s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
 
-	UpdateJobPriority(*s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error)
+	UploadPartCopyRequest(*s3.UploadPartCopyInput) (*aws.Request, *s3.UploadPartCopyOutput)
+
+	UploadPartCopyWithContext(aws.Context, *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+
+	UploadPartRequest(*s3.UploadPartInput) (*aws.Request, *s3.UploadPartOutput)
+
+	UploadPartWithContext(aws.Context, *s3.UploadPartInput) (*s3.UploadPartOutput, error)
+
+	WaitUntilBucketExists(*s3.HeadBucketInput) error
+
+	WaitUntilBucketExistsWithContext(aws.Context, *s3.HeadBucketInput, ...aws.WaiterOption) error
+
+	WaitUntilJobComplete(*s3.DescribeJobInput) error
+
+	WaitUntilJobCompleteWithContext(aws.Context, *s3.DescribeJobInput, ...aws.WaiterOption) error
+
+	WaitUntilObjectExists(*s3.HeadObjectInput) error
+
+	WaitUntilObjectExistsWithContext(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
+
+	WaitUntilObjectNotExists(*s3.HeadObjectInput) error
+
+	WaitUntilObjectNotExistsWithContext(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
+
+	WaitUntilRestoreCompleted(*s3.HeadObjectInput) error
+
+	WaitUntilRestoreCompletedWithContext(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
 }
+
+var _ S3API = (*s3.S3)(nil)
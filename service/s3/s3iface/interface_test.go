@@ -0,0 +1,50 @@
+package s3iface
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestS3APIComplete checks that the interface was generated again after
+// methods were added to s3.S3.
+func TestS3APIComplete(t *testing.T) {
+	api := reflect.TypeOf((*S3API)(nil)).Elem()
+	service := reflect.TypeOf((*aws.Service)(nil))
+	client := reflect.TypeOf((*s3.S3)(nil))
+	for i := 0; i < client.NumMethod(); i++ {
+		m := client.Method(i)
+		if _, promoted := service.MethodByName(m.Name); promoted {
+			continue
+		}
+		// The methods of the files with build constraints are left out.
+		if strings.Contains(m.Type.String(), "iter.Seq") {
+			continue
+		}
+		_, ok := api.MethodByName(m.Name)
+		assert.True(t, ok, "S3API misses %s, run go generate in service/s3/s3iface", m.Name)
+	}
+}
+
+func TestMockS3API(t *testing.T) {
+	var input *s3.PutObjectInput
+	var api S3API = &MockS3API{
+		PutObjectFunc: func(in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+			input = in
+			return &s3.PutObjectOutput{ETag: aws.String(`"etag"`)}, nil
+		},
+	}
+
+	out, err := api.PutObject(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err)
+	assert.Equal(t, `"etag"`, aws.ToString(out.ETag))
+	assert.Equal(t, "key", aws.ToString(input.Key))
+
+	assert.PanicsWithValue(t, "s3iface: MockS3API.GetObjectFunc is not set", func() {
+		api.GetObject(&s3.GetObjectInput{})
+	})
+}
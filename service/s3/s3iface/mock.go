@@ -0,0 +1,3267 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package s3iface
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3"
+)
+
+// MockS3API is an S3API for tests, whose methods call the function of the
+// field named after them, such as PutObjectFunc for PutObject. Calling a
+// method whose function is not set panics.
+type MockS3API struct {
+	AbortBucketWormFunc                              func(*s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error)
+	AbortBucketWormRequestFunc                       func(*s3.AbortBucketWormInput) (*aws.Request, *s3.AbortBucketWormOutput)
+	AbortBucketWormWithContextFunc                   func(aws.Context, *s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error)
+	AbortMultipartUploadFunc                         func(*s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
+	AbortMultipartUploadRequestFunc                  func(*s3.AbortMultipartUploadInput) (*aws.Request, *s3.AbortMultipartUploadOutput)
+	AbortMultipartUploadWithContextFunc              func(aws.Context, *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
+	AppendObjectFunc                                 func(*s3.AppendObjectInput) (*s3.AppendObjectOutput, error)
+	AppendObjectRequestFunc                          func(*s3.AppendObjectInput) (*aws.Request, *s3.AppendObjectOutput)
+	AppendObjectWithContextFunc                      func(aws.Context, *s3.AppendObjectInput) (*s3.AppendObjectOutput, error)
+	ClearObjectFunc                                  func(*s3.ClearObjectInput) (*s3.ClearObjectOutput, error)
+	ClearObjectRequestFunc                           func(*s3.ClearObjectInput) (*aws.Request, *s3.ClearObjectOutput)
+	ClearObjectWithContextFunc                       func(aws.Context, *s3.ClearObjectInput) (*s3.ClearObjectOutput, error)
+	CompleteBucketWormFunc                           func(*s3.CompleteBucketWormInput) (*s3.CompleteBucketWormOutput, error)
+	CompleteBucketWormRequestFunc                    func(*s3.CompleteBucketWormInput) (*aws.Request, *s3.CompleteBucketWormOutput)
+	CompleteBucketWormWithContextFunc                func(aws.Context, *s3.CompleteBucketWormInput) (*s3.CompleteBucketWormOutput, error)
+	CompleteMultipartUploadFunc                      func(*s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
+	CompleteMultipartUploadRequestFunc               func(*s3.CompleteMultipartUploadInput) (*aws.Request, *s3.CompleteMultipartUploadOutput)
+	CompleteMultipartUploadWithContextFunc           func(aws.Context, *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
+	CopyFileFunc                                     func(*s3.CopyFileInput) (*s3.CopyFileOutput, error)
+	CopyFileAcrossRegionFunc                         func(*s3.CopyFileInput, *s3.S3) (*s3.UploadFileOutput, error)
+	CopyFileAcrossRegionWithContextFunc              func(context.Context, *s3.CopyFileInput, *s3.S3) (*s3.UploadFileOutput, error)
+	CopyFileWithContextFunc                          func(context.Context, *s3.CopyFileInput) (*s3.CopyFileOutput, error)
+	CopyObjectFunc                                   func(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
+	CopyObjectRequestFunc                            func(*s3.CopyObjectInput) (*aws.Request, *s3.CopyObjectOutput)
+	CopyObjectWithContextFunc                        func(aws.Context, *s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
+	CreateBucketFunc                                 func(*s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
+	CreateBucketRequestFunc                          func(*s3.CreateBucketInput) (*aws.Request, *s3.CreateBucketOutput)
+	CreateBucketWithContextFunc                      func(aws.Context, *s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
+	CreateJobFunc                                    func(*s3.CreateJobInput) (*s3.CreateJobOutput, error)
+	CreateJobRequestFunc                             func(*s3.CreateJobInput) (*aws.Request, *s3.CreateJobOutput)
+	CreateJobWithContextFunc                         func(aws.Context, *s3.CreateJobInput) (*s3.CreateJobOutput, error)
+	CreateMultipartUploadFunc                        func(*s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
+	CreateMultipartUploadRequestFunc                 func(*s3.CreateMultipartUploadInput) (*aws.Request, *s3.CreateMultipartUploadOutput)
+	CreateMultipartUploadWithContextFunc             func(aws.Context, *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
+	DeleteBucketFunc                                 func(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
+	DeleteBucketCORSFunc                             func(*s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
+	DeleteBucketCORSRequestFunc                      func(*s3.DeleteBucketCORSInput) (*aws.Request, *s3.DeleteBucketCORSOutput)
+	DeleteBucketCORSWithContextFunc                  func(aws.Context, *s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
+	DeleteBucketDataAcceleratorFunc                  func(*s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error)
+	DeleteBucketDataAcceleratorRequestFunc           func(*s3.DeleteBucketDataAcceleratorInput) (*aws.Request, *s3.DeleteBucketDataAcceleratorOutput)
+	DeleteBucketDataAcceleratorWithContextFunc       func(aws.Context, *s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error)
+	DeleteBucketDecompressPolicyFunc                 func(*s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error)
+	DeleteBucketDecompressPolicyRequestFunc          func(*s3.DeleteBucketDecompressPolicyInput) (*aws.Request, *s3.DeleteBucketDecompressPolicyOutput)
+	DeleteBucketDecompressPolicyWithContextFunc      func(aws.Context, *s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error)
+	DeleteBucketEncryptionFunc                       func(*s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error)
+	DeleteBucketEncryptionRequestFunc                func(*s3.DeleteBucketEncryptionInput) (*aws.Request, *s3.DeleteBucketEncryptionOutput)
+	DeleteBucketEncryptionWithContextFunc            func(aws.Context, *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error)
+	DeleteBucketInventoryFunc                        func(*s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error)
+	DeleteBucketInventoryRequestFunc                 func(*s3.DeleteBucketInventoryInput) (*aws.Request, *s3.DeleteBucketInventoryOutput)
+	DeleteBucketInventoryWithContextFunc             func(aws.Context, *s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error)
+	DeleteBucketLifecycleFunc                        func(*s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
+	DeleteBucketLifecycleRequestFunc                 func(*s3.DeleteBucketLifecycleInput) (*aws.Request, *s3.DeleteBucketLifecycleOutput)
+	DeleteBucketLifecycleWithContextFunc             func(aws.Context, *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
+	DeleteBucketMirrorFunc                           func(*s3.DeleteBucketMirrorInput) (*s3.DeleteBucketMirrorOutput, error)
+	DeleteBucketMirrorRequestFunc                    func(*s3.DeleteBucketMirrorInput) (*aws.Request, *s3.DeleteBucketMirrorOutput)
+	DeleteBucketMirrorWithContextFunc                func(aws.Context, *s3.DeleteBucketMirrorInput) (*s3.DeleteBucketMirrorOutput, error)
+	DeleteBucketNotificationFunc                     func(*s3.DeleteBucketNotificationInput) (*s3.DeleteBucketNotificationOutput, error)
+	DeleteBucketNotificationRequestFunc              func(*s3.DeleteBucketNotificationInput) (*aws.Request, *s3.DeleteBucketNotificationOutput)
+	DeleteBucketNotificationWithContextFunc          func(aws.Context, *s3.DeleteBucketNotificationInput) (*s3.DeleteBucketNotificationOutput, error)
+	DeleteBucketPolicyFunc                           func(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
+	DeleteBucketPolicyRequestFunc                    func(*s3.DeleteBucketPolicyInput) (*aws.Request, *s3.DeleteBucketPolicyOutput)
+	DeleteBucketPolicyWithContextFunc                func(aws.Context, *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
+	DeleteBucketPrefixFunc                           func(*s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+	DeleteBucketPrefixWithContextFunc                func(aws.Context, *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+	DeleteBucketPublicNetworkBlockFunc               func(*s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error)
+	DeleteBucketPublicNetworkBlockRequestFunc        func(*s3.DeleteBucketPublicNetworkBlockInput) (*aws.Request, *s3.DeleteBucketPublicNetworkBlockOutput)
+	DeleteBucketPublicNetworkBlockWithContextFunc    func(aws.Context, *s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error)
+	DeleteBucketQosFunc                              func(*s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error)
+	DeleteBucketQosRequestFunc                       func(*s3.DeleteBucketQosInput) (*aws.Request, *s3.DeleteBucketQosOutput)
+	DeleteBucketQosWithContextFunc                   func(aws.Context, *s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error)
+	DeleteBucketQuotaFunc                            func(*s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error)
+	DeleteBucketQuotaRequestFunc                     func(*s3.DeleteBucketQuotaInput) (*aws.Request, *s3.DeleteBucketQuotaOutput)
+	DeleteBucketQuotaWithContextFunc                 func(aws.Context, *s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error)
+	DeleteBucketReplicationFunc                      func(*s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
+	DeleteBucketReplicationRequestFunc               func(*s3.DeleteBucketReplicationInput) (*aws.Request, *s3.DeleteBucketReplicationOutput)
+	DeleteBucketReplicationWithContextFunc           func(aws.Context, *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
+	DeleteBucketRequestFunc                          func(*s3.DeleteBucketInput) (*aws.Request, *s3.DeleteBucketOutput)
+	DeleteBucketTaggingFunc                          func(*s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
+	DeleteBucketTaggingRequestFunc                   func(*s3.DeleteBucketTaggingInput) (*aws.Request, *s3.DeleteBucketTaggingOutput)
+	DeleteBucketTaggingWithContextFunc               func(aws.Context, *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
+	DeleteBucketWebsiteFunc                          func(*s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
+	DeleteBucketWebsiteRequestFunc                   func(*s3.DeleteBucketWebsiteInput) (*aws.Request, *s3.DeleteBucketWebsiteOutput)
+	DeleteBucketWebsiteWithContextFunc               func(aws.Context, *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
+	DeleteBucketWithContextFunc                      func(aws.Context, *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
+	DeleteJobFunc                                    func(*s3.DeleteJobInput) (*s3.DeleteJobOutput, error)
+	DeleteJobRequestFunc                             func(*s3.DeleteJobInput) (*aws.Request, *s3.DeleteJobOutput)
+	DeleteJobWithContextFunc                         func(aws.Context, *s3.DeleteJobInput) (*s3.DeleteJobOutput, error)
+	DeleteObjectFunc                                 func(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+	DeleteObjectRequestFunc                          func(*s3.DeleteObjectInput) (*aws.Request, *s3.DeleteObjectOutput)
+	DeleteObjectTaggingFunc                          func(*s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error)
+	DeleteObjectTaggingRequestFunc                   func(*s3.DeleteObjectTaggingInput) (*aws.Request, *s3.DeleteObjectTaggingOutput)
+	DeleteObjectTaggingWithContextFunc               func(aws.Context, *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error)
+	DeleteObjectWithContextFunc                      func(aws.Context, *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+	DeleteObjectsFunc                                func(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	DeleteObjectsRequestFunc                         func(*s3.DeleteObjectsInput) (*aws.Request, *s3.DeleteObjectsOutput)
+	DeleteObjectsWithContextFunc                     func(aws.Context, *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	DeletePublicNetworkBlockFunc                     func(*s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error)
+	DeletePublicNetworkBlockRequestFunc              func(*s3.DeletePublicNetworkBlockInput) (*aws.Request, *s3.DeletePublicNetworkBlockOutput)
+	DeletePublicNetworkBlockWithContextFunc          func(aws.Context, *s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error)
+	DeleteRequesterQosFunc                           func(*s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error)
+	DeleteRequesterQosRequestFunc                    func(*s3.DeleteRequesterQosInput) (*aws.Request, *s3.DeleteRequesterQosOutput)
+	DeleteRequesterQosWithContextFunc                func(aws.Context, *s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error)
+	DeleteVpcAccessBlockFunc                         func(*s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error)
+	DeleteVpcAccessBlockRequestFunc                  func(*s3.DeleteVpcAccessBlockInput) (*aws.Request, *s3.DeleteVpcAccessBlockOutput)
+	DeleteVpcAccessBlockWithContextFunc              func(aws.Context, *s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error)
+	DescribeJobFunc                                  func(*s3.DescribeJobInput) (*s3.DescribeJobOutput, error)
+	DescribeJobRequestFunc                           func(*s3.DescribeJobInput) (*aws.Request, *s3.DescribeJobOutput)
+	DescribeJobWithContextFunc                       func(aws.Context, *s3.DescribeJobInput) (*s3.DescribeJobOutput, error)
+	DownloadFileFunc                                 func(*s3.DownloadFileInput) (*s3.DownloadFileOutput, error)
+	DownloadFileWithContextFunc                      func(context.Context, *s3.DownloadFileInput) (*s3.DownloadFileOutput, error)
+	ExtendBucketWormFunc                             func(*s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error)
+	ExtendBucketWormRequestFunc                      func(*s3.ExtendBucketWormInput) (*aws.Request, *s3.ExtendBucketWormOutput)
+	ExtendBucketWormWithContextFunc                  func(aws.Context, *s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error)
+	FetchObjectFunc                                  func(*s3.FetchObjectInput) (*s3.FetchObjectOutput, error)
+	FetchObjectRequestFunc                           func(*s3.FetchObjectInput) (*aws.Request, *s3.FetchObjectOutput)
+	FetchObjectWithContextFunc                       func(aws.Context, *s3.FetchObjectInput) (*s3.FetchObjectOutput, error)
+	GeneratePresignedUrlFunc                         func(*s3.GeneratePresignedUrlInput) (string, error)
+	GeneratePresignedUrlInputFunc                    func(*s3.GeneratePresignedUrlInput) string
+	GenerateShareUrlFunc                             func(*s3.GenerateShareUrlInput) (string, error)
+	GetBucketACLFunc                                 func(*s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
+	GetBucketACLRequestFunc                          func(*s3.GetBucketACLInput) (*aws.Request, *s3.GetBucketACLOutput)
+	GetBucketACLWithContextFunc                      func(aws.Context, *s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
+	GetBucketAccessMonitorFunc                       func(*s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error)
+	GetBucketAccessMonitorRequestFunc                func(*s3.GetBucketAccessMonitorInput) (*aws.Request, *s3.GetBucketAccessMonitorOutput)
+	GetBucketAccessMonitorWithContextFunc            func(aws.Context, *s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error)
+	GetBucketCORSFunc                                func(*s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
+	GetBucketCORSRequestFunc                         func(*s3.GetBucketCORSInput) (*aws.Request, *s3.GetBucketCORSOutput)
+	GetBucketCORSWithContextFunc                     func(aws.Context, *s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
+	GetBucketDataAcceleratorFunc                     func(*s3.GetBucketDataAcceleratorInput) (*s3.GetBucketDataAcceleratorOutput, error)
+	GetBucketDataAcceleratorRequestFunc              func(*s3.GetBucketDataAcceleratorInput) (*aws.Request, *s3.GetBucketDataAcceleratorOutput)
+	GetBucketDataAcceleratorWithContextFunc          func(aws.Context, *s3.GetBucketDataAcceleratorInput) (*s3.GetBucketDataAcceleratorOutput, error)
+	GetBucketDataRedundancySwitchFunc                func(*s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error)
+	GetBucketDataRedundancySwitchRequestFunc         func(*s3.GetBucketDataRedundancySwitchInput) (*aws.Request, *s3.GetBucketDataRedundancySwitchOutput)
+	GetBucketDataRedundancySwitchWithContextFunc     func(aws.Context, *s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error)
+	GetBucketDecompressPolicyFunc                    func(*s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error)
+	GetBucketDecompressPolicyRequestFunc             func(*s3.GetBucketDecompressPolicyInput) (*aws.Request, *s3.GetBucketDecompressPolicyOutput)
+	GetBucketDecompressPolicyWithContextFunc         func(aws.Context, *s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error)
+	GetBucketEncryptionFunc                          func(*s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+	GetBucketEncryptionRequestFunc                   func(*s3.GetBucketEncryptionInput) (*aws.Request, *s3.GetBucketEncryptionOutput)
+	GetBucketEncryptionWithContextFunc               func(aws.Context, *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+	GetBucketInventoryFunc                           func(*s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error)
+	GetBucketInventoryRequestFunc                    func(*s3.GetBucketInventoryInput) (*aws.Request, *s3.GetBucketInventoryOutput)
+	GetBucketInventoryWithContextFunc                func(aws.Context, *s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error)
+	GetBucketLifecycleFunc                           func(*s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
+	GetBucketLifecycleRequestFunc                    func(*s3.GetBucketLifecycleInput) (*aws.Request, *s3.GetBucketLifecycleOutput)
+	GetBucketLifecycleWithContextFunc                func(aws.Context, *s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
+	GetBucketLocationFunc                            func(*s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
+	GetBucketLocationRequestFunc                     func(*s3.GetBucketLocationInput) (*aws.Request, *s3.GetBucketLocationOutput)
+	GetBucketLocationWithContextFunc                 func(aws.Context, *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
+	GetBucketLoggingFunc                             func(*s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
+	GetBucketLoggingRequestFunc                      func(*s3.GetBucketLoggingInput) (*aws.Request, *s3.GetBucketLoggingOutput)
+	GetBucketLoggingWithContextFunc                  func(aws.Context, *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
+	GetBucketMirrorFunc                              func(*s3.GetBucketMirrorInput) (*s3.GetBucketMirrorOutput, error)
+	GetBucketMirrorRequestFunc                       func(*s3.GetBucketMirrorInput) (*aws.Request, *s3.GetBucketMirrorOutput)
+	GetBucketMirrorWithContextFunc                   func(aws.Context, *s3.GetBucketMirrorInput) (*s3.GetBucketMirrorOutput, error)
+	GetBucketNotificationFunc                        func(*s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error)
+	GetBucketNotificationRequestFunc                 func(*s3.GetBucketNotificationInput) (*aws.Request, *s3.GetBucketNotificationOutput)
+	GetBucketNotificationWithContextFunc             func(aws.Context, *s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error)
+	GetBucketPolicyFunc                              func(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
+	GetBucketPolicyRequestFunc                       func(*s3.GetBucketPolicyInput) (*aws.Request, *s3.GetBucketPolicyOutput)
+	GetBucketPolicyWithContextFunc                   func(aws.Context, *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
+	GetBucketPublicNetworkBlockFunc                  func(*s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error)
+	GetBucketPublicNetworkBlockRequestFunc           func(*s3.GetBucketPublicNetworkBlockInput) (*aws.Request, *s3.GetBucketPublicNetworkBlockOutput)
+	GetBucketPublicNetworkBlockWithContextFunc       func(aws.Context, *s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error)
+	GetBucketQosFunc                                 func(*s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error)
+	GetBucketQosRequestFunc                          func(*s3.GetBucketQosInput) (*aws.Request, *s3.GetBucketQosOutput)
+	GetBucketQosWithContextFunc                      func(aws.Context, *s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error)
+	GetBucketQuotaFunc                               func(*s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error)
+	GetBucketQuotaRequestFunc                        func(*s3.GetBucketQuotaInput) (*aws.Request, *s3.GetBucketQuotaOutput)
+	GetBucketQuotaWithContextFunc                    func(aws.Context, *s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error)
+	GetBucketReplicationFunc                         func(*s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
+	GetBucketReplicationRequestFunc                  func(*s3.GetBucketReplicationInput) (*aws.Request, *s3.GetBucketReplicationOutput)
+	GetBucketReplicationWithContextFunc              func(aws.Context, *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
+	GetBucketRequestPaymentFunc                      func(*s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
+	GetBucketRequestPaymentRequestFunc               func(*s3.GetBucketRequestPaymentInput) (*aws.Request, *s3.GetBucketRequestPaymentOutput)
+	GetBucketRequestPaymentWithContextFunc           func(aws.Context, *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
+	GetBucketRetentionFunc                           func(*s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error)
+	GetBucketRetentionRequestFunc                    func(*s3.GetBucketRetentionInput) (*aws.Request, *s3.GetBucketRetentionOutput)
+	GetBucketRetentionWithContextFunc                func(aws.Context, *s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error)
+	GetBucketTaggingFunc                             func(*s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
+	GetBucketTaggingRequestFunc                      func(*s3.GetBucketTaggingInput) (*aws.Request, *s3.GetBucketTaggingOutput)
+	GetBucketTaggingWithContextFunc                  func(aws.Context, *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
+	GetBucketTransferAccelerationFunc                func(*s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error)
+	GetBucketTransferAccelerationRequestFunc         func(*s3.GetBucketTransferAccelerationInput) (*aws.Request, *s3.GetBucketTransferAccelerationOutput)
+	GetBucketTransferAccelerationWithContextFunc     func(aws.Context, *s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error)
+	GetBucketVersioningFunc                          func(*s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
+	GetBucketVersioningRequestFunc                   func(*s3.GetBucketVersioningInput) (*aws.Request, *s3.GetBucketVersioningOutput)
+	GetBucketVersioningWithContextFunc               func(aws.Context, *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
+	GetBucketWebsiteFunc                             func(*s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
+	GetBucketWebsiteRequestFunc                      func(*s3.GetBucketWebsiteInput) (*aws.Request, *s3.GetBucketWebsiteOutput)
+	GetBucketWebsiteWithContextFunc                  func(aws.Context, *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
+	GetBucketWormFunc                                func(*s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error)
+	GetBucketWormRequestFunc                         func(*s3.GetBucketWormInput) (*aws.Request, *s3.GetBucketWormOutput)
+	GetBucketWormWithContextFunc                     func(aws.Context, *s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error)
+	GetObjectFunc                                    func(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	GetObjectACLFunc                                 func(*s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
+	GetObjectACLRequestFunc                          func(*s3.GetObjectACLInput) (*aws.Request, *s3.GetObjectACLOutput)
+	GetObjectACLWithContextFunc                      func(aws.Context, *s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
+	GetObjectMigrationFunc                           func(*s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error)
+	GetObjectMigrationRequestFunc                    func(*s3.GetObjectMigrationInput) (*aws.Request, *s3.GetObjectMigrationOutput)
+	GetObjectMigrationWithContextFunc                func(aws.Context, *s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error)
+	GetObjectRequestFunc                             func(*s3.GetObjectInput) (*aws.Request, *s3.GetObjectOutput)
+	GetObjectTaggingFunc                             func(*s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error)
+	GetObjectTaggingRequestFunc                      func(*s3.GetObjectTaggingInput) (*aws.Request, *s3.GetObjectTaggingOutput)
+	GetObjectTaggingWithContextFunc                  func(aws.Context, *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error)
+	GetObjectToFileFunc                              func(*s3.GetObjectInput, string) error
+	GetObjectToFileWithContextFunc                   func(aws.Context, *s3.GetObjectInput, string) error
+	GetObjectTorrentFunc                             func(*s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
+	GetObjectTorrentRequestFunc                      func(*s3.GetObjectTorrentInput) (*aws.Request, *s3.GetObjectTorrentOutput)
+	GetObjectTorrentWithContextFunc                  func(aws.Context, *s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
+	GetObjectWithContextFunc                         func(aws.Context, *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	GetPublicNetworkBlockFunc                        func(*s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error)
+	GetPublicNetworkBlockRequestFunc                 func(*s3.GetPublicNetworkBlockInput) (*aws.Request, *s3.GetPublicNetworkBlockOutput)
+	GetPublicNetworkBlockWithContextFunc             func(aws.Context, *s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error)
+	GetRequesterQosFunc                              func(*s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error)
+	GetRequesterQosRequestFunc                       func(*s3.GetRequesterQosInput) (*aws.Request, *s3.GetRequesterQosOutput)
+	GetRequesterQosWithContextFunc                   func(aws.Context, *s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error)
+	GetVpcAccessBlockFunc                            func(*s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error)
+	GetVpcAccessBlockRequestFunc                     func(*s3.GetVpcAccessBlockInput) (*aws.Request, *s3.GetVpcAccessBlockOutput)
+	GetVpcAccessBlockWithContextFunc                 func(aws.Context, *s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error)
+	HeadBucketFunc                                   func(*s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	HeadBucketExistFunc                              func(string) (bool, error)
+	HeadBucketExistWithContextFunc                   func(aws.Context, string) (bool, error)
+	HeadBucketRequestFunc                            func(*s3.HeadBucketInput) (*aws.Request, *s3.HeadBucketOutput)
+	HeadBucketWithContextFunc                        func(aws.Context, *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	HeadObjectFunc                                   func(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+	HeadObjectRequestFunc                            func(*s3.HeadObjectInput) (*aws.Request, *s3.HeadObjectOutput)
+	HeadObjectWithContextFunc                        func(aws.Context, *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+	InitiateBucketWormFunc                           func(*s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error)
+	InitiateBucketWormRequestFunc                    func(*s3.InitiateBucketWormInput) (*aws.Request, *s3.InitiateBucketWormOutput)
+	InitiateBucketWormWithContextFunc                func(aws.Context, *s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error)
+	ListBucketInventoryFunc                          func(*s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error)
+	ListBucketInventoryRequestFunc                   func(*s3.ListBucketInventoryInput) (*aws.Request, *s3.ListBucketInventoryOutput)
+	ListBucketInventoryWithContextFunc               func(aws.Context, *s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error)
+	ListBucketsFunc                                  func(*s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
+	ListBucketsRequestFunc                           func(*s3.ListBucketsInput) (*aws.Request, *s3.ListBucketsOutput)
+	ListBucketsWithContextFunc                       func(aws.Context, *s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
+	ListJobsFunc                                     func(*s3.ListJobsInput) (*s3.ListJobsOutput, error)
+	ListJobsRequestFunc                              func(*s3.ListJobsInput) (*aws.Request, *s3.ListJobsOutput)
+	ListJobsWithContextFunc                          func(aws.Context, *s3.ListJobsInput) (*s3.ListJobsOutput, error)
+	ListMultipartUploadsFunc                         func(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
+	ListMultipartUploadsPagesFunc                    func(*s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool) error
+	ListMultipartUploadsRequestFunc                  func(*s3.ListMultipartUploadsInput) (*aws.Request, *s3.ListMultipartUploadsOutput)
+	ListMultipartUploadsWithContextFunc              func(aws.Context, *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
+	ListObjectVersionsFunc                           func(*s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	ListObjectVersionsPagesFunc                      func(*s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool) error
+	ListObjectVersionsRequestFunc                    func(*s3.ListObjectVersionsInput) (*aws.Request, *s3.ListObjectVersionsOutput)
+	ListObjectVersionsWithContextFunc                func(aws.Context, *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	ListObjectsFunc                                  func(*s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
+	ListObjectsPagesFunc                             func(*s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool) error
+	ListObjectsRequestFunc                           func(*s3.ListObjectsInput) (*aws.Request, *s3.ListObjectsOutput)
+	ListObjectsV2Func                                func(*s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+	ListObjectsV2PagesFunc                           func(*s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool) error
+	ListObjectsV2RequestFunc                         func(*s3.ListObjectsV2Input) (*aws.Request, *s3.ListObjectsV2Output)
+	ListObjectsV2WithContextFunc                     func(aws.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+	ListObjectsWithContextFunc                       func(aws.Context, *s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
+	ListPartsFunc                                    func(*s3.ListPartsInput) (*s3.ListPartsOutput, error)
+	ListPartsPagesFunc                               func(*s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool) error
+	ListPartsRequestFunc                             func(*s3.ListPartsInput) (*aws.Request, *s3.ListPartsOutput)
+	ListPartsWithContextFunc                         func(aws.Context, *s3.ListPartsInput) (*s3.ListPartsOutput, error)
+	ListRetentionFunc                                func(*s3.ListRetentionInput) (*s3.ListRetentionOutput, error)
+	ListRetentionRequestFunc                         func(*s3.ListRetentionInput) (*aws.Request, *s3.ListRetentionOutput)
+	ListRetentionWithContextFunc                     func(aws.Context, *s3.ListRetentionInput) (*s3.ListRetentionOutput, error)
+	NewListBucketInventoryPaginatorFunc              func(*s3.ListBucketInventoryInput, ...aws.PagerOption) *s3.ListBucketInventoryPaginator
+	NewListBucketsPaginatorFunc                      func(*s3.ListBucketsInput, ...aws.PagerOption) *s3.ListBucketsPaginator
+	NewListJobsPaginatorFunc                         func(*s3.ListJobsInput, ...aws.PagerOption) *s3.ListJobsPaginator
+	NewListMultipartUploadsPaginatorFunc             func(*s3.ListMultipartUploadsInput, ...aws.PagerOption) *s3.ListMultipartUploadsPaginator
+	NewListObjectVersionsPaginatorFunc               func(*s3.ListObjectVersionsInput, ...aws.PagerOption) *s3.ListObjectVersionsPaginator
+	NewListObjectsPaginatorFunc                      func(*s3.ListObjectsInput, ...aws.PagerOption) *s3.ListObjectsPaginator
+	NewListObjectsV2PaginatorFunc                    func(*s3.ListObjectsV2Input, ...aws.PagerOption) *s3.ListObjectsV2Paginator
+	NewListPartsPaginatorFunc                        func(*s3.ListPartsInput, ...aws.PagerOption) *s3.ListPartsPaginator
+	NewListRetentionPaginatorFunc                    func(*s3.ListRetentionInput, ...aws.PagerOption) *s3.ListRetentionPaginator
+	PresignPostObjectFunc                            func(*s3.PresignPostObjectInput) (*s3.PresignPostObjectOutput, error)
+	PutBucketACLFunc                                 func(*s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
+	PutBucketACLRequestFunc                          func(*s3.PutBucketACLInput) (*aws.Request, *s3.PutBucketACLOutput)
+	PutBucketACLWithContextFunc                      func(aws.Context, *s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
+	PutBucketAccessMonitorFunc                       func(*s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error)
+	PutBucketAccessMonitorRequestFunc                func(*s3.PutBucketAccessMonitorInput) (*aws.Request, *s3.PutBucketAccessMonitorOutput)
+	PutBucketAccessMonitorWithContextFunc            func(aws.Context, *s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error)
+	PutBucketCORSFunc                                func(*s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
+	PutBucketCORSRequestFunc                         func(*s3.PutBucketCORSInput) (*aws.Request, *s3.PutBucketCORSOutput)
+	PutBucketCORSWithContextFunc                     func(aws.Context, *s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
+	PutBucketDataAcceleratorFunc                     func(*s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error)
+	PutBucketDataAcceleratorRequestFunc              func(*s3.PutBucketDataAcceleratorInput) (*aws.Request, *s3.PutBucketDataAcceleratorOutput)
+	PutBucketDataAcceleratorWithContextFunc          func(aws.Context, *s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error)
+	PutBucketDataRedundancySwitchFunc                func(*s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error)
+	PutBucketDataRedundancySwitchRequestFunc         func(*s3.PutBucketDataRedundancySwitchInput) (*aws.Request, *s3.PutBucketDataRedundancySwitchOutput)
+	PutBucketDataRedundancySwitchWithContextFunc     func(aws.Context, *s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error)
+	PutBucketDecompressPolicyFunc                    func(*s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error)
+	PutBucketDecompressPolicyRequestFunc             func(*s3.PutBucketDecompressPolicyInput) (*aws.Request, *s3.PutBucketDecompressPolicyOutput)
+	PutBucketDecompressPolicyWithContextFunc         func(aws.Context, *s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error)
+	PutBucketEncryptionFunc                          func(*s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error)
+	PutBucketEncryptionRequestFunc                   func(*s3.PutBucketEncryptionInput) (*aws.Request, *s3.PutBucketEncryptionOutput)
+	PutBucketEncryptionWithContextFunc               func(aws.Context, *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error)
+	PutBucketInventoryFunc                           func(*s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error)
+	PutBucketInventoryRequestFunc                    func(*s3.PutBucketInventoryInput) (*aws.Request, *s3.PutBucketInventoryOutput)
+	PutBucketInventoryWithContextFunc                func(aws.Context, *s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error)
+	PutBucketLifecycleFunc                           func(*s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
+	PutBucketLifecycleRequestFunc                    func(*s3.PutBucketLifecycleInput) (*aws.Request, *s3.PutBucketLifecycleOutput)
+	PutBucketLifecycleWithContextFunc                func(aws.Context, *s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
+	PutBucketLoggingFunc                             func(*s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
+	PutBucketLoggingRequestFunc                      func(*s3.PutBucketLoggingInput) (*aws.Request, *s3.PutBucketLoggingOutput)
+	PutBucketLoggingWithContextFunc                  func(aws.Context, *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
+	PutBucketMirrorFunc                              func(*s3.PutBucketMirrorInput) (*s3.PutBucketMirrorOutput, error)
+	PutBucketMirrorRequestFunc                       func(*s3.PutBucketMirrorInput) (*aws.Request, *s3.PutBucketMirrorOutput)
+	PutBucketMirrorWithContextFunc                   func(aws.Context, *s3.PutBucketMirrorInput) (*s3.PutBucketMirrorOutput, error)
+	PutBucketNotificationFunc                        func(*s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
+	PutBucketNotificationRequestFunc                 func(*s3.PutBucketNotificationInput) (*aws.Request, *s3.PutBucketNotificationOutput)
+	PutBucketNotificationWithContextFunc             func(aws.Context, *s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
+	PutBucketPolicyFunc                              func(*s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
+	PutBucketPolicyRequestFunc                       func(*s3.PutBucketPolicyInput) (*aws.Request, *s3.PutBucketPolicyOutput)
+	PutBucketPolicyWithContextFunc                   func(aws.Context, *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
+	PutBucketPublicNetworkBlockFunc                  func(*s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error)
+	PutBucketPublicNetworkBlockRequestFunc           func(*s3.PutBucketPublicNetworkBlockInput) (*aws.Request, *s3.PutBucketPublicNetworkBlockOutput)
+	PutBucketPublicNetworkBlockWithContextFunc       func(aws.Context, *s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error)
+	PutBucketQosFunc                                 func(*s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error)
+	PutBucketQosRequestFunc                          func(*s3.PutBucketQosInput) (*aws.Request, *s3.PutBucketQosOutput)
+	PutBucketQosWithContextFunc                      func(aws.Context, *s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error)
+	PutBucketQuotaFunc                               func(*s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error)
+	PutBucketQuotaRequestFunc                        func(*s3.PutBucketQuotaInput) (*aws.Request, *s3.PutBucketQuotaOutput)
+	PutBucketQuotaWithContextFunc                    func(aws.Context, *s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error)
+	PutBucketReplicationFunc                         func(*s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
+	PutBucketReplicationRequestFunc                  func(*s3.PutBucketReplicationInput) (*aws.Request, *s3.PutBucketReplicationOutput)
+	PutBucketReplicationWithContextFunc              func(aws.Context, *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
+	PutBucketRequestPaymentFunc                      func(*s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
+	PutBucketRequestPaymentRequestFunc               func(*s3.PutBucketRequestPaymentInput) (*aws.Request, *s3.PutBucketRequestPaymentOutput)
+	PutBucketRequestPaymentWithContextFunc           func(aws.Context, *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
+	PutBucketRetentionFunc                           func(*s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error)
+	PutBucketRetentionRequestFunc                    func(*s3.PutBucketRetentionInput) (*aws.Request, *s3.PutBucketRetentionOutput)
+	PutBucketRetentionWithContextFunc                func(aws.Context, *s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error)
+	PutBucketTaggingFunc                             func(*s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
+	PutBucketTaggingRequestFunc                      func(*s3.PutBucketTaggingInput) (*aws.Request, *s3.PutBucketTaggingOutput)
+	PutBucketTaggingWithContextFunc                  func(aws.Context, *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
+	PutBucketTransferAccelerationFunc                func(*s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error)
+	PutBucketTransferAccelerationRequestFunc         func(*s3.PutBucketTransferAccelerationInput) (*aws.Request, *s3.PutBucketTransferAccelerationOutput)
+	PutBucketTransferAccelerationWithContextFunc     func(aws.Context, *s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error)
+	PutBucketVersioningFunc                          func(*s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
+	PutBucketVersioningRequestFunc                   func(*s3.PutBucketVersioningInput) (*aws.Request, *s3.PutBucketVersioningOutput)
+	PutBucketVersioningWithContextFunc               func(aws.Context, *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
+	PutBucketWebsiteFunc                             func(*s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
+	PutBucketWebsiteRequestFunc                      func(*s3.PutBucketWebsiteInput) (*aws.Request, *s3.PutBucketWebsiteOutput)
+	PutBucketWebsiteWithContextFunc                  func(aws.Context, *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
+	PutObjectFunc                                    func(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
+	PutObjectACLFunc                                 func(*s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
+	PutObjectACLRequestFunc                          func(*s3.PutObjectACLInput) (*aws.Request, *s3.PutObjectACLOutput)
+	PutObjectACLWithContextFunc                      func(aws.Context, *s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
+	PutObjectDataRedundancyTransitionFunc            func(*s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error)
+	PutObjectDataRedundancyTransitionRequestFunc     func(*s3.PutObjectDataRedundancyTransitionInput) (*aws.Request, *s3.PutObjectDataRedundancyTransitionOutput)
+	PutObjectDataRedundancyTransitionWithContextFunc func(aws.Context, *s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error)
+	PutObjectMigrationFunc                           func(*s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error)
+	PutObjectMigrationRequestFunc                    func(*s3.PutObjectMigrationInput) (*aws.Request, *s3.PutObjectMigrationOutput)
+	PutObjectMigrationWithContextFunc                func(aws.Context, *s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error)
+	PutObjectPreassignedInputFunc                    func(*s3.PutObjectInput) (*http.Request, error)
+	PutObjectRequestFunc                             func(*s3.PutObjectInput) (*aws.Request, *s3.PutObjectOutput)
+	PutObjectTaggingFunc                             func(*s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error)
+	PutObjectTaggingRequestFunc                      func(*s3.PutObjectTaggingInput) (*aws.Request, *s3.PutObjectTaggingOutput)
+	PutObjectTaggingWithContextFunc                  func(aws.Context, *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error)
+	PutObjectWithContextFunc                         func(aws.Context, *s3.PutObjectInput) (*s3.PutObjectOutput, error)
+	PutPublicNetworkBlockFunc                        func(*s3.PutPublicNetworkBlockInput) (*s3.PutPublicNetworkBlockOutput, error)
+	PutPublicNetworkBlockRequestFunc                 func(*s3.PutPublicNetworkBlockInput) (*aws.Request, *s3.PutPublicNetworkBlockOutput)
+	PutPublicNetworkBlockWithContextFunc             func(aws.Context, *s3.PutPublicNetworkBlockInput) (*s3.PutPublicNetworkBlockOutput, error)
+	PutReaderFunc                                    func(*s3.PutReaderInput) (*s3.PutObjectOutput, error)
+	PutReaderRequestFunc                             func(*s3.PutReaderInput) (*aws.Request, *s3.PutObjectOutput)
+	PutReaderWithContextFunc                         func(aws.Context, *s3.PutReaderInput) (*s3.PutObjectOutput, error)
+	PutRequesterQosFunc                              func(*s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error)
+	PutRequesterQosRequestFunc                       func(*s3.PutRequesterQosInput) (*aws.Request, *s3.PutRequesterQosOutput)
+	PutRequesterQosWithContextFunc                   func(aws.Context, *s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error)
+	PutVpcAccessBlockFunc                            func(*s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error)
+	PutVpcAccessBlockRequestFunc                     func(*s3.PutVpcAccessBlockInput) (*aws.Request, *s3.PutVpcAccessBlockOutput)
+	PutVpcAccessBlockWithContextFunc                 func(aws.Context, *s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error)
+	QueryBucketRankFunc                              func(*s3.QueryBucketRankInput) (*s3.QueryBucketRankOutput, error)
+	QueryBucketRankRequestFunc                       func(*s3.QueryBucketRankInput) (*aws.Request, *s3.QueryBucketRankOutput)
+	QueryBucketRankWithContextFunc                   func(aws.Context, *s3.QueryBucketRankInput) (*s3.QueryBucketRankOutput, error)
+	QueryKs3DataFunc                                 func(*s3.QueryKs3DataInput) (*s3.QueryKs3DataOutput, error)
+	QueryKs3DataRequestFunc                          func(*s3.QueryKs3DataInput) (*aws.Request, *s3.QueryKs3DataOutput)
+	QueryKs3DataWithContextFunc                      func(aws.Context, *s3.QueryKs3DataInput) (*s3.QueryKs3DataOutput, error)
+	RecoverObjectFunc                                func(*s3.RecoverObjectInput) (*s3.RecoverObjectOutput, error)
+	RecoverObjectRequestFunc                         func(*s3.RecoverObjectInput) (*aws.Request, *s3.RecoverObjectOutput)
+	RecoverObjectWithContextFunc                     func(aws.Context, *s3.RecoverObjectInput) (*s3.RecoverObjectOutput, error)
+	RestoreObjectFunc                                func(*s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
+	RestoreObjectRequestFunc                         func(*s3.RestoreObjectInput) (*aws.Request, *s3.RestoreObjectOutput)
+	RestoreObjectWithContextFunc                     func(aws.Context, *s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
+	SaveObjectToFileFunc                             func(string, *s3.GetObjectInput, *s3.GetObjectOutput) error
+	TryDeleteBucketPrefixFunc                        func(*s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+	TryDeleteBucketPrefixWithContextFunc             func(aws.Context, *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error)
+	UpdateJobPriorityFunc                            func(*s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error)
+	UpdateJobPriorityRequestFunc                     func(*s3.UpdateJobPriorityInput) (*aws.Request, *s3.UpdateJobPriorityOutput)
+	UpdateJobPriorityWithContextFunc                 func(aws.Context, *s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error)
+	UploadFileFunc                                   func(*s3.UploadFileInput) (*s3.UploadFileOutput, error)
+	UploadFileWithContextFunc                        func(context.Context, *s3.UploadFileInput) (*s3.UploadFileOutput, error)
+	UploadPartFunc                                   func(*s3.UploadPartInput) (*s3.UploadPartOutput, error)
+	UploadPartCopyFunc                               func(*s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+	UploadPartCopyRequestFunc                        func(*s3.UploadPartCopyInput) (*aws.Request, *s3.UploadPartCopyOutput)
+	UploadPartCopyWithContextFunc                    func(aws.Context, *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+	UploadPartRequestFunc                            func(*s3.UploadPartInput) (*aws.Request, *s3.UploadPartOutput)
+	UploadPartWithContextFunc                        func(aws.Context, *s3.UploadPartInput) (*s3.UploadPartOutput, error)
+	WaitUntilBucketExistsFunc                        func(*s3.HeadBucketInput) error
+	WaitUntilBucketExistsWithContextFunc             func(aws.Context, *s3.HeadBucketInput, ...aws.WaiterOption) error
+	WaitUntilJobCompleteFunc                         func(*s3.DescribeJobInput) error
+	WaitUntilJobCompleteWithContextFunc              func(aws.Context, *s3.DescribeJobInput, ...aws.WaiterOption) error
+	WaitUntilObjectExistsFunc                        func(*s3.HeadObjectInput) error
+	WaitUntilObjectExistsWithContextFunc             func(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
+	WaitUntilObjectNotExistsFunc                     func(*s3.HeadObjectInput) error
+	WaitUntilObjectNotExistsWithContextFunc          func(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
+	WaitUntilRestoreCompletedFunc                    func(*s3.HeadObjectInput) error
+	WaitUntilRestoreCompletedWithContextFunc         func(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
+}
+
+var _ S3API = (*MockS3API)(nil)
+
+func (m *MockS3API) AbortBucketWorm(p0 *s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error) {
+	if m.AbortBucketWormFunc == nil {
+		panic("s3iface: MockS3API.AbortBucketWormFunc is not set")
+	}
+	return m.AbortBucketWormFunc(p0)
+}
+
+func (m *MockS3API) AbortBucketWormRequest(p0 *s3.AbortBucketWormInput) (*aws.Request, *s3.AbortBucketWormOutput) {
+	if m.AbortBucketWormRequestFunc == nil {
+		panic("s3iface: MockS3API.AbortBucketWormRequestFunc is not set")
+	}
+	return m.AbortBucketWormRequestFunc(p0)
+}
+
+func (m *MockS3API) AbortBucketWormWithContext(p0 aws.Context, p1 *s3.AbortBucketWormInput) (*s3.AbortBucketWormOutput, error) {
+	if m.AbortBucketWormWithContextFunc == nil {
+		panic("s3iface: MockS3API.AbortBucketWormWithContextFunc is not set")
+	}
+	return m.AbortBucketWormWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) AbortMultipartUpload(p0 *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	if m.AbortMultipartUploadFunc == nil {
+		panic("s3iface: MockS3API.AbortMultipartUploadFunc is not set")
+	}
+	return m.AbortMultipartUploadFunc(p0)
+}
+
+func (m *MockS3API) AbortMultipartUploadRequest(p0 *s3.AbortMultipartUploadInput) (*aws.Request, *s3.AbortMultipartUploadOutput) {
+	if m.AbortMultipartUploadRequestFunc == nil {
+		panic("s3iface: MockS3API.AbortMultipartUploadRequestFunc is not set")
+	}
+	return m.AbortMultipartUploadRequestFunc(p0)
+}
+
+func (m *MockS3API) AbortMultipartUploadWithContext(p0 aws.Context, p1 *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	if m.AbortMultipartUploadWithContextFunc == nil {
+		panic("s3iface: MockS3API.AbortMultipartUploadWithContextFunc is not set")
+	}
+	return m.AbortMultipartUploadWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) AppendObject(p0 *s3.AppendObjectInput) (*s3.AppendObjectOutput, error) {
+	if m.AppendObjectFunc == nil {
+		panic("s3iface: MockS3API.AppendObjectFunc is not set")
+	}
+	return m.AppendObjectFunc(p0)
+}
+
+func (m *MockS3API) AppendObjectRequest(p0 *s3.AppendObjectInput) (*aws.Request, *s3.AppendObjectOutput) {
+	if m.AppendObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.AppendObjectRequestFunc is not set")
+	}
+	return m.AppendObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) AppendObjectWithContext(p0 aws.Context, p1 *s3.AppendObjectInput) (*s3.AppendObjectOutput, error) {
+	if m.AppendObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.AppendObjectWithContextFunc is not set")
+	}
+	return m.AppendObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ClearObject(p0 *s3.ClearObjectInput) (*s3.ClearObjectOutput, error) {
+	if m.ClearObjectFunc == nil {
+		panic("s3iface: MockS3API.ClearObjectFunc is not set")
+	}
+	return m.ClearObjectFunc(p0)
+}
+
+func (m *MockS3API) ClearObjectRequest(p0 *s3.ClearObjectInput) (*aws.Request, *s3.ClearObjectOutput) {
+	if m.ClearObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.ClearObjectRequestFunc is not set")
+	}
+	return m.ClearObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) ClearObjectWithContext(p0 aws.Context, p1 *s3.ClearObjectInput) (*s3.ClearObjectOutput, error) {
+	if m.ClearObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.ClearObjectWithContextFunc is not set")
+	}
+	return m.ClearObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CompleteBucketWorm(p0 *s3.CompleteBucketWormInput) (*s3.CompleteBucketWormOutput, error) {
+	if m.CompleteBucketWormFunc == nil {
+		panic("s3iface: MockS3API.CompleteBucketWormFunc is not set")
+	}
+	return m.CompleteBucketWormFunc(p0)
+}
+
+func (m *MockS3API) CompleteBucketWormRequest(p0 *s3.CompleteBucketWormInput) (*aws.Request, *s3.CompleteBucketWormOutput) {
+	if m.CompleteBucketWormRequestFunc == nil {
+		panic("s3iface: MockS3API.CompleteBucketWormRequestFunc is not set")
+	}
+	return m.CompleteBucketWormRequestFunc(p0)
+}
+
+func (m *MockS3API) CompleteBucketWormWithContext(p0 aws.Context, p1 *s3.CompleteBucketWormInput) (*s3.CompleteBucketWormOutput, error) {
+	if m.CompleteBucketWormWithContextFunc == nil {
+		panic("s3iface: MockS3API.CompleteBucketWormWithContextFunc is not set")
+	}
+	return m.CompleteBucketWormWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CompleteMultipartUpload(p0 *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	if m.CompleteMultipartUploadFunc == nil {
+		panic("s3iface: MockS3API.CompleteMultipartUploadFunc is not set")
+	}
+	return m.CompleteMultipartUploadFunc(p0)
+}
+
+func (m *MockS3API) CompleteMultipartUploadRequest(p0 *s3.CompleteMultipartUploadInput) (*aws.Request, *s3.CompleteMultipartUploadOutput) {
+	if m.CompleteMultipartUploadRequestFunc == nil {
+		panic("s3iface: MockS3API.CompleteMultipartUploadRequestFunc is not set")
+	}
+	return m.CompleteMultipartUploadRequestFunc(p0)
+}
+
+func (m *MockS3API) CompleteMultipartUploadWithContext(p0 aws.Context, p1 *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	if m.CompleteMultipartUploadWithContextFunc == nil {
+		panic("s3iface: MockS3API.CompleteMultipartUploadWithContextFunc is not set")
+	}
+	return m.CompleteMultipartUploadWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CopyFile(p0 *s3.CopyFileInput) (*s3.CopyFileOutput, error) {
+	if m.CopyFileFunc == nil {
+		panic("s3iface: MockS3API.CopyFileFunc is not set")
+	}
+	return m.CopyFileFunc(p0)
+}
+
+func (m *MockS3API) CopyFileAcrossRegion(p0 *s3.CopyFileInput, p1 *s3.S3) (*s3.UploadFileOutput, error) {
+	if m.CopyFileAcrossRegionFunc == nil {
+		panic("s3iface: MockS3API.CopyFileAcrossRegionFunc is not set")
+	}
+	return m.CopyFileAcrossRegionFunc(p0, p1)
+}
+
+func (m *MockS3API) CopyFileAcrossRegionWithContext(p0 context.Context, p1 *s3.CopyFileInput, p2 *s3.S3) (*s3.UploadFileOutput, error) {
+	if m.CopyFileAcrossRegionWithContextFunc == nil {
+		panic("s3iface: MockS3API.CopyFileAcrossRegionWithContextFunc is not set")
+	}
+	return m.CopyFileAcrossRegionWithContextFunc(p0, p1, p2)
+}
+
+func (m *MockS3API) CopyFileWithContext(p0 context.Context, p1 *s3.CopyFileInput) (*s3.CopyFileOutput, error) {
+	if m.CopyFileWithContextFunc == nil {
+		panic("s3iface: MockS3API.CopyFileWithContextFunc is not set")
+	}
+	return m.CopyFileWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CopyObject(p0 *s3.CopyObjectInput) (*s3.CopyObjectOutput, error) {
+	if m.CopyObjectFunc == nil {
+		panic("s3iface: MockS3API.CopyObjectFunc is not set")
+	}
+	return m.CopyObjectFunc(p0)
+}
+
+func (m *MockS3API) CopyObjectRequest(p0 *s3.CopyObjectInput) (*aws.Request, *s3.CopyObjectOutput) {
+	if m.CopyObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.CopyObjectRequestFunc is not set")
+	}
+	return m.CopyObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) CopyObjectWithContext(p0 aws.Context, p1 *s3.CopyObjectInput) (*s3.CopyObjectOutput, error) {
+	if m.CopyObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.CopyObjectWithContextFunc is not set")
+	}
+	return m.CopyObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CreateBucket(p0 *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	if m.CreateBucketFunc == nil {
+		panic("s3iface: MockS3API.CreateBucketFunc is not set")
+	}
+	return m.CreateBucketFunc(p0)
+}
+
+func (m *MockS3API) CreateBucketRequest(p0 *s3.CreateBucketInput) (*aws.Request, *s3.CreateBucketOutput) {
+	if m.CreateBucketRequestFunc == nil {
+		panic("s3iface: MockS3API.CreateBucketRequestFunc is not set")
+	}
+	return m.CreateBucketRequestFunc(p0)
+}
+
+func (m *MockS3API) CreateBucketWithContext(p0 aws.Context, p1 *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	if m.CreateBucketWithContextFunc == nil {
+		panic("s3iface: MockS3API.CreateBucketWithContextFunc is not set")
+	}
+	return m.CreateBucketWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CreateJob(p0 *s3.CreateJobInput) (*s3.CreateJobOutput, error) {
+	if m.CreateJobFunc == nil {
+		panic("s3iface: MockS3API.CreateJobFunc is not set")
+	}
+	return m.CreateJobFunc(p0)
+}
+
+func (m *MockS3API) CreateJobRequest(p0 *s3.CreateJobInput) (*aws.Request, *s3.CreateJobOutput) {
+	if m.CreateJobRequestFunc == nil {
+		panic("s3iface: MockS3API.CreateJobRequestFunc is not set")
+	}
+	return m.CreateJobRequestFunc(p0)
+}
+
+func (m *MockS3API) CreateJobWithContext(p0 aws.Context, p1 *s3.CreateJobInput) (*s3.CreateJobOutput, error) {
+	if m.CreateJobWithContextFunc == nil {
+		panic("s3iface: MockS3API.CreateJobWithContextFunc is not set")
+	}
+	return m.CreateJobWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) CreateMultipartUpload(p0 *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	if m.CreateMultipartUploadFunc == nil {
+		panic("s3iface: MockS3API.CreateMultipartUploadFunc is not set")
+	}
+	return m.CreateMultipartUploadFunc(p0)
+}
+
+func (m *MockS3API) CreateMultipartUploadRequest(p0 *s3.CreateMultipartUploadInput) (*aws.Request, *s3.CreateMultipartUploadOutput) {
+	if m.CreateMultipartUploadRequestFunc == nil {
+		panic("s3iface: MockS3API.CreateMultipartUploadRequestFunc is not set")
+	}
+	return m.CreateMultipartUploadRequestFunc(p0)
+}
+
+func (m *MockS3API) CreateMultipartUploadWithContext(p0 aws.Context, p1 *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	if m.CreateMultipartUploadWithContextFunc == nil {
+		panic("s3iface: MockS3API.CreateMultipartUploadWithContextFunc is not set")
+	}
+	return m.CreateMultipartUploadWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucket(p0 *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	if m.DeleteBucketFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketFunc is not set")
+	}
+	return m.DeleteBucketFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketCORS(p0 *s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error) {
+	if m.DeleteBucketCORSFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketCORSFunc is not set")
+	}
+	return m.DeleteBucketCORSFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketCORSRequest(p0 *s3.DeleteBucketCORSInput) (*aws.Request, *s3.DeleteBucketCORSOutput) {
+	if m.DeleteBucketCORSRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketCORSRequestFunc is not set")
+	}
+	return m.DeleteBucketCORSRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketCORSWithContext(p0 aws.Context, p1 *s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error) {
+	if m.DeleteBucketCORSWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketCORSWithContextFunc is not set")
+	}
+	return m.DeleteBucketCORSWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketDataAccelerator(p0 *s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error) {
+	if m.DeleteBucketDataAcceleratorFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketDataAcceleratorFunc is not set")
+	}
+	return m.DeleteBucketDataAcceleratorFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketDataAcceleratorRequest(p0 *s3.DeleteBucketDataAcceleratorInput) (*aws.Request, *s3.DeleteBucketDataAcceleratorOutput) {
+	if m.DeleteBucketDataAcceleratorRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketDataAcceleratorRequestFunc is not set")
+	}
+	return m.DeleteBucketDataAcceleratorRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketDataAcceleratorWithContext(p0 aws.Context, p1 *s3.DeleteBucketDataAcceleratorInput) (*s3.DeleteBucketDataAcceleratorOutput, error) {
+	if m.DeleteBucketDataAcceleratorWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketDataAcceleratorWithContextFunc is not set")
+	}
+	return m.DeleteBucketDataAcceleratorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketDecompressPolicy(p0 *s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error) {
+	if m.DeleteBucketDecompressPolicyFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketDecompressPolicyFunc is not set")
+	}
+	return m.DeleteBucketDecompressPolicyFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketDecompressPolicyRequest(p0 *s3.DeleteBucketDecompressPolicyInput) (*aws.Request, *s3.DeleteBucketDecompressPolicyOutput) {
+	if m.DeleteBucketDecompressPolicyRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketDecompressPolicyRequestFunc is not set")
+	}
+	return m.DeleteBucketDecompressPolicyRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketDecompressPolicyWithContext(p0 aws.Context, p1 *s3.DeleteBucketDecompressPolicyInput) (*s3.DeleteBucketDecompressPolicyOutput, error) {
+	if m.DeleteBucketDecompressPolicyWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketDecompressPolicyWithContextFunc is not set")
+	}
+	return m.DeleteBucketDecompressPolicyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketEncryption(p0 *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error) {
+	if m.DeleteBucketEncryptionFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketEncryptionFunc is not set")
+	}
+	return m.DeleteBucketEncryptionFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketEncryptionRequest(p0 *s3.DeleteBucketEncryptionInput) (*aws.Request, *s3.DeleteBucketEncryptionOutput) {
+	if m.DeleteBucketEncryptionRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketEncryptionRequestFunc is not set")
+	}
+	return m.DeleteBucketEncryptionRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketEncryptionWithContext(p0 aws.Context, p1 *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error) {
+	if m.DeleteBucketEncryptionWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketEncryptionWithContextFunc is not set")
+	}
+	return m.DeleteBucketEncryptionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketInventory(p0 *s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error) {
+	if m.DeleteBucketInventoryFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketInventoryFunc is not set")
+	}
+	return m.DeleteBucketInventoryFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketInventoryRequest(p0 *s3.DeleteBucketInventoryInput) (*aws.Request, *s3.DeleteBucketInventoryOutput) {
+	if m.DeleteBucketInventoryRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketInventoryRequestFunc is not set")
+	}
+	return m.DeleteBucketInventoryRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketInventoryWithContext(p0 aws.Context, p1 *s3.DeleteBucketInventoryInput) (*s3.DeleteBucketInventoryOutput, error) {
+	if m.DeleteBucketInventoryWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketInventoryWithContextFunc is not set")
+	}
+	return m.DeleteBucketInventoryWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketLifecycle(p0 *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error) {
+	if m.DeleteBucketLifecycleFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketLifecycleFunc is not set")
+	}
+	return m.DeleteBucketLifecycleFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketLifecycleRequest(p0 *s3.DeleteBucketLifecycleInput) (*aws.Request, *s3.DeleteBucketLifecycleOutput) {
+	if m.DeleteBucketLifecycleRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketLifecycleRequestFunc is not set")
+	}
+	return m.DeleteBucketLifecycleRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketLifecycleWithContext(p0 aws.Context, p1 *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error) {
+	if m.DeleteBucketLifecycleWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketLifecycleWithContextFunc is not set")
+	}
+	return m.DeleteBucketLifecycleWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketMirror(p0 *s3.DeleteBucketMirrorInput) (*s3.DeleteBucketMirrorOutput, error) {
+	if m.DeleteBucketMirrorFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketMirrorFunc is not set")
+	}
+	return m.DeleteBucketMirrorFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketMirrorRequest(p0 *s3.DeleteBucketMirrorInput) (*aws.Request, *s3.DeleteBucketMirrorOutput) {
+	if m.DeleteBucketMirrorRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketMirrorRequestFunc is not set")
+	}
+	return m.DeleteBucketMirrorRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketMirrorWithContext(p0 aws.Context, p1 *s3.DeleteBucketMirrorInput) (*s3.DeleteBucketMirrorOutput, error) {
+	if m.DeleteBucketMirrorWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketMirrorWithContextFunc is not set")
+	}
+	return m.DeleteBucketMirrorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketNotification(p0 *s3.DeleteBucketNotificationInput) (*s3.DeleteBucketNotificationOutput, error) {
+	if m.DeleteBucketNotificationFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketNotificationFunc is not set")
+	}
+	return m.DeleteBucketNotificationFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketNotificationRequest(p0 *s3.DeleteBucketNotificationInput) (*aws.Request, *s3.DeleteBucketNotificationOutput) {
+	if m.DeleteBucketNotificationRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketNotificationRequestFunc is not set")
+	}
+	return m.DeleteBucketNotificationRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketNotificationWithContext(p0 aws.Context, p1 *s3.DeleteBucketNotificationInput) (*s3.DeleteBucketNotificationOutput, error) {
+	if m.DeleteBucketNotificationWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketNotificationWithContextFunc is not set")
+	}
+	return m.DeleteBucketNotificationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketPolicy(p0 *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+	if m.DeleteBucketPolicyFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPolicyFunc is not set")
+	}
+	return m.DeleteBucketPolicyFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketPolicyRequest(p0 *s3.DeleteBucketPolicyInput) (*aws.Request, *s3.DeleteBucketPolicyOutput) {
+	if m.DeleteBucketPolicyRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPolicyRequestFunc is not set")
+	}
+	return m.DeleteBucketPolicyRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketPolicyWithContext(p0 aws.Context, p1 *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+	if m.DeleteBucketPolicyWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPolicyWithContextFunc is not set")
+	}
+	return m.DeleteBucketPolicyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketPrefix(p0 *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error) {
+	if m.DeleteBucketPrefixFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPrefixFunc is not set")
+	}
+	return m.DeleteBucketPrefixFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketPrefixWithContext(p0 aws.Context, p1 *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error) {
+	if m.DeleteBucketPrefixWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPrefixWithContextFunc is not set")
+	}
+	return m.DeleteBucketPrefixWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketPublicNetworkBlock(p0 *s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error) {
+	if m.DeleteBucketPublicNetworkBlockFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPublicNetworkBlockFunc is not set")
+	}
+	return m.DeleteBucketPublicNetworkBlockFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketPublicNetworkBlockRequest(p0 *s3.DeleteBucketPublicNetworkBlockInput) (*aws.Request, *s3.DeleteBucketPublicNetworkBlockOutput) {
+	if m.DeleteBucketPublicNetworkBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPublicNetworkBlockRequestFunc is not set")
+	}
+	return m.DeleteBucketPublicNetworkBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketPublicNetworkBlockWithContext(p0 aws.Context, p1 *s3.DeleteBucketPublicNetworkBlockInput) (*s3.DeleteBucketPublicNetworkBlockOutput, error) {
+	if m.DeleteBucketPublicNetworkBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketPublicNetworkBlockWithContextFunc is not set")
+	}
+	return m.DeleteBucketPublicNetworkBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketQos(p0 *s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error) {
+	if m.DeleteBucketQosFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketQosFunc is not set")
+	}
+	return m.DeleteBucketQosFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketQosRequest(p0 *s3.DeleteBucketQosInput) (*aws.Request, *s3.DeleteBucketQosOutput) {
+	if m.DeleteBucketQosRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketQosRequestFunc is not set")
+	}
+	return m.DeleteBucketQosRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketQosWithContext(p0 aws.Context, p1 *s3.DeleteBucketQosInput) (*s3.DeleteBucketQosOutput, error) {
+	if m.DeleteBucketQosWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketQosWithContextFunc is not set")
+	}
+	return m.DeleteBucketQosWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketQuota(p0 *s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error) {
+	if m.DeleteBucketQuotaFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketQuotaFunc is not set")
+	}
+	return m.DeleteBucketQuotaFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketQuotaRequest(p0 *s3.DeleteBucketQuotaInput) (*aws.Request, *s3.DeleteBucketQuotaOutput) {
+	if m.DeleteBucketQuotaRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketQuotaRequestFunc is not set")
+	}
+	return m.DeleteBucketQuotaRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketQuotaWithContext(p0 aws.Context, p1 *s3.DeleteBucketQuotaInput) (*s3.DeleteBucketQuotaOutput, error) {
+	if m.DeleteBucketQuotaWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketQuotaWithContextFunc is not set")
+	}
+	return m.DeleteBucketQuotaWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketReplication(p0 *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error) {
+	if m.DeleteBucketReplicationFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketReplicationFunc is not set")
+	}
+	return m.DeleteBucketReplicationFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketReplicationRequest(p0 *s3.DeleteBucketReplicationInput) (*aws.Request, *s3.DeleteBucketReplicationOutput) {
+	if m.DeleteBucketReplicationRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketReplicationRequestFunc is not set")
+	}
+	return m.DeleteBucketReplicationRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketReplicationWithContext(p0 aws.Context, p1 *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error) {
+	if m.DeleteBucketReplicationWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketReplicationWithContextFunc is not set")
+	}
+	return m.DeleteBucketReplicationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketRequest(p0 *s3.DeleteBucketInput) (*aws.Request, *s3.DeleteBucketOutput) {
+	if m.DeleteBucketRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketRequestFunc is not set")
+	}
+	return m.DeleteBucketRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketTagging(p0 *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error) {
+	if m.DeleteBucketTaggingFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketTaggingFunc is not set")
+	}
+	return m.DeleteBucketTaggingFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketTaggingRequest(p0 *s3.DeleteBucketTaggingInput) (*aws.Request, *s3.DeleteBucketTaggingOutput) {
+	if m.DeleteBucketTaggingRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketTaggingRequestFunc is not set")
+	}
+	return m.DeleteBucketTaggingRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketTaggingWithContext(p0 aws.Context, p1 *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error) {
+	if m.DeleteBucketTaggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketTaggingWithContextFunc is not set")
+	}
+	return m.DeleteBucketTaggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketWebsite(p0 *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error) {
+	if m.DeleteBucketWebsiteFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketWebsiteFunc is not set")
+	}
+	return m.DeleteBucketWebsiteFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketWebsiteRequest(p0 *s3.DeleteBucketWebsiteInput) (*aws.Request, *s3.DeleteBucketWebsiteOutput) {
+	if m.DeleteBucketWebsiteRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketWebsiteRequestFunc is not set")
+	}
+	return m.DeleteBucketWebsiteRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteBucketWebsiteWithContext(p0 aws.Context, p1 *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error) {
+	if m.DeleteBucketWebsiteWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketWebsiteWithContextFunc is not set")
+	}
+	return m.DeleteBucketWebsiteWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteBucketWithContext(p0 aws.Context, p1 *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	if m.DeleteBucketWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteBucketWithContextFunc is not set")
+	}
+	return m.DeleteBucketWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteJob(p0 *s3.DeleteJobInput) (*s3.DeleteJobOutput, error) {
+	if m.DeleteJobFunc == nil {
+		panic("s3iface: MockS3API.DeleteJobFunc is not set")
+	}
+	return m.DeleteJobFunc(p0)
+}
+
+func (m *MockS3API) DeleteJobRequest(p0 *s3.DeleteJobInput) (*aws.Request, *s3.DeleteJobOutput) {
+	if m.DeleteJobRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteJobRequestFunc is not set")
+	}
+	return m.DeleteJobRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteJobWithContext(p0 aws.Context, p1 *s3.DeleteJobInput) (*s3.DeleteJobOutput, error) {
+	if m.DeleteJobWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteJobWithContextFunc is not set")
+	}
+	return m.DeleteJobWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteObject(p0 *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	if m.DeleteObjectFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectFunc is not set")
+	}
+	return m.DeleteObjectFunc(p0)
+}
+
+func (m *MockS3API) DeleteObjectRequest(p0 *s3.DeleteObjectInput) (*aws.Request, *s3.DeleteObjectOutput) {
+	if m.DeleteObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectRequestFunc is not set")
+	}
+	return m.DeleteObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteObjectTagging(p0 *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error) {
+	if m.DeleteObjectTaggingFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectTaggingFunc is not set")
+	}
+	return m.DeleteObjectTaggingFunc(p0)
+}
+
+func (m *MockS3API) DeleteObjectTaggingRequest(p0 *s3.DeleteObjectTaggingInput) (*aws.Request, *s3.DeleteObjectTaggingOutput) {
+	if m.DeleteObjectTaggingRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectTaggingRequestFunc is not set")
+	}
+	return m.DeleteObjectTaggingRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteObjectTaggingWithContext(p0 aws.Context, p1 *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error) {
+	if m.DeleteObjectTaggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectTaggingWithContextFunc is not set")
+	}
+	return m.DeleteObjectTaggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteObjectWithContext(p0 aws.Context, p1 *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	if m.DeleteObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectWithContextFunc is not set")
+	}
+	return m.DeleteObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteObjects(p0 *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	if m.DeleteObjectsFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectsFunc is not set")
+	}
+	return m.DeleteObjectsFunc(p0)
+}
+
+func (m *MockS3API) DeleteObjectsRequest(p0 *s3.DeleteObjectsInput) (*aws.Request, *s3.DeleteObjectsOutput) {
+	if m.DeleteObjectsRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectsRequestFunc is not set")
+	}
+	return m.DeleteObjectsRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteObjectsWithContext(p0 aws.Context, p1 *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	if m.DeleteObjectsWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteObjectsWithContextFunc is not set")
+	}
+	return m.DeleteObjectsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeletePublicNetworkBlock(p0 *s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error) {
+	if m.DeletePublicNetworkBlockFunc == nil {
+		panic("s3iface: MockS3API.DeletePublicNetworkBlockFunc is not set")
+	}
+	return m.DeletePublicNetworkBlockFunc(p0)
+}
+
+func (m *MockS3API) DeletePublicNetworkBlockRequest(p0 *s3.DeletePublicNetworkBlockInput) (*aws.Request, *s3.DeletePublicNetworkBlockOutput) {
+	if m.DeletePublicNetworkBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.DeletePublicNetworkBlockRequestFunc is not set")
+	}
+	return m.DeletePublicNetworkBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) DeletePublicNetworkBlockWithContext(p0 aws.Context, p1 *s3.DeletePublicNetworkBlockInput) (*s3.DeletePublicNetworkBlockOutput, error) {
+	if m.DeletePublicNetworkBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeletePublicNetworkBlockWithContextFunc is not set")
+	}
+	return m.DeletePublicNetworkBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteRequesterQos(p0 *s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error) {
+	if m.DeleteRequesterQosFunc == nil {
+		panic("s3iface: MockS3API.DeleteRequesterQosFunc is not set")
+	}
+	return m.DeleteRequesterQosFunc(p0)
+}
+
+func (m *MockS3API) DeleteRequesterQosRequest(p0 *s3.DeleteRequesterQosInput) (*aws.Request, *s3.DeleteRequesterQosOutput) {
+	if m.DeleteRequesterQosRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteRequesterQosRequestFunc is not set")
+	}
+	return m.DeleteRequesterQosRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteRequesterQosWithContext(p0 aws.Context, p1 *s3.DeleteRequesterQosInput) (*s3.DeleteRequesterQosOutput, error) {
+	if m.DeleteRequesterQosWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteRequesterQosWithContextFunc is not set")
+	}
+	return m.DeleteRequesterQosWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DeleteVpcAccessBlock(p0 *s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error) {
+	if m.DeleteVpcAccessBlockFunc == nil {
+		panic("s3iface: MockS3API.DeleteVpcAccessBlockFunc is not set")
+	}
+	return m.DeleteVpcAccessBlockFunc(p0)
+}
+
+func (m *MockS3API) DeleteVpcAccessBlockRequest(p0 *s3.DeleteVpcAccessBlockInput) (*aws.Request, *s3.DeleteVpcAccessBlockOutput) {
+	if m.DeleteVpcAccessBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.DeleteVpcAccessBlockRequestFunc is not set")
+	}
+	return m.DeleteVpcAccessBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) DeleteVpcAccessBlockWithContext(p0 aws.Context, p1 *s3.DeleteVpcAccessBlockInput) (*s3.DeleteVpcAccessBlockOutput, error) {
+	if m.DeleteVpcAccessBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.DeleteVpcAccessBlockWithContextFunc is not set")
+	}
+	return m.DeleteVpcAccessBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DescribeJob(p0 *s3.DescribeJobInput) (*s3.DescribeJobOutput, error) {
+	if m.DescribeJobFunc == nil {
+		panic("s3iface: MockS3API.DescribeJobFunc is not set")
+	}
+	return m.DescribeJobFunc(p0)
+}
+
+func (m *MockS3API) DescribeJobRequest(p0 *s3.DescribeJobInput) (*aws.Request, *s3.DescribeJobOutput) {
+	if m.DescribeJobRequestFunc == nil {
+		panic("s3iface: MockS3API.DescribeJobRequestFunc is not set")
+	}
+	return m.DescribeJobRequestFunc(p0)
+}
+
+func (m *MockS3API) DescribeJobWithContext(p0 aws.Context, p1 *s3.DescribeJobInput) (*s3.DescribeJobOutput, error) {
+	if m.DescribeJobWithContextFunc == nil {
+		panic("s3iface: MockS3API.DescribeJobWithContextFunc is not set")
+	}
+	return m.DescribeJobWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) DownloadFile(p0 *s3.DownloadFileInput) (*s3.DownloadFileOutput, error) {
+	if m.DownloadFileFunc == nil {
+		panic("s3iface: MockS3API.DownloadFileFunc is not set")
+	}
+	return m.DownloadFileFunc(p0)
+}
+
+func (m *MockS3API) DownloadFileWithContext(p0 context.Context, p1 *s3.DownloadFileInput) (*s3.DownloadFileOutput, error) {
+	if m.DownloadFileWithContextFunc == nil {
+		panic("s3iface: MockS3API.DownloadFileWithContextFunc is not set")
+	}
+	return m.DownloadFileWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ExtendBucketWorm(p0 *s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error) {
+	if m.ExtendBucketWormFunc == nil {
+		panic("s3iface: MockS3API.ExtendBucketWormFunc is not set")
+	}
+	return m.ExtendBucketWormFunc(p0)
+}
+
+func (m *MockS3API) ExtendBucketWormRequest(p0 *s3.ExtendBucketWormInput) (*aws.Request, *s3.ExtendBucketWormOutput) {
+	if m.ExtendBucketWormRequestFunc == nil {
+		panic("s3iface: MockS3API.ExtendBucketWormRequestFunc is not set")
+	}
+	return m.ExtendBucketWormRequestFunc(p0)
+}
+
+func (m *MockS3API) ExtendBucketWormWithContext(p0 aws.Context, p1 *s3.ExtendBucketWormInput) (*s3.ExtendBucketWormOutput, error) {
+	if m.ExtendBucketWormWithContextFunc == nil {
+		panic("s3iface: MockS3API.ExtendBucketWormWithContextFunc is not set")
+	}
+	return m.ExtendBucketWormWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) FetchObject(p0 *s3.FetchObjectInput) (*s3.FetchObjectOutput, error) {
+	if m.FetchObjectFunc == nil {
+		panic("s3iface: MockS3API.FetchObjectFunc is not set")
+	}
+	return m.FetchObjectFunc(p0)
+}
+
+func (m *MockS3API) FetchObjectRequest(p0 *s3.FetchObjectInput) (*aws.Request, *s3.FetchObjectOutput) {
+	if m.FetchObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.FetchObjectRequestFunc is not set")
+	}
+	return m.FetchObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) FetchObjectWithContext(p0 aws.Context, p1 *s3.FetchObjectInput) (*s3.FetchObjectOutput, error) {
+	if m.FetchObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.FetchObjectWithContextFunc is not set")
+	}
+	return m.FetchObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GeneratePresignedUrl(p0 *s3.GeneratePresignedUrlInput) (string, error) {
+	if m.GeneratePresignedUrlFunc == nil {
+		panic("s3iface: MockS3API.GeneratePresignedUrlFunc is not set")
+	}
+	return m.GeneratePresignedUrlFunc(p0)
+}
+
+func (m *MockS3API) GeneratePresignedUrlInput(p0 *s3.GeneratePresignedUrlInput) string {
+	if m.GeneratePresignedUrlInputFunc == nil {
+		panic("s3iface: MockS3API.GeneratePresignedUrlInputFunc is not set")
+	}
+	return m.GeneratePresignedUrlInputFunc(p0)
+}
+
+func (m *MockS3API) GenerateShareUrl(p0 *s3.GenerateShareUrlInput) (string, error) {
+	if m.GenerateShareUrlFunc == nil {
+		panic("s3iface: MockS3API.GenerateShareUrlFunc is not set")
+	}
+	return m.GenerateShareUrlFunc(p0)
+}
+
+func (m *MockS3API) GetBucketACL(p0 *s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error) {
+	if m.GetBucketACLFunc == nil {
+		panic("s3iface: MockS3API.GetBucketACLFunc is not set")
+	}
+	return m.GetBucketACLFunc(p0)
+}
+
+func (m *MockS3API) GetBucketACLRequest(p0 *s3.GetBucketACLInput) (*aws.Request, *s3.GetBucketACLOutput) {
+	if m.GetBucketACLRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketACLRequestFunc is not set")
+	}
+	return m.GetBucketACLRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketACLWithContext(p0 aws.Context, p1 *s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error) {
+	if m.GetBucketACLWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketACLWithContextFunc is not set")
+	}
+	return m.GetBucketACLWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketAccessMonitor(p0 *s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error) {
+	if m.GetBucketAccessMonitorFunc == nil {
+		panic("s3iface: MockS3API.GetBucketAccessMonitorFunc is not set")
+	}
+	return m.GetBucketAccessMonitorFunc(p0)
+}
+
+func (m *MockS3API) GetBucketAccessMonitorRequest(p0 *s3.GetBucketAccessMonitorInput) (*aws.Request, *s3.GetBucketAccessMonitorOutput) {
+	if m.GetBucketAccessMonitorRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketAccessMonitorRequestFunc is not set")
+	}
+	return m.GetBucketAccessMonitorRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketAccessMonitorWithContext(p0 aws.Context, p1 *s3.GetBucketAccessMonitorInput) (*s3.GetBucketAccessMonitorOutput, error) {
+	if m.GetBucketAccessMonitorWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketAccessMonitorWithContextFunc is not set")
+	}
+	return m.GetBucketAccessMonitorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketCORS(p0 *s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error) {
+	if m.GetBucketCORSFunc == nil {
+		panic("s3iface: MockS3API.GetBucketCORSFunc is not set")
+	}
+	return m.GetBucketCORSFunc(p0)
+}
+
+func (m *MockS3API) GetBucketCORSRequest(p0 *s3.GetBucketCORSInput) (*aws.Request, *s3.GetBucketCORSOutput) {
+	if m.GetBucketCORSRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketCORSRequestFunc is not set")
+	}
+	return m.GetBucketCORSRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketCORSWithContext(p0 aws.Context, p1 *s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error) {
+	if m.GetBucketCORSWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketCORSWithContextFunc is not set")
+	}
+	return m.GetBucketCORSWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketDataAccelerator(p0 *s3.GetBucketDataAcceleratorInput) (*s3.GetBucketDataAcceleratorOutput, error) {
+	if m.GetBucketDataAcceleratorFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDataAcceleratorFunc is not set")
+	}
+	return m.GetBucketDataAcceleratorFunc(p0)
+}
+
+func (m *MockS3API) GetBucketDataAcceleratorRequest(p0 *s3.GetBucketDataAcceleratorInput) (*aws.Request, *s3.GetBucketDataAcceleratorOutput) {
+	if m.GetBucketDataAcceleratorRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDataAcceleratorRequestFunc is not set")
+	}
+	return m.GetBucketDataAcceleratorRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketDataAcceleratorWithContext(p0 aws.Context, p1 *s3.GetBucketDataAcceleratorInput) (*s3.GetBucketDataAcceleratorOutput, error) {
+	if m.GetBucketDataAcceleratorWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDataAcceleratorWithContextFunc is not set")
+	}
+	return m.GetBucketDataAcceleratorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketDataRedundancySwitch(p0 *s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error) {
+	if m.GetBucketDataRedundancySwitchFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDataRedundancySwitchFunc is not set")
+	}
+	return m.GetBucketDataRedundancySwitchFunc(p0)
+}
+
+func (m *MockS3API) GetBucketDataRedundancySwitchRequest(p0 *s3.GetBucketDataRedundancySwitchInput) (*aws.Request, *s3.GetBucketDataRedundancySwitchOutput) {
+	if m.GetBucketDataRedundancySwitchRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDataRedundancySwitchRequestFunc is not set")
+	}
+	return m.GetBucketDataRedundancySwitchRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketDataRedundancySwitchWithContext(p0 aws.Context, p1 *s3.GetBucketDataRedundancySwitchInput) (*s3.GetBucketDataRedundancySwitchOutput, error) {
+	if m.GetBucketDataRedundancySwitchWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDataRedundancySwitchWithContextFunc is not set")
+	}
+	return m.GetBucketDataRedundancySwitchWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketDecompressPolicy(p0 *s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error) {
+	if m.GetBucketDecompressPolicyFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDecompressPolicyFunc is not set")
+	}
+	return m.GetBucketDecompressPolicyFunc(p0)
+}
+
+func (m *MockS3API) GetBucketDecompressPolicyRequest(p0 *s3.GetBucketDecompressPolicyInput) (*aws.Request, *s3.GetBucketDecompressPolicyOutput) {
+	if m.GetBucketDecompressPolicyRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDecompressPolicyRequestFunc is not set")
+	}
+	return m.GetBucketDecompressPolicyRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketDecompressPolicyWithContext(p0 aws.Context, p1 *s3.GetBucketDecompressPolicyInput) (*s3.GetBucketDecompressPolicyOutput, error) {
+	if m.GetBucketDecompressPolicyWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketDecompressPolicyWithContextFunc is not set")
+	}
+	return m.GetBucketDecompressPolicyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketEncryption(p0 *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	if m.GetBucketEncryptionFunc == nil {
+		panic("s3iface: MockS3API.GetBucketEncryptionFunc is not set")
+	}
+	return m.GetBucketEncryptionFunc(p0)
+}
+
+func (m *MockS3API) GetBucketEncryptionRequest(p0 *s3.GetBucketEncryptionInput) (*aws.Request, *s3.GetBucketEncryptionOutput) {
+	if m.GetBucketEncryptionRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketEncryptionRequestFunc is not set")
+	}
+	return m.GetBucketEncryptionRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketEncryptionWithContext(p0 aws.Context, p1 *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	if m.GetBucketEncryptionWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketEncryptionWithContextFunc is not set")
+	}
+	return m.GetBucketEncryptionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketInventory(p0 *s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error) {
+	if m.GetBucketInventoryFunc == nil {
+		panic("s3iface: MockS3API.GetBucketInventoryFunc is not set")
+	}
+	return m.GetBucketInventoryFunc(p0)
+}
+
+func (m *MockS3API) GetBucketInventoryRequest(p0 *s3.GetBucketInventoryInput) (*aws.Request, *s3.GetBucketInventoryOutput) {
+	if m.GetBucketInventoryRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketInventoryRequestFunc is not set")
+	}
+	return m.GetBucketInventoryRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketInventoryWithContext(p0 aws.Context, p1 *s3.GetBucketInventoryInput) (*s3.GetBucketInventoryOutput, error) {
+	if m.GetBucketInventoryWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketInventoryWithContextFunc is not set")
+	}
+	return m.GetBucketInventoryWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketLifecycle(p0 *s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error) {
+	if m.GetBucketLifecycleFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLifecycleFunc is not set")
+	}
+	return m.GetBucketLifecycleFunc(p0)
+}
+
+func (m *MockS3API) GetBucketLifecycleRequest(p0 *s3.GetBucketLifecycleInput) (*aws.Request, *s3.GetBucketLifecycleOutput) {
+	if m.GetBucketLifecycleRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLifecycleRequestFunc is not set")
+	}
+	return m.GetBucketLifecycleRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketLifecycleWithContext(p0 aws.Context, p1 *s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error) {
+	if m.GetBucketLifecycleWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLifecycleWithContextFunc is not set")
+	}
+	return m.GetBucketLifecycleWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketLocation(p0 *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
+	if m.GetBucketLocationFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLocationFunc is not set")
+	}
+	return m.GetBucketLocationFunc(p0)
+}
+
+func (m *MockS3API) GetBucketLocationRequest(p0 *s3.GetBucketLocationInput) (*aws.Request, *s3.GetBucketLocationOutput) {
+	if m.GetBucketLocationRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLocationRequestFunc is not set")
+	}
+	return m.GetBucketLocationRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketLocationWithContext(p0 aws.Context, p1 *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
+	if m.GetBucketLocationWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLocationWithContextFunc is not set")
+	}
+	return m.GetBucketLocationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketLogging(p0 *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error) {
+	if m.GetBucketLoggingFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLoggingFunc is not set")
+	}
+	return m.GetBucketLoggingFunc(p0)
+}
+
+func (m *MockS3API) GetBucketLoggingRequest(p0 *s3.GetBucketLoggingInput) (*aws.Request, *s3.GetBucketLoggingOutput) {
+	if m.GetBucketLoggingRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLoggingRequestFunc is not set")
+	}
+	return m.GetBucketLoggingRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketLoggingWithContext(p0 aws.Context, p1 *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error) {
+	if m.GetBucketLoggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketLoggingWithContextFunc is not set")
+	}
+	return m.GetBucketLoggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketMirror(p0 *s3.GetBucketMirrorInput) (*s3.GetBucketMirrorOutput, error) {
+	if m.GetBucketMirrorFunc == nil {
+		panic("s3iface: MockS3API.GetBucketMirrorFunc is not set")
+	}
+	return m.GetBucketMirrorFunc(p0)
+}
+
+func (m *MockS3API) GetBucketMirrorRequest(p0 *s3.GetBucketMirrorInput) (*aws.Request, *s3.GetBucketMirrorOutput) {
+	if m.GetBucketMirrorRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketMirrorRequestFunc is not set")
+	}
+	return m.GetBucketMirrorRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketMirrorWithContext(p0 aws.Context, p1 *s3.GetBucketMirrorInput) (*s3.GetBucketMirrorOutput, error) {
+	if m.GetBucketMirrorWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketMirrorWithContextFunc is not set")
+	}
+	return m.GetBucketMirrorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketNotification(p0 *s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error) {
+	if m.GetBucketNotificationFunc == nil {
+		panic("s3iface: MockS3API.GetBucketNotificationFunc is not set")
+	}
+	return m.GetBucketNotificationFunc(p0)
+}
+
+func (m *MockS3API) GetBucketNotificationRequest(p0 *s3.GetBucketNotificationInput) (*aws.Request, *s3.GetBucketNotificationOutput) {
+	if m.GetBucketNotificationRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketNotificationRequestFunc is not set")
+	}
+	return m.GetBucketNotificationRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketNotificationWithContext(p0 aws.Context, p1 *s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error) {
+	if m.GetBucketNotificationWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketNotificationWithContextFunc is not set")
+	}
+	return m.GetBucketNotificationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketPolicy(p0 *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	if m.GetBucketPolicyFunc == nil {
+		panic("s3iface: MockS3API.GetBucketPolicyFunc is not set")
+	}
+	return m.GetBucketPolicyFunc(p0)
+}
+
+func (m *MockS3API) GetBucketPolicyRequest(p0 *s3.GetBucketPolicyInput) (*aws.Request, *s3.GetBucketPolicyOutput) {
+	if m.GetBucketPolicyRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketPolicyRequestFunc is not set")
+	}
+	return m.GetBucketPolicyRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketPolicyWithContext(p0 aws.Context, p1 *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	if m.GetBucketPolicyWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketPolicyWithContextFunc is not set")
+	}
+	return m.GetBucketPolicyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketPublicNetworkBlock(p0 *s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error) {
+	if m.GetBucketPublicNetworkBlockFunc == nil {
+		panic("s3iface: MockS3API.GetBucketPublicNetworkBlockFunc is not set")
+	}
+	return m.GetBucketPublicNetworkBlockFunc(p0)
+}
+
+func (m *MockS3API) GetBucketPublicNetworkBlockRequest(p0 *s3.GetBucketPublicNetworkBlockInput) (*aws.Request, *s3.GetBucketPublicNetworkBlockOutput) {
+	if m.GetBucketPublicNetworkBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketPublicNetworkBlockRequestFunc is not set")
+	}
+	return m.GetBucketPublicNetworkBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketPublicNetworkBlockWithContext(p0 aws.Context, p1 *s3.GetBucketPublicNetworkBlockInput) (*s3.GetBucketPublicNetworkBlockOutput, error) {
+	if m.GetBucketPublicNetworkBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketPublicNetworkBlockWithContextFunc is not set")
+	}
+	return m.GetBucketPublicNetworkBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketQos(p0 *s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error) {
+	if m.GetBucketQosFunc == nil {
+		panic("s3iface: MockS3API.GetBucketQosFunc is not set")
+	}
+	return m.GetBucketQosFunc(p0)
+}
+
+func (m *MockS3API) GetBucketQosRequest(p0 *s3.GetBucketQosInput) (*aws.Request, *s3.GetBucketQosOutput) {
+	if m.GetBucketQosRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketQosRequestFunc is not set")
+	}
+	return m.GetBucketQosRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketQosWithContext(p0 aws.Context, p1 *s3.GetBucketQosInput) (*s3.GetBucketQosOutput, error) {
+	if m.GetBucketQosWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketQosWithContextFunc is not set")
+	}
+	return m.GetBucketQosWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketQuota(p0 *s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error) {
+	if m.GetBucketQuotaFunc == nil {
+		panic("s3iface: MockS3API.GetBucketQuotaFunc is not set")
+	}
+	return m.GetBucketQuotaFunc(p0)
+}
+
+func (m *MockS3API) GetBucketQuotaRequest(p0 *s3.GetBucketQuotaInput) (*aws.Request, *s3.GetBucketQuotaOutput) {
+	if m.GetBucketQuotaRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketQuotaRequestFunc is not set")
+	}
+	return m.GetBucketQuotaRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketQuotaWithContext(p0 aws.Context, p1 *s3.GetBucketQuotaInput) (*s3.GetBucketQuotaOutput, error) {
+	if m.GetBucketQuotaWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketQuotaWithContextFunc is not set")
+	}
+	return m.GetBucketQuotaWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketReplication(p0 *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error) {
+	if m.GetBucketReplicationFunc == nil {
+		panic("s3iface: MockS3API.GetBucketReplicationFunc is not set")
+	}
+	return m.GetBucketReplicationFunc(p0)
+}
+
+func (m *MockS3API) GetBucketReplicationRequest(p0 *s3.GetBucketReplicationInput) (*aws.Request, *s3.GetBucketReplicationOutput) {
+	if m.GetBucketReplicationRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketReplicationRequestFunc is not set")
+	}
+	return m.GetBucketReplicationRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketReplicationWithContext(p0 aws.Context, p1 *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error) {
+	if m.GetBucketReplicationWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketReplicationWithContextFunc is not set")
+	}
+	return m.GetBucketReplicationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketRequestPayment(p0 *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error) {
+	if m.GetBucketRequestPaymentFunc == nil {
+		panic("s3iface: MockS3API.GetBucketRequestPaymentFunc is not set")
+	}
+	return m.GetBucketRequestPaymentFunc(p0)
+}
+
+func (m *MockS3API) GetBucketRequestPaymentRequest(p0 *s3.GetBucketRequestPaymentInput) (*aws.Request, *s3.GetBucketRequestPaymentOutput) {
+	if m.GetBucketRequestPaymentRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketRequestPaymentRequestFunc is not set")
+	}
+	return m.GetBucketRequestPaymentRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketRequestPaymentWithContext(p0 aws.Context, p1 *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error) {
+	if m.GetBucketRequestPaymentWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketRequestPaymentWithContextFunc is not set")
+	}
+	return m.GetBucketRequestPaymentWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketRetention(p0 *s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error) {
+	if m.GetBucketRetentionFunc == nil {
+		panic("s3iface: MockS3API.GetBucketRetentionFunc is not set")
+	}
+	return m.GetBucketRetentionFunc(p0)
+}
+
+func (m *MockS3API) GetBucketRetentionRequest(p0 *s3.GetBucketRetentionInput) (*aws.Request, *s3.GetBucketRetentionOutput) {
+	if m.GetBucketRetentionRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketRetentionRequestFunc is not set")
+	}
+	return m.GetBucketRetentionRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketRetentionWithContext(p0 aws.Context, p1 *s3.GetBucketRetentionInput) (*s3.GetBucketRetentionOutput, error) {
+	if m.GetBucketRetentionWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketRetentionWithContextFunc is not set")
+	}
+	return m.GetBucketRetentionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketTagging(p0 *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error) {
+	if m.GetBucketTaggingFunc == nil {
+		panic("s3iface: MockS3API.GetBucketTaggingFunc is not set")
+	}
+	return m.GetBucketTaggingFunc(p0)
+}
+
+func (m *MockS3API) GetBucketTaggingRequest(p0 *s3.GetBucketTaggingInput) (*aws.Request, *s3.GetBucketTaggingOutput) {
+	if m.GetBucketTaggingRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketTaggingRequestFunc is not set")
+	}
+	return m.GetBucketTaggingRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketTaggingWithContext(p0 aws.Context, p1 *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error) {
+	if m.GetBucketTaggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketTaggingWithContextFunc is not set")
+	}
+	return m.GetBucketTaggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketTransferAcceleration(p0 *s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error) {
+	if m.GetBucketTransferAccelerationFunc == nil {
+		panic("s3iface: MockS3API.GetBucketTransferAccelerationFunc is not set")
+	}
+	return m.GetBucketTransferAccelerationFunc(p0)
+}
+
+func (m *MockS3API) GetBucketTransferAccelerationRequest(p0 *s3.GetBucketTransferAccelerationInput) (*aws.Request, *s3.GetBucketTransferAccelerationOutput) {
+	if m.GetBucketTransferAccelerationRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketTransferAccelerationRequestFunc is not set")
+	}
+	return m.GetBucketTransferAccelerationRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketTransferAccelerationWithContext(p0 aws.Context, p1 *s3.GetBucketTransferAccelerationInput) (*s3.GetBucketTransferAccelerationOutput, error) {
+	if m.GetBucketTransferAccelerationWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketTransferAccelerationWithContextFunc is not set")
+	}
+	return m.GetBucketTransferAccelerationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketVersioning(p0 *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	if m.GetBucketVersioningFunc == nil {
+		panic("s3iface: MockS3API.GetBucketVersioningFunc is not set")
+	}
+	return m.GetBucketVersioningFunc(p0)
+}
+
+func (m *MockS3API) GetBucketVersioningRequest(p0 *s3.GetBucketVersioningInput) (*aws.Request, *s3.GetBucketVersioningOutput) {
+	if m.GetBucketVersioningRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketVersioningRequestFunc is not set")
+	}
+	return m.GetBucketVersioningRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketVersioningWithContext(p0 aws.Context, p1 *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	if m.GetBucketVersioningWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketVersioningWithContextFunc is not set")
+	}
+	return m.GetBucketVersioningWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketWebsite(p0 *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error) {
+	if m.GetBucketWebsiteFunc == nil {
+		panic("s3iface: MockS3API.GetBucketWebsiteFunc is not set")
+	}
+	return m.GetBucketWebsiteFunc(p0)
+}
+
+func (m *MockS3API) GetBucketWebsiteRequest(p0 *s3.GetBucketWebsiteInput) (*aws.Request, *s3.GetBucketWebsiteOutput) {
+	if m.GetBucketWebsiteRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketWebsiteRequestFunc is not set")
+	}
+	return m.GetBucketWebsiteRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketWebsiteWithContext(p0 aws.Context, p1 *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error) {
+	if m.GetBucketWebsiteWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketWebsiteWithContextFunc is not set")
+	}
+	return m.GetBucketWebsiteWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetBucketWorm(p0 *s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error) {
+	if m.GetBucketWormFunc == nil {
+		panic("s3iface: MockS3API.GetBucketWormFunc is not set")
+	}
+	return m.GetBucketWormFunc(p0)
+}
+
+func (m *MockS3API) GetBucketWormRequest(p0 *s3.GetBucketWormInput) (*aws.Request, *s3.GetBucketWormOutput) {
+	if m.GetBucketWormRequestFunc == nil {
+		panic("s3iface: MockS3API.GetBucketWormRequestFunc is not set")
+	}
+	return m.GetBucketWormRequestFunc(p0)
+}
+
+func (m *MockS3API) GetBucketWormWithContext(p0 aws.Context, p1 *s3.GetBucketWormInput) (*s3.GetBucketWormOutput, error) {
+	if m.GetBucketWormWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetBucketWormWithContextFunc is not set")
+	}
+	return m.GetBucketWormWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetObject(p0 *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	if m.GetObjectFunc == nil {
+		panic("s3iface: MockS3API.GetObjectFunc is not set")
+	}
+	return m.GetObjectFunc(p0)
+}
+
+func (m *MockS3API) GetObjectACL(p0 *s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error) {
+	if m.GetObjectACLFunc == nil {
+		panic("s3iface: MockS3API.GetObjectACLFunc is not set")
+	}
+	return m.GetObjectACLFunc(p0)
+}
+
+func (m *MockS3API) GetObjectACLRequest(p0 *s3.GetObjectACLInput) (*aws.Request, *s3.GetObjectACLOutput) {
+	if m.GetObjectACLRequestFunc == nil {
+		panic("s3iface: MockS3API.GetObjectACLRequestFunc is not set")
+	}
+	return m.GetObjectACLRequestFunc(p0)
+}
+
+func (m *MockS3API) GetObjectACLWithContext(p0 aws.Context, p1 *s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error) {
+	if m.GetObjectACLWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetObjectACLWithContextFunc is not set")
+	}
+	return m.GetObjectACLWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetObjectMigration(p0 *s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error) {
+	if m.GetObjectMigrationFunc == nil {
+		panic("s3iface: MockS3API.GetObjectMigrationFunc is not set")
+	}
+	return m.GetObjectMigrationFunc(p0)
+}
+
+func (m *MockS3API) GetObjectMigrationRequest(p0 *s3.GetObjectMigrationInput) (*aws.Request, *s3.GetObjectMigrationOutput) {
+	if m.GetObjectMigrationRequestFunc == nil {
+		panic("s3iface: MockS3API.GetObjectMigrationRequestFunc is not set")
+	}
+	return m.GetObjectMigrationRequestFunc(p0)
+}
+
+func (m *MockS3API) GetObjectMigrationWithContext(p0 aws.Context, p1 *s3.GetObjectMigrationInput) (*s3.GetObjectMigrationOutput, error) {
+	if m.GetObjectMigrationWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetObjectMigrationWithContextFunc is not set")
+	}
+	return m.GetObjectMigrationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetObjectRequest(p0 *s3.GetObjectInput) (*aws.Request, *s3.GetObjectOutput) {
+	if m.GetObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.GetObjectRequestFunc is not set")
+	}
+	return m.GetObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) GetObjectTagging(p0 *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error) {
+	if m.GetObjectTaggingFunc == nil {
+		panic("s3iface: MockS3API.GetObjectTaggingFunc is not set")
+	}
+	return m.GetObjectTaggingFunc(p0)
+}
+
+func (m *MockS3API) GetObjectTaggingRequest(p0 *s3.GetObjectTaggingInput) (*aws.Request, *s3.GetObjectTaggingOutput) {
+	if m.GetObjectTaggingRequestFunc == nil {
+		panic("s3iface: MockS3API.GetObjectTaggingRequestFunc is not set")
+	}
+	return m.GetObjectTaggingRequestFunc(p0)
+}
+
+func (m *MockS3API) GetObjectTaggingWithContext(p0 aws.Context, p1 *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error) {
+	if m.GetObjectTaggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetObjectTaggingWithContextFunc is not set")
+	}
+	return m.GetObjectTaggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetObjectToFile(p0 *s3.GetObjectInput, p1 string) error {
+	if m.GetObjectToFileFunc == nil {
+		panic("s3iface: MockS3API.GetObjectToFileFunc is not set")
+	}
+	return m.GetObjectToFileFunc(p0, p1)
+}
+
+func (m *MockS3API) GetObjectToFileWithContext(p0 aws.Context, p1 *s3.GetObjectInput, p2 string) error {
+	if m.GetObjectToFileWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetObjectToFileWithContextFunc is not set")
+	}
+	return m.GetObjectToFileWithContextFunc(p0, p1, p2)
+}
+
+func (m *MockS3API) GetObjectTorrent(p0 *s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error) {
+	if m.GetObjectTorrentFunc == nil {
+		panic("s3iface: MockS3API.GetObjectTorrentFunc is not set")
+	}
+	return m.GetObjectTorrentFunc(p0)
+}
+
+func (m *MockS3API) GetObjectTorrentRequest(p0 *s3.GetObjectTorrentInput) (*aws.Request, *s3.GetObjectTorrentOutput) {
+	if m.GetObjectTorrentRequestFunc == nil {
+		panic("s3iface: MockS3API.GetObjectTorrentRequestFunc is not set")
+	}
+	return m.GetObjectTorrentRequestFunc(p0)
+}
+
+func (m *MockS3API) GetObjectTorrentWithContext(p0 aws.Context, p1 *s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error) {
+	if m.GetObjectTorrentWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetObjectTorrentWithContextFunc is not set")
+	}
+	return m.GetObjectTorrentWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetObjectWithContext(p0 aws.Context, p1 *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	if m.GetObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetObjectWithContextFunc is not set")
+	}
+	return m.GetObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetPublicNetworkBlock(p0 *s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error) {
+	if m.GetPublicNetworkBlockFunc == nil {
+		panic("s3iface: MockS3API.GetPublicNetworkBlockFunc is not set")
+	}
+	return m.GetPublicNetworkBlockFunc(p0)
+}
+
+func (m *MockS3API) GetPublicNetworkBlockRequest(p0 *s3.GetPublicNetworkBlockInput) (*aws.Request, *s3.GetPublicNetworkBlockOutput) {
+	if m.GetPublicNetworkBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.GetPublicNetworkBlockRequestFunc is not set")
+	}
+	return m.GetPublicNetworkBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) GetPublicNetworkBlockWithContext(p0 aws.Context, p1 *s3.GetPublicNetworkBlockInput) (*s3.GetPublicNetworkBlockOutput, error) {
+	if m.GetPublicNetworkBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetPublicNetworkBlockWithContextFunc is not set")
+	}
+	return m.GetPublicNetworkBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetRequesterQos(p0 *s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error) {
+	if m.GetRequesterQosFunc == nil {
+		panic("s3iface: MockS3API.GetRequesterQosFunc is not set")
+	}
+	return m.GetRequesterQosFunc(p0)
+}
+
+func (m *MockS3API) GetRequesterQosRequest(p0 *s3.GetRequesterQosInput) (*aws.Request, *s3.GetRequesterQosOutput) {
+	if m.GetRequesterQosRequestFunc == nil {
+		panic("s3iface: MockS3API.GetRequesterQosRequestFunc is not set")
+	}
+	return m.GetRequesterQosRequestFunc(p0)
+}
+
+func (m *MockS3API) GetRequesterQosWithContext(p0 aws.Context, p1 *s3.GetRequesterQosInput) (*s3.GetRequesterQosOutput, error) {
+	if m.GetRequesterQosWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetRequesterQosWithContextFunc is not set")
+	}
+	return m.GetRequesterQosWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) GetVpcAccessBlock(p0 *s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error) {
+	if m.GetVpcAccessBlockFunc == nil {
+		panic("s3iface: MockS3API.GetVpcAccessBlockFunc is not set")
+	}
+	return m.GetVpcAccessBlockFunc(p0)
+}
+
+func (m *MockS3API) GetVpcAccessBlockRequest(p0 *s3.GetVpcAccessBlockInput) (*aws.Request, *s3.GetVpcAccessBlockOutput) {
+	if m.GetVpcAccessBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.GetVpcAccessBlockRequestFunc is not set")
+	}
+	return m.GetVpcAccessBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) GetVpcAccessBlockWithContext(p0 aws.Context, p1 *s3.GetVpcAccessBlockInput) (*s3.GetVpcAccessBlockOutput, error) {
+	if m.GetVpcAccessBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.GetVpcAccessBlockWithContextFunc is not set")
+	}
+	return m.GetVpcAccessBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) HeadBucket(p0 *s3.HeadBucketInput) (*s3.HeadBucketOutput, error) {
+	if m.HeadBucketFunc == nil {
+		panic("s3iface: MockS3API.HeadBucketFunc is not set")
+	}
+	return m.HeadBucketFunc(p0)
+}
+
+func (m *MockS3API) HeadBucketExist(p0 string) (bool, error) {
+	if m.HeadBucketExistFunc == nil {
+		panic("s3iface: MockS3API.HeadBucketExistFunc is not set")
+	}
+	return m.HeadBucketExistFunc(p0)
+}
+
+func (m *MockS3API) HeadBucketExistWithContext(p0 aws.Context, p1 string) (bool, error) {
+	if m.HeadBucketExistWithContextFunc == nil {
+		panic("s3iface: MockS3API.HeadBucketExistWithContextFunc is not set")
+	}
+	return m.HeadBucketExistWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) HeadBucketRequest(p0 *s3.HeadBucketInput) (*aws.Request, *s3.HeadBucketOutput) {
+	if m.HeadBucketRequestFunc == nil {
+		panic("s3iface: MockS3API.HeadBucketRequestFunc is not set")
+	}
+	return m.HeadBucketRequestFunc(p0)
+}
+
+func (m *MockS3API) HeadBucketWithContext(p0 aws.Context, p1 *s3.HeadBucketInput) (*s3.HeadBucketOutput, error) {
+	if m.HeadBucketWithContextFunc == nil {
+		panic("s3iface: MockS3API.HeadBucketWithContextFunc is not set")
+	}
+	return m.HeadBucketWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) HeadObject(p0 *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	if m.HeadObjectFunc == nil {
+		panic("s3iface: MockS3API.HeadObjectFunc is not set")
+	}
+	return m.HeadObjectFunc(p0)
+}
+
+func (m *MockS3API) HeadObjectRequest(p0 *s3.HeadObjectInput) (*aws.Request, *s3.HeadObjectOutput) {
+	if m.HeadObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.HeadObjectRequestFunc is not set")
+	}
+	return m.HeadObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) HeadObjectWithContext(p0 aws.Context, p1 *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	if m.HeadObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.HeadObjectWithContextFunc is not set")
+	}
+	return m.HeadObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) InitiateBucketWorm(p0 *s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error) {
+	if m.InitiateBucketWormFunc == nil {
+		panic("s3iface: MockS3API.InitiateBucketWormFunc is not set")
+	}
+	return m.InitiateBucketWormFunc(p0)
+}
+
+func (m *MockS3API) InitiateBucketWormRequest(p0 *s3.InitiateBucketWormInput) (*aws.Request, *s3.InitiateBucketWormOutput) {
+	if m.InitiateBucketWormRequestFunc == nil {
+		panic("s3iface: MockS3API.InitiateBucketWormRequestFunc is not set")
+	}
+	return m.InitiateBucketWormRequestFunc(p0)
+}
+
+func (m *MockS3API) InitiateBucketWormWithContext(p0 aws.Context, p1 *s3.InitiateBucketWormInput) (*s3.InitiateBucketWormOutput, error) {
+	if m.InitiateBucketWormWithContextFunc == nil {
+		panic("s3iface: MockS3API.InitiateBucketWormWithContextFunc is not set")
+	}
+	return m.InitiateBucketWormWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListBucketInventory(p0 *s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error) {
+	if m.ListBucketInventoryFunc == nil {
+		panic("s3iface: MockS3API.ListBucketInventoryFunc is not set")
+	}
+	return m.ListBucketInventoryFunc(p0)
+}
+
+func (m *MockS3API) ListBucketInventoryRequest(p0 *s3.ListBucketInventoryInput) (*aws.Request, *s3.ListBucketInventoryOutput) {
+	if m.ListBucketInventoryRequestFunc == nil {
+		panic("s3iface: MockS3API.ListBucketInventoryRequestFunc is not set")
+	}
+	return m.ListBucketInventoryRequestFunc(p0)
+}
+
+func (m *MockS3API) ListBucketInventoryWithContext(p0 aws.Context, p1 *s3.ListBucketInventoryInput) (*s3.ListBucketInventoryOutput, error) {
+	if m.ListBucketInventoryWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListBucketInventoryWithContextFunc is not set")
+	}
+	return m.ListBucketInventoryWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListBuckets(p0 *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	if m.ListBucketsFunc == nil {
+		panic("s3iface: MockS3API.ListBucketsFunc is not set")
+	}
+	return m.ListBucketsFunc(p0)
+}
+
+func (m *MockS3API) ListBucketsRequest(p0 *s3.ListBucketsInput) (*aws.Request, *s3.ListBucketsOutput) {
+	if m.ListBucketsRequestFunc == nil {
+		panic("s3iface: MockS3API.ListBucketsRequestFunc is not set")
+	}
+	return m.ListBucketsRequestFunc(p0)
+}
+
+func (m *MockS3API) ListBucketsWithContext(p0 aws.Context, p1 *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	if m.ListBucketsWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListBucketsWithContextFunc is not set")
+	}
+	return m.ListBucketsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListJobs(p0 *s3.ListJobsInput) (*s3.ListJobsOutput, error) {
+	if m.ListJobsFunc == nil {
+		panic("s3iface: MockS3API.ListJobsFunc is not set")
+	}
+	return m.ListJobsFunc(p0)
+}
+
+func (m *MockS3API) ListJobsRequest(p0 *s3.ListJobsInput) (*aws.Request, *s3.ListJobsOutput) {
+	if m.ListJobsRequestFunc == nil {
+		panic("s3iface: MockS3API.ListJobsRequestFunc is not set")
+	}
+	return m.ListJobsRequestFunc(p0)
+}
+
+func (m *MockS3API) ListJobsWithContext(p0 aws.Context, p1 *s3.ListJobsInput) (*s3.ListJobsOutput, error) {
+	if m.ListJobsWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListJobsWithContextFunc is not set")
+	}
+	return m.ListJobsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListMultipartUploads(p0 *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error) {
+	if m.ListMultipartUploadsFunc == nil {
+		panic("s3iface: MockS3API.ListMultipartUploadsFunc is not set")
+	}
+	return m.ListMultipartUploadsFunc(p0)
+}
+
+func (m *MockS3API) ListMultipartUploadsPages(p0 *s3.ListMultipartUploadsInput, p1 func(*s3.ListMultipartUploadsOutput, bool) bool) error {
+	if m.ListMultipartUploadsPagesFunc == nil {
+		panic("s3iface: MockS3API.ListMultipartUploadsPagesFunc is not set")
+	}
+	return m.ListMultipartUploadsPagesFunc(p0, p1)
+}
+
+func (m *MockS3API) ListMultipartUploadsRequest(p0 *s3.ListMultipartUploadsInput) (*aws.Request, *s3.ListMultipartUploadsOutput) {
+	if m.ListMultipartUploadsRequestFunc == nil {
+		panic("s3iface: MockS3API.ListMultipartUploadsRequestFunc is not set")
+	}
+	return m.ListMultipartUploadsRequestFunc(p0)
+}
+
+func (m *MockS3API) ListMultipartUploadsWithContext(p0 aws.Context, p1 *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error) {
+	if m.ListMultipartUploadsWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListMultipartUploadsWithContextFunc is not set")
+	}
+	return m.ListMultipartUploadsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListObjectVersions(p0 *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	if m.ListObjectVersionsFunc == nil {
+		panic("s3iface: MockS3API.ListObjectVersionsFunc is not set")
+	}
+	return m.ListObjectVersionsFunc(p0)
+}
+
+func (m *MockS3API) ListObjectVersionsPages(p0 *s3.ListObjectVersionsInput, p1 func(*s3.ListObjectVersionsOutput, bool) bool) error {
+	if m.ListObjectVersionsPagesFunc == nil {
+		panic("s3iface: MockS3API.ListObjectVersionsPagesFunc is not set")
+	}
+	return m.ListObjectVersionsPagesFunc(p0, p1)
+}
+
+func (m *MockS3API) ListObjectVersionsRequest(p0 *s3.ListObjectVersionsInput) (*aws.Request, *s3.ListObjectVersionsOutput) {
+	if m.ListObjectVersionsRequestFunc == nil {
+		panic("s3iface: MockS3API.ListObjectVersionsRequestFunc is not set")
+	}
+	return m.ListObjectVersionsRequestFunc(p0)
+}
+
+func (m *MockS3API) ListObjectVersionsWithContext(p0 aws.Context, p1 *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	if m.ListObjectVersionsWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListObjectVersionsWithContextFunc is not set")
+	}
+	return m.ListObjectVersionsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListObjects(p0 *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
+	if m.ListObjectsFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsFunc is not set")
+	}
+	return m.ListObjectsFunc(p0)
+}
+
+func (m *MockS3API) ListObjectsPages(p0 *s3.ListObjectsInput, p1 func(*s3.ListObjectsOutput, bool) bool) error {
+	if m.ListObjectsPagesFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsPagesFunc is not set")
+	}
+	return m.ListObjectsPagesFunc(p0, p1)
+}
+
+func (m *MockS3API) ListObjectsRequest(p0 *s3.ListObjectsInput) (*aws.Request, *s3.ListObjectsOutput) {
+	if m.ListObjectsRequestFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsRequestFunc is not set")
+	}
+	return m.ListObjectsRequestFunc(p0)
+}
+
+func (m *MockS3API) ListObjectsV2(p0 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	if m.ListObjectsV2Func == nil {
+		panic("s3iface: MockS3API.ListObjectsV2Func is not set")
+	}
+	return m.ListObjectsV2Func(p0)
+}
+
+func (m *MockS3API) ListObjectsV2Pages(p0 *s3.ListObjectsV2Input, p1 func(*s3.ListObjectsV2Output, bool) bool) error {
+	if m.ListObjectsV2PagesFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsV2PagesFunc is not set")
+	}
+	return m.ListObjectsV2PagesFunc(p0, p1)
+}
+
+func (m *MockS3API) ListObjectsV2Request(p0 *s3.ListObjectsV2Input) (*aws.Request, *s3.ListObjectsV2Output) {
+	if m.ListObjectsV2RequestFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsV2RequestFunc is not set")
+	}
+	return m.ListObjectsV2RequestFunc(p0)
+}
+
+func (m *MockS3API) ListObjectsV2WithContext(p0 aws.Context, p1 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	if m.ListObjectsV2WithContextFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsV2WithContextFunc is not set")
+	}
+	return m.ListObjectsV2WithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListObjectsWithContext(p0 aws.Context, p1 *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
+	if m.ListObjectsWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListObjectsWithContextFunc is not set")
+	}
+	return m.ListObjectsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListParts(p0 *s3.ListPartsInput) (*s3.ListPartsOutput, error) {
+	if m.ListPartsFunc == nil {
+		panic("s3iface: MockS3API.ListPartsFunc is not set")
+	}
+	return m.ListPartsFunc(p0)
+}
+
+func (m *MockS3API) ListPartsPages(p0 *s3.ListPartsInput, p1 func(*s3.ListPartsOutput, bool) bool) error {
+	if m.ListPartsPagesFunc == nil {
+		panic("s3iface: MockS3API.ListPartsPagesFunc is not set")
+	}
+	return m.ListPartsPagesFunc(p0, p1)
+}
+
+func (m *MockS3API) ListPartsRequest(p0 *s3.ListPartsInput) (*aws.Request, *s3.ListPartsOutput) {
+	if m.ListPartsRequestFunc == nil {
+		panic("s3iface: MockS3API.ListPartsRequestFunc is not set")
+	}
+	return m.ListPartsRequestFunc(p0)
+}
+
+func (m *MockS3API) ListPartsWithContext(p0 aws.Context, p1 *s3.ListPartsInput) (*s3.ListPartsOutput, error) {
+	if m.ListPartsWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListPartsWithContextFunc is not set")
+	}
+	return m.ListPartsWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) ListRetention(p0 *s3.ListRetentionInput) (*s3.ListRetentionOutput, error) {
+	if m.ListRetentionFunc == nil {
+		panic("s3iface: MockS3API.ListRetentionFunc is not set")
+	}
+	return m.ListRetentionFunc(p0)
+}
+
+func (m *MockS3API) ListRetentionRequest(p0 *s3.ListRetentionInput) (*aws.Request, *s3.ListRetentionOutput) {
+	if m.ListRetentionRequestFunc == nil {
+		panic("s3iface: MockS3API.ListRetentionRequestFunc is not set")
+	}
+	return m.ListRetentionRequestFunc(p0)
+}
+
+func (m *MockS3API) ListRetentionWithContext(p0 aws.Context, p1 *s3.ListRetentionInput) (*s3.ListRetentionOutput, error) {
+	if m.ListRetentionWithContextFunc == nil {
+		panic("s3iface: MockS3API.ListRetentionWithContextFunc is not set")
+	}
+	return m.ListRetentionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) NewListBucketInventoryPaginator(p0 *s3.ListBucketInventoryInput, p1 ...aws.PagerOption) *s3.ListBucketInventoryPaginator {
+	if m.NewListBucketInventoryPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListBucketInventoryPaginatorFunc is not set")
+	}
+	return m.NewListBucketInventoryPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListBucketsPaginator(p0 *s3.ListBucketsInput, p1 ...aws.PagerOption) *s3.ListBucketsPaginator {
+	if m.NewListBucketsPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListBucketsPaginatorFunc is not set")
+	}
+	return m.NewListBucketsPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListJobsPaginator(p0 *s3.ListJobsInput, p1 ...aws.PagerOption) *s3.ListJobsPaginator {
+	if m.NewListJobsPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListJobsPaginatorFunc is not set")
+	}
+	return m.NewListJobsPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListMultipartUploadsPaginator(p0 *s3.ListMultipartUploadsInput, p1 ...aws.PagerOption) *s3.ListMultipartUploadsPaginator {
+	if m.NewListMultipartUploadsPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListMultipartUploadsPaginatorFunc is not set")
+	}
+	return m.NewListMultipartUploadsPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListObjectVersionsPaginator(p0 *s3.ListObjectVersionsInput, p1 ...aws.PagerOption) *s3.ListObjectVersionsPaginator {
+	if m.NewListObjectVersionsPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListObjectVersionsPaginatorFunc is not set")
+	}
+	return m.NewListObjectVersionsPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListObjectsPaginator(p0 *s3.ListObjectsInput, p1 ...aws.PagerOption) *s3.ListObjectsPaginator {
+	if m.NewListObjectsPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListObjectsPaginatorFunc is not set")
+	}
+	return m.NewListObjectsPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListObjectsV2Paginator(p0 *s3.ListObjectsV2Input, p1 ...aws.PagerOption) *s3.ListObjectsV2Paginator {
+	if m.NewListObjectsV2PaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListObjectsV2PaginatorFunc is not set")
+	}
+	return m.NewListObjectsV2PaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListPartsPaginator(p0 *s3.ListPartsInput, p1 ...aws.PagerOption) *s3.ListPartsPaginator {
+	if m.NewListPartsPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListPartsPaginatorFunc is not set")
+	}
+	return m.NewListPartsPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) NewListRetentionPaginator(p0 *s3.ListRetentionInput, p1 ...aws.PagerOption) *s3.ListRetentionPaginator {
+	if m.NewListRetentionPaginatorFunc == nil {
+		panic("s3iface: MockS3API.NewListRetentionPaginatorFunc is not set")
+	}
+	return m.NewListRetentionPaginatorFunc(p0, p1...)
+}
+
+func (m *MockS3API) PresignPostObject(p0 *s3.PresignPostObjectInput) (*s3.PresignPostObjectOutput, error) {
+	if m.PresignPostObjectFunc == nil {
+		panic("s3iface: MockS3API.PresignPostObjectFunc is not set")
+	}
+	return m.PresignPostObjectFunc(p0)
+}
+
+func (m *MockS3API) PutBucketACL(p0 *s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error) {
+	if m.PutBucketACLFunc == nil {
+		panic("s3iface: MockS3API.PutBucketACLFunc is not set")
+	}
+	return m.PutBucketACLFunc(p0)
+}
+
+func (m *MockS3API) PutBucketACLRequest(p0 *s3.PutBucketACLInput) (*aws.Request, *s3.PutBucketACLOutput) {
+	if m.PutBucketACLRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketACLRequestFunc is not set")
+	}
+	return m.PutBucketACLRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketACLWithContext(p0 aws.Context, p1 *s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error) {
+	if m.PutBucketACLWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketACLWithContextFunc is not set")
+	}
+	return m.PutBucketACLWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketAccessMonitor(p0 *s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error) {
+	if m.PutBucketAccessMonitorFunc == nil {
+		panic("s3iface: MockS3API.PutBucketAccessMonitorFunc is not set")
+	}
+	return m.PutBucketAccessMonitorFunc(p0)
+}
+
+func (m *MockS3API) PutBucketAccessMonitorRequest(p0 *s3.PutBucketAccessMonitorInput) (*aws.Request, *s3.PutBucketAccessMonitorOutput) {
+	if m.PutBucketAccessMonitorRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketAccessMonitorRequestFunc is not set")
+	}
+	return m.PutBucketAccessMonitorRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketAccessMonitorWithContext(p0 aws.Context, p1 *s3.PutBucketAccessMonitorInput) (*s3.PutBucketAccessMonitorOutput, error) {
+	if m.PutBucketAccessMonitorWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketAccessMonitorWithContextFunc is not set")
+	}
+	return m.PutBucketAccessMonitorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketCORS(p0 *s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error) {
+	if m.PutBucketCORSFunc == nil {
+		panic("s3iface: MockS3API.PutBucketCORSFunc is not set")
+	}
+	return m.PutBucketCORSFunc(p0)
+}
+
+func (m *MockS3API) PutBucketCORSRequest(p0 *s3.PutBucketCORSInput) (*aws.Request, *s3.PutBucketCORSOutput) {
+	if m.PutBucketCORSRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketCORSRequestFunc is not set")
+	}
+	return m.PutBucketCORSRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketCORSWithContext(p0 aws.Context, p1 *s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error) {
+	if m.PutBucketCORSWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketCORSWithContextFunc is not set")
+	}
+	return m.PutBucketCORSWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketDataAccelerator(p0 *s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error) {
+	if m.PutBucketDataAcceleratorFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDataAcceleratorFunc is not set")
+	}
+	return m.PutBucketDataAcceleratorFunc(p0)
+}
+
+func (m *MockS3API) PutBucketDataAcceleratorRequest(p0 *s3.PutBucketDataAcceleratorInput) (*aws.Request, *s3.PutBucketDataAcceleratorOutput) {
+	if m.PutBucketDataAcceleratorRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDataAcceleratorRequestFunc is not set")
+	}
+	return m.PutBucketDataAcceleratorRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketDataAcceleratorWithContext(p0 aws.Context, p1 *s3.PutBucketDataAcceleratorInput) (*s3.PutBucketDataAcceleratorOutput, error) {
+	if m.PutBucketDataAcceleratorWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDataAcceleratorWithContextFunc is not set")
+	}
+	return m.PutBucketDataAcceleratorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketDataRedundancySwitch(p0 *s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error) {
+	if m.PutBucketDataRedundancySwitchFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDataRedundancySwitchFunc is not set")
+	}
+	return m.PutBucketDataRedundancySwitchFunc(p0)
+}
+
+func (m *MockS3API) PutBucketDataRedundancySwitchRequest(p0 *s3.PutBucketDataRedundancySwitchInput) (*aws.Request, *s3.PutBucketDataRedundancySwitchOutput) {
+	if m.PutBucketDataRedundancySwitchRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDataRedundancySwitchRequestFunc is not set")
+	}
+	return m.PutBucketDataRedundancySwitchRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketDataRedundancySwitchWithContext(p0 aws.Context, p1 *s3.PutBucketDataRedundancySwitchInput) (*s3.PutBucketDataRedundancySwitchOutput, error) {
+	if m.PutBucketDataRedundancySwitchWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDataRedundancySwitchWithContextFunc is not set")
+	}
+	return m.PutBucketDataRedundancySwitchWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketDecompressPolicy(p0 *s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error) {
+	if m.PutBucketDecompressPolicyFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDecompressPolicyFunc is not set")
+	}
+	return m.PutBucketDecompressPolicyFunc(p0)
+}
+
+func (m *MockS3API) PutBucketDecompressPolicyRequest(p0 *s3.PutBucketDecompressPolicyInput) (*aws.Request, *s3.PutBucketDecompressPolicyOutput) {
+	if m.PutBucketDecompressPolicyRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDecompressPolicyRequestFunc is not set")
+	}
+	return m.PutBucketDecompressPolicyRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketDecompressPolicyWithContext(p0 aws.Context, p1 *s3.PutBucketDecompressPolicyInput) (*s3.PutBucketDecompressPolicyOutput, error) {
+	if m.PutBucketDecompressPolicyWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketDecompressPolicyWithContextFunc is not set")
+	}
+	return m.PutBucketDecompressPolicyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketEncryption(p0 *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+	if m.PutBucketEncryptionFunc == nil {
+		panic("s3iface: MockS3API.PutBucketEncryptionFunc is not set")
+	}
+	return m.PutBucketEncryptionFunc(p0)
+}
+
+func (m *MockS3API) PutBucketEncryptionRequest(p0 *s3.PutBucketEncryptionInput) (*aws.Request, *s3.PutBucketEncryptionOutput) {
+	if m.PutBucketEncryptionRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketEncryptionRequestFunc is not set")
+	}
+	return m.PutBucketEncryptionRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketEncryptionWithContext(p0 aws.Context, p1 *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+	if m.PutBucketEncryptionWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketEncryptionWithContextFunc is not set")
+	}
+	return m.PutBucketEncryptionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketInventory(p0 *s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error) {
+	if m.PutBucketInventoryFunc == nil {
+		panic("s3iface: MockS3API.PutBucketInventoryFunc is not set")
+	}
+	return m.PutBucketInventoryFunc(p0)
+}
+
+func (m *MockS3API) PutBucketInventoryRequest(p0 *s3.PutBucketInventoryInput) (*aws.Request, *s3.PutBucketInventoryOutput) {
+	if m.PutBucketInventoryRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketInventoryRequestFunc is not set")
+	}
+	return m.PutBucketInventoryRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketInventoryWithContext(p0 aws.Context, p1 *s3.PutBucketInventoryInput) (*s3.PutBucketInventoryOutput, error) {
+	if m.PutBucketInventoryWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketInventoryWithContextFunc is not set")
+	}
+	return m.PutBucketInventoryWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketLifecycle(p0 *s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error) {
+	if m.PutBucketLifecycleFunc == nil {
+		panic("s3iface: MockS3API.PutBucketLifecycleFunc is not set")
+	}
+	return m.PutBucketLifecycleFunc(p0)
+}
+
+func (m *MockS3API) PutBucketLifecycleRequest(p0 *s3.PutBucketLifecycleInput) (*aws.Request, *s3.PutBucketLifecycleOutput) {
+	if m.PutBucketLifecycleRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketLifecycleRequestFunc is not set")
+	}
+	return m.PutBucketLifecycleRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketLifecycleWithContext(p0 aws.Context, p1 *s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error) {
+	if m.PutBucketLifecycleWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketLifecycleWithContextFunc is not set")
+	}
+	return m.PutBucketLifecycleWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketLogging(p0 *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error) {
+	if m.PutBucketLoggingFunc == nil {
+		panic("s3iface: MockS3API.PutBucketLoggingFunc is not set")
+	}
+	return m.PutBucketLoggingFunc(p0)
+}
+
+func (m *MockS3API) PutBucketLoggingRequest(p0 *s3.PutBucketLoggingInput) (*aws.Request, *s3.PutBucketLoggingOutput) {
+	if m.PutBucketLoggingRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketLoggingRequestFunc is not set")
+	}
+	return m.PutBucketLoggingRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketLoggingWithContext(p0 aws.Context, p1 *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error) {
+	if m.PutBucketLoggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketLoggingWithContextFunc is not set")
+	}
+	return m.PutBucketLoggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketMirror(p0 *s3.PutBucketMirrorInput) (*s3.PutBucketMirrorOutput, error) {
+	if m.PutBucketMirrorFunc == nil {
+		panic("s3iface: MockS3API.PutBucketMirrorFunc is not set")
+	}
+	return m.PutBucketMirrorFunc(p0)
+}
+
+func (m *MockS3API) PutBucketMirrorRequest(p0 *s3.PutBucketMirrorInput) (*aws.Request, *s3.PutBucketMirrorOutput) {
+	if m.PutBucketMirrorRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketMirrorRequestFunc is not set")
+	}
+	return m.PutBucketMirrorRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketMirrorWithContext(p0 aws.Context, p1 *s3.PutBucketMirrorInput) (*s3.PutBucketMirrorOutput, error) {
+	if m.PutBucketMirrorWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketMirrorWithContextFunc is not set")
+	}
+	return m.PutBucketMirrorWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketNotification(p0 *s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error) {
+	if m.PutBucketNotificationFunc == nil {
+		panic("s3iface: MockS3API.PutBucketNotificationFunc is not set")
+	}
+	return m.PutBucketNotificationFunc(p0)
+}
+
+func (m *MockS3API) PutBucketNotificationRequest(p0 *s3.PutBucketNotificationInput) (*aws.Request, *s3.PutBucketNotificationOutput) {
+	if m.PutBucketNotificationRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketNotificationRequestFunc is not set")
+	}
+	return m.PutBucketNotificationRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketNotificationWithContext(p0 aws.Context, p1 *s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error) {
+	if m.PutBucketNotificationWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketNotificationWithContextFunc is not set")
+	}
+	return m.PutBucketNotificationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketPolicy(p0 *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error) {
+	if m.PutBucketPolicyFunc == nil {
+		panic("s3iface: MockS3API.PutBucketPolicyFunc is not set")
+	}
+	return m.PutBucketPolicyFunc(p0)
+}
+
+func (m *MockS3API) PutBucketPolicyRequest(p0 *s3.PutBucketPolicyInput) (*aws.Request, *s3.PutBucketPolicyOutput) {
+	if m.PutBucketPolicyRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketPolicyRequestFunc is not set")
+	}
+	return m.PutBucketPolicyRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketPolicyWithContext(p0 aws.Context, p1 *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error) {
+	if m.PutBucketPolicyWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketPolicyWithContextFunc is not set")
+	}
+	return m.PutBucketPolicyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketPublicNetworkBlock(p0 *s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error) {
+	if m.PutBucketPublicNetworkBlockFunc == nil {
+		panic("s3iface: MockS3API.PutBucketPublicNetworkBlockFunc is not set")
+	}
+	return m.PutBucketPublicNetworkBlockFunc(p0)
+}
+
+func (m *MockS3API) PutBucketPublicNetworkBlockRequest(p0 *s3.PutBucketPublicNetworkBlockInput) (*aws.Request, *s3.PutBucketPublicNetworkBlockOutput) {
+	if m.PutBucketPublicNetworkBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketPublicNetworkBlockRequestFunc is not set")
+	}
+	return m.PutBucketPublicNetworkBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketPublicNetworkBlockWithContext(p0 aws.Context, p1 *s3.PutBucketPublicNetworkBlockInput) (*s3.PutBucketPublicNetworkBlockOutput, error) {
+	if m.PutBucketPublicNetworkBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketPublicNetworkBlockWithContextFunc is not set")
+	}
+	return m.PutBucketPublicNetworkBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketQos(p0 *s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error) {
+	if m.PutBucketQosFunc == nil {
+		panic("s3iface: MockS3API.PutBucketQosFunc is not set")
+	}
+	return m.PutBucketQosFunc(p0)
+}
+
+func (m *MockS3API) PutBucketQosRequest(p0 *s3.PutBucketQosInput) (*aws.Request, *s3.PutBucketQosOutput) {
+	if m.PutBucketQosRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketQosRequestFunc is not set")
+	}
+	return m.PutBucketQosRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketQosWithContext(p0 aws.Context, p1 *s3.PutBucketQosInput) (*s3.PutBucketQosOutput, error) {
+	if m.PutBucketQosWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketQosWithContextFunc is not set")
+	}
+	return m.PutBucketQosWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketQuota(p0 *s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error) {
+	if m.PutBucketQuotaFunc == nil {
+		panic("s3iface: MockS3API.PutBucketQuotaFunc is not set")
+	}
+	return m.PutBucketQuotaFunc(p0)
+}
+
+func (m *MockS3API) PutBucketQuotaRequest(p0 *s3.PutBucketQuotaInput) (*aws.Request, *s3.PutBucketQuotaOutput) {
+	if m.PutBucketQuotaRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketQuotaRequestFunc is not set")
+	}
+	return m.PutBucketQuotaRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketQuotaWithContext(p0 aws.Context, p1 *s3.PutBucketQuotaInput) (*s3.PutBucketQuotaOutput, error) {
+	if m.PutBucketQuotaWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketQuotaWithContextFunc is not set")
+	}
+	return m.PutBucketQuotaWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketReplication(p0 *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error) {
+	if m.PutBucketReplicationFunc == nil {
+		panic("s3iface: MockS3API.PutBucketReplicationFunc is not set")
+	}
+	return m.PutBucketReplicationFunc(p0)
+}
+
+func (m *MockS3API) PutBucketReplicationRequest(p0 *s3.PutBucketReplicationInput) (*aws.Request, *s3.PutBucketReplicationOutput) {
+	if m.PutBucketReplicationRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketReplicationRequestFunc is not set")
+	}
+	return m.PutBucketReplicationRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketReplicationWithContext(p0 aws.Context, p1 *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error) {
+	if m.PutBucketReplicationWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketReplicationWithContextFunc is not set")
+	}
+	return m.PutBucketReplicationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketRequestPayment(p0 *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error) {
+	if m.PutBucketRequestPaymentFunc == nil {
+		panic("s3iface: MockS3API.PutBucketRequestPaymentFunc is not set")
+	}
+	return m.PutBucketRequestPaymentFunc(p0)
+}
+
+func (m *MockS3API) PutBucketRequestPaymentRequest(p0 *s3.PutBucketRequestPaymentInput) (*aws.Request, *s3.PutBucketRequestPaymentOutput) {
+	if m.PutBucketRequestPaymentRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketRequestPaymentRequestFunc is not set")
+	}
+	return m.PutBucketRequestPaymentRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketRequestPaymentWithContext(p0 aws.Context, p1 *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error) {
+	if m.PutBucketRequestPaymentWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketRequestPaymentWithContextFunc is not set")
+	}
+	return m.PutBucketRequestPaymentWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketRetention(p0 *s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error) {
+	if m.PutBucketRetentionFunc == nil {
+		panic("s3iface: MockS3API.PutBucketRetentionFunc is not set")
+	}
+	return m.PutBucketRetentionFunc(p0)
+}
+
+func (m *MockS3API) PutBucketRetentionRequest(p0 *s3.PutBucketRetentionInput) (*aws.Request, *s3.PutBucketRetentionOutput) {
+	if m.PutBucketRetentionRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketRetentionRequestFunc is not set")
+	}
+	return m.PutBucketRetentionRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketRetentionWithContext(p0 aws.Context, p1 *s3.PutBucketRetentionInput) (*s3.PutBucketRetentionOutput, error) {
+	if m.PutBucketRetentionWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketRetentionWithContextFunc is not set")
+	}
+	return m.PutBucketRetentionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketTagging(p0 *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error) {
+	if m.PutBucketTaggingFunc == nil {
+		panic("s3iface: MockS3API.PutBucketTaggingFunc is not set")
+	}
+	return m.PutBucketTaggingFunc(p0)
+}
+
+func (m *MockS3API) PutBucketTaggingRequest(p0 *s3.PutBucketTaggingInput) (*aws.Request, *s3.PutBucketTaggingOutput) {
+	if m.PutBucketTaggingRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketTaggingRequestFunc is not set")
+	}
+	return m.PutBucketTaggingRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketTaggingWithContext(p0 aws.Context, p1 *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error) {
+	if m.PutBucketTaggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketTaggingWithContextFunc is not set")
+	}
+	return m.PutBucketTaggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketTransferAcceleration(p0 *s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error) {
+	if m.PutBucketTransferAccelerationFunc == nil {
+		panic("s3iface: MockS3API.PutBucketTransferAccelerationFunc is not set")
+	}
+	return m.PutBucketTransferAccelerationFunc(p0)
+}
+
+func (m *MockS3API) PutBucketTransferAccelerationRequest(p0 *s3.PutBucketTransferAccelerationInput) (*aws.Request, *s3.PutBucketTransferAccelerationOutput) {
+	if m.PutBucketTransferAccelerationRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketTransferAccelerationRequestFunc is not set")
+	}
+	return m.PutBucketTransferAccelerationRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketTransferAccelerationWithContext(p0 aws.Context, p1 *s3.PutBucketTransferAccelerationInput) (*s3.PutBucketTransferAccelerationOutput, error) {
+	if m.PutBucketTransferAccelerationWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketTransferAccelerationWithContextFunc is not set")
+	}
+	return m.PutBucketTransferAccelerationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketVersioning(p0 *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+	if m.PutBucketVersioningFunc == nil {
+		panic("s3iface: MockS3API.PutBucketVersioningFunc is not set")
+	}
+	return m.PutBucketVersioningFunc(p0)
+}
+
+func (m *MockS3API) PutBucketVersioningRequest(p0 *s3.PutBucketVersioningInput) (*aws.Request, *s3.PutBucketVersioningOutput) {
+	if m.PutBucketVersioningRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketVersioningRequestFunc is not set")
+	}
+	return m.PutBucketVersioningRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketVersioningWithContext(p0 aws.Context, p1 *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+	if m.PutBucketVersioningWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketVersioningWithContextFunc is not set")
+	}
+	return m.PutBucketVersioningWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutBucketWebsite(p0 *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error) {
+	if m.PutBucketWebsiteFunc == nil {
+		panic("s3iface: MockS3API.PutBucketWebsiteFunc is not set")
+	}
+	return m.PutBucketWebsiteFunc(p0)
+}
+
+func (m *MockS3API) PutBucketWebsiteRequest(p0 *s3.PutBucketWebsiteInput) (*aws.Request, *s3.PutBucketWebsiteOutput) {
+	if m.PutBucketWebsiteRequestFunc == nil {
+		panic("s3iface: MockS3API.PutBucketWebsiteRequestFunc is not set")
+	}
+	return m.PutBucketWebsiteRequestFunc(p0)
+}
+
+func (m *MockS3API) PutBucketWebsiteWithContext(p0 aws.Context, p1 *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error) {
+	if m.PutBucketWebsiteWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutBucketWebsiteWithContextFunc is not set")
+	}
+	return m.PutBucketWebsiteWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutObject(p0 *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	if m.PutObjectFunc == nil {
+		panic("s3iface: MockS3API.PutObjectFunc is not set")
+	}
+	return m.PutObjectFunc(p0)
+}
+
+func (m *MockS3API) PutObjectACL(p0 *s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error) {
+	if m.PutObjectACLFunc == nil {
+		panic("s3iface: MockS3API.PutObjectACLFunc is not set")
+	}
+	return m.PutObjectACLFunc(p0)
+}
+
+func (m *MockS3API) PutObjectACLRequest(p0 *s3.PutObjectACLInput) (*aws.Request, *s3.PutObjectACLOutput) {
+	if m.PutObjectACLRequestFunc == nil {
+		panic("s3iface: MockS3API.PutObjectACLRequestFunc is not set")
+	}
+	return m.PutObjectACLRequestFunc(p0)
+}
+
+func (m *MockS3API) PutObjectACLWithContext(p0 aws.Context, p1 *s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error) {
+	if m.PutObjectACLWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutObjectACLWithContextFunc is not set")
+	}
+	return m.PutObjectACLWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutObjectDataRedundancyTransition(p0 *s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error) {
+	if m.PutObjectDataRedundancyTransitionFunc == nil {
+		panic("s3iface: MockS3API.PutObjectDataRedundancyTransitionFunc is not set")
+	}
+	return m.PutObjectDataRedundancyTransitionFunc(p0)
+}
+
+func (m *MockS3API) PutObjectDataRedundancyTransitionRequest(p0 *s3.PutObjectDataRedundancyTransitionInput) (*aws.Request, *s3.PutObjectDataRedundancyTransitionOutput) {
+	if m.PutObjectDataRedundancyTransitionRequestFunc == nil {
+		panic("s3iface: MockS3API.PutObjectDataRedundancyTransitionRequestFunc is not set")
+	}
+	return m.PutObjectDataRedundancyTransitionRequestFunc(p0)
+}
+
+func (m *MockS3API) PutObjectDataRedundancyTransitionWithContext(p0 aws.Context, p1 *s3.PutObjectDataRedundancyTransitionInput) (*s3.PutObjectDataRedundancyTransitionOutput, error) {
+	if m.PutObjectDataRedundancyTransitionWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutObjectDataRedundancyTransitionWithContextFunc is not set")
+	}
+	return m.PutObjectDataRedundancyTransitionWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutObjectMigration(p0 *s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error) {
+	if m.PutObjectMigrationFunc == nil {
+		panic("s3iface: MockS3API.PutObjectMigrationFunc is not set")
+	}
+	return m.PutObjectMigrationFunc(p0)
+}
+
+func (m *MockS3API) PutObjectMigrationRequest(p0 *s3.PutObjectMigrationInput) (*aws.Request, *s3.PutObjectMigrationOutput) {
+	if m.PutObjectMigrationRequestFunc == nil {
+		panic("s3iface: MockS3API.PutObjectMigrationRequestFunc is not set")
+	}
+	return m.PutObjectMigrationRequestFunc(p0)
+}
+
+func (m *MockS3API) PutObjectMigrationWithContext(p0 aws.Context, p1 *s3.PutObjectMigrationInput) (*s3.PutObjectMigrationOutput, error) {
+	if m.PutObjectMigrationWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutObjectMigrationWithContextFunc is not set")
+	}
+	return m.PutObjectMigrationWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutObjectPreassignedInput(p0 *s3.PutObjectInput) (*http.Request, error) {
+	if m.PutObjectPreassignedInputFunc == nil {
+		panic("s3iface: MockS3API.PutObjectPreassignedInputFunc is not set")
+	}
+	return m.PutObjectPreassignedInputFunc(p0)
+}
+
+func (m *MockS3API) PutObjectRequest(p0 *s3.PutObjectInput) (*aws.Request, *s3.PutObjectOutput) {
+	if m.PutObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.PutObjectRequestFunc is not set")
+	}
+	return m.PutObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) PutObjectTagging(p0 *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error) {
+	if m.PutObjectTaggingFunc == nil {
+		panic("s3iface: MockS3API.PutObjectTaggingFunc is not set")
+	}
+	return m.PutObjectTaggingFunc(p0)
+}
+
+func (m *MockS3API) PutObjectTaggingRequest(p0 *s3.PutObjectTaggingInput) (*aws.Request, *s3.PutObjectTaggingOutput) {
+	if m.PutObjectTaggingRequestFunc == nil {
+		panic("s3iface: MockS3API.PutObjectTaggingRequestFunc is not set")
+	}
+	return m.PutObjectTaggingRequestFunc(p0)
+}
+
+func (m *MockS3API) PutObjectTaggingWithContext(p0 aws.Context, p1 *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error) {
+	if m.PutObjectTaggingWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutObjectTaggingWithContextFunc is not set")
+	}
+	return m.PutObjectTaggingWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutObjectWithContext(p0 aws.Context, p1 *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	if m.PutObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutObjectWithContextFunc is not set")
+	}
+	return m.PutObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutPublicNetworkBlock(p0 *s3.PutPublicNetworkBlockInput) (*s3.PutPublicNetworkBlockOutput, error) {
+	if m.PutPublicNetworkBlockFunc == nil {
+		panic("s3iface: MockS3API.PutPublicNetworkBlockFunc is not set")
+	}
+	return m.PutPublicNetworkBlockFunc(p0)
+}
+
+func (m *MockS3API) PutPublicNetworkBlockRequest(p0 *s3.PutPublicNetworkBlockInput) (*aws.Request, *s3.PutPublicNetworkBlockOutput) {
+	if m.PutPublicNetworkBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.PutPublicNetworkBlockRequestFunc is not set")
+	}
+	return m.PutPublicNetworkBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) PutPublicNetworkBlockWithContext(p0 aws.Context, p1 *s3.PutPublicNetworkBlockInput) (*s3.PutPublicNetworkBlockOutput, error) {
+	if m.PutPublicNetworkBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutPublicNetworkBlockWithContextFunc is not set")
+	}
+	return m.PutPublicNetworkBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutReader(p0 *s3.PutReaderInput) (*s3.PutObjectOutput, error) {
+	if m.PutReaderFunc == nil {
+		panic("s3iface: MockS3API.PutReaderFunc is not set")
+	}
+	return m.PutReaderFunc(p0)
+}
+
+func (m *MockS3API) PutReaderRequest(p0 *s3.PutReaderInput) (*aws.Request, *s3.PutObjectOutput) {
+	if m.PutReaderRequestFunc == nil {
+		panic("s3iface: MockS3API.PutReaderRequestFunc is not set")
+	}
+	return m.PutReaderRequestFunc(p0)
+}
+
+func (m *MockS3API) PutReaderWithContext(p0 aws.Context, p1 *s3.PutReaderInput) (*s3.PutObjectOutput, error) {
+	if m.PutReaderWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutReaderWithContextFunc is not set")
+	}
+	return m.PutReaderWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutRequesterQos(p0 *s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error) {
+	if m.PutRequesterQosFunc == nil {
+		panic("s3iface: MockS3API.PutRequesterQosFunc is not set")
+	}
+	return m.PutRequesterQosFunc(p0)
+}
+
+func (m *MockS3API) PutRequesterQosRequest(p0 *s3.PutRequesterQosInput) (*aws.Request, *s3.PutRequesterQosOutput) {
+	if m.PutRequesterQosRequestFunc == nil {
+		panic("s3iface: MockS3API.PutRequesterQosRequestFunc is not set")
+	}
+	return m.PutRequesterQosRequestFunc(p0)
+}
+
+func (m *MockS3API) PutRequesterQosWithContext(p0 aws.Context, p1 *s3.PutRequesterQosInput) (*s3.PutRequesterQosOutput, error) {
+	if m.PutRequesterQosWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutRequesterQosWithContextFunc is not set")
+	}
+	return m.PutRequesterQosWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) PutVpcAccessBlock(p0 *s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error) {
+	if m.PutVpcAccessBlockFunc == nil {
+		panic("s3iface: MockS3API.PutVpcAccessBlockFunc is not set")
+	}
+	return m.PutVpcAccessBlockFunc(p0)
+}
+
+func (m *MockS3API) PutVpcAccessBlockRequest(p0 *s3.PutVpcAccessBlockInput) (*aws.Request, *s3.PutVpcAccessBlockOutput) {
+	if m.PutVpcAccessBlockRequestFunc == nil {
+		panic("s3iface: MockS3API.PutVpcAccessBlockRequestFunc is not set")
+	}
+	return m.PutVpcAccessBlockRequestFunc(p0)
+}
+
+func (m *MockS3API) PutVpcAccessBlockWithContext(p0 aws.Context, p1 *s3.PutVpcAccessBlockInput) (*s3.PutVpcAccessBlockOutput, error) {
+	if m.PutVpcAccessBlockWithContextFunc == nil {
+		panic("s3iface: MockS3API.PutVpcAccessBlockWithContextFunc is not set")
+	}
+	return m.PutVpcAccessBlockWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) QueryBucketRank(p0 *s3.QueryBucketRankInput) (*s3.QueryBucketRankOutput, error) {
+	if m.QueryBucketRankFunc == nil {
+		panic("s3iface: MockS3API.QueryBucketRankFunc is not set")
+	}
+	return m.QueryBucketRankFunc(p0)
+}
+
+func (m *MockS3API) QueryBucketRankRequest(p0 *s3.QueryBucketRankInput) (*aws.Request, *s3.QueryBucketRankOutput) {
+	if m.QueryBucketRankRequestFunc == nil {
+		panic("s3iface: MockS3API.QueryBucketRankRequestFunc is not set")
+	}
+	return m.QueryBucketRankRequestFunc(p0)
+}
+
+func (m *MockS3API) QueryBucketRankWithContext(p0 aws.Context, p1 *s3.QueryBucketRankInput) (*s3.QueryBucketRankOutput, error) {
+	if m.QueryBucketRankWithContextFunc == nil {
+		panic("s3iface: MockS3API.QueryBucketRankWithContextFunc is not set")
+	}
+	return m.QueryBucketRankWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) QueryKs3Data(p0 *s3.QueryKs3DataInput) (*s3.QueryKs3DataOutput, error) {
+	if m.QueryKs3DataFunc == nil {
+		panic("s3iface: MockS3API.QueryKs3DataFunc is not set")
+	}
+	return m.QueryKs3DataFunc(p0)
+}
+
+func (m *MockS3API) QueryKs3DataRequest(p0 *s3.QueryKs3DataInput) (*aws.Request, *s3.QueryKs3DataOutput) {
+	if m.QueryKs3DataRequestFunc == nil {
+		panic("s3iface: MockS3API.QueryKs3DataRequestFunc is not set")
+	}
+	return m.QueryKs3DataRequestFunc(p0)
+}
+
+func (m *MockS3API) QueryKs3DataWithContext(p0 aws.Context, p1 *s3.QueryKs3DataInput) (*s3.QueryKs3DataOutput, error) {
+	if m.QueryKs3DataWithContextFunc == nil {
+		panic("s3iface: MockS3API.QueryKs3DataWithContextFunc is not set")
+	}
+	return m.QueryKs3DataWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) RecoverObject(p0 *s3.RecoverObjectInput) (*s3.RecoverObjectOutput, error) {
+	if m.RecoverObjectFunc == nil {
+		panic("s3iface: MockS3API.RecoverObjectFunc is not set")
+	}
+	return m.RecoverObjectFunc(p0)
+}
+
+func (m *MockS3API) RecoverObjectRequest(p0 *s3.RecoverObjectInput) (*aws.Request, *s3.RecoverObjectOutput) {
+	if m.RecoverObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.RecoverObjectRequestFunc is not set")
+	}
+	return m.RecoverObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) RecoverObjectWithContext(p0 aws.Context, p1 *s3.RecoverObjectInput) (*s3.RecoverObjectOutput, error) {
+	if m.RecoverObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.RecoverObjectWithContextFunc is not set")
+	}
+	return m.RecoverObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) RestoreObject(p0 *s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error) {
+	if m.RestoreObjectFunc == nil {
+		panic("s3iface: MockS3API.RestoreObjectFunc is not set")
+	}
+	return m.RestoreObjectFunc(p0)
+}
+
+func (m *MockS3API) RestoreObjectRequest(p0 *s3.RestoreObjectInput) (*aws.Request, *s3.RestoreObjectOutput) {
+	if m.RestoreObjectRequestFunc == nil {
+		panic("s3iface: MockS3API.RestoreObjectRequestFunc is not set")
+	}
+	return m.RestoreObjectRequestFunc(p0)
+}
+
+func (m *MockS3API) RestoreObjectWithContext(p0 aws.Context, p1 *s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error) {
+	if m.RestoreObjectWithContextFunc == nil {
+		panic("s3iface: MockS3API.RestoreObjectWithContextFunc is not set")
+	}
+	return m.RestoreObjectWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) SaveObjectToFile(p0 string, p1 *s3.GetObjectInput, p2 *s3.GetObjectOutput) error {
+	if m.SaveObjectToFileFunc == nil {
+		panic("s3iface: MockS3API.SaveObjectToFileFunc is not set")
+	}
+	return m.SaveObjectToFileFunc(p0, p1, p2)
+}
+
+func (m *MockS3API) TryDeleteBucketPrefix(p0 *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error) {
+	if m.TryDeleteBucketPrefixFunc == nil {
+		panic("s3iface: MockS3API.TryDeleteBucketPrefixFunc is not set")
+	}
+	return m.TryDeleteBucketPrefixFunc(p0)
+}
+
+func (m *MockS3API) TryDeleteBucketPrefixWithContext(p0 aws.Context, p1 *s3.DeleteBucketPrefixInput) (*s3.DeleteObjectsOutput, error) {
+	if m.TryDeleteBucketPrefixWithContextFunc == nil {
+		panic("s3iface: MockS3API.TryDeleteBucketPrefixWithContextFunc is not set")
+	}
+	return m.TryDeleteBucketPrefixWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) UpdateJobPriority(p0 *s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error) {
+	if m.UpdateJobPriorityFunc == nil {
+		panic("s3iface: MockS3API.UpdateJobPriorityFunc is not set")
+	}
+	return m.UpdateJobPriorityFunc(p0)
+}
+
+func (m *MockS3API) UpdateJobPriorityRequest(p0 *s3.UpdateJobPriorityInput) (*aws.Request, *s3.UpdateJobPriorityOutput) {
+	if m.UpdateJobPriorityRequestFunc == nil {
+		panic("s3iface: MockS3API.UpdateJobPriorityRequestFunc is not set")
+	}
+	return m.UpdateJobPriorityRequestFunc(p0)
+}
+
+func (m *MockS3API) UpdateJobPriorityWithContext(p0 aws.Context, p1 *s3.UpdateJobPriorityInput) (*s3.UpdateJobPriorityOutput, error) {
+	if m.UpdateJobPriorityWithContextFunc == nil {
+		panic("s3iface: MockS3API.UpdateJobPriorityWithContextFunc is not set")
+	}
+	return m.UpdateJobPriorityWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) UploadFile(p0 *s3.UploadFileInput) (*s3.UploadFileOutput, error) {
+	if m.UploadFileFunc == nil {
+		panic("s3iface: MockS3API.UploadFileFunc is not set")
+	}
+	return m.UploadFileFunc(p0)
+}
+
+func (m *MockS3API) UploadFileWithContext(p0 context.Context, p1 *s3.UploadFileInput) (*s3.UploadFileOutput, error) {
+	if m.UploadFileWithContextFunc == nil {
+		panic("s3iface: MockS3API.UploadFileWithContextFunc is not set")
+	}
+	return m.UploadFileWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) UploadPart(p0 *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	if m.UploadPartFunc == nil {
+		panic("s3iface: MockS3API.UploadPartFunc is not set")
+	}
+	return m.UploadPartFunc(p0)
+}
+
+func (m *MockS3API) UploadPartCopy(p0 *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error) {
+	if m.UploadPartCopyFunc == nil {
+		panic("s3iface: MockS3API.UploadPartCopyFunc is not set")
+	}
+	return m.UploadPartCopyFunc(p0)
+}
+
+func (m *MockS3API) UploadPartCopyRequest(p0 *s3.UploadPartCopyInput) (*aws.Request, *s3.UploadPartCopyOutput) {
+	if m.UploadPartCopyRequestFunc == nil {
+		panic("s3iface: MockS3API.UploadPartCopyRequestFunc is not set")
+	}
+	return m.UploadPartCopyRequestFunc(p0)
+}
+
+func (m *MockS3API) UploadPartCopyWithContext(p0 aws.Context, p1 *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error) {
+	if m.UploadPartCopyWithContextFunc == nil {
+		panic("s3iface: MockS3API.UploadPartCopyWithContextFunc is not set")
+	}
+	return m.UploadPartCopyWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) UploadPartRequest(p0 *s3.UploadPartInput) (*aws.Request, *s3.UploadPartOutput) {
+	if m.UploadPartRequestFunc == nil {
+		panic("s3iface: MockS3API.UploadPartRequestFunc is not set")
+	}
+	return m.UploadPartRequestFunc(p0)
+}
+
+func (m *MockS3API) UploadPartWithContext(p0 aws.Context, p1 *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	if m.UploadPartWithContextFunc == nil {
+		panic("s3iface: MockS3API.UploadPartWithContextFunc is not set")
+	}
+	return m.UploadPartWithContextFunc(p0, p1)
+}
+
+func (m *MockS3API) WaitUntilBucketExists(p0 *s3.HeadBucketInput) error {
+	if m.WaitUntilBucketExistsFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilBucketExistsFunc is not set")
+	}
+	return m.WaitUntilBucketExistsFunc(p0)
+}
+
+func (m *MockS3API) WaitUntilBucketExistsWithContext(p0 aws.Context, p1 *s3.HeadBucketInput, p2 ...aws.WaiterOption) error {
+	if m.WaitUntilBucketExistsWithContextFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilBucketExistsWithContextFunc is not set")
+	}
+	return m.WaitUntilBucketExistsWithContextFunc(p0, p1, p2...)
+}
+
+func (m *MockS3API) WaitUntilJobComplete(p0 *s3.DescribeJobInput) error {
+	if m.WaitUntilJobCompleteFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilJobCompleteFunc is not set")
+	}
+	return m.WaitUntilJobCompleteFunc(p0)
+}
+
+func (m *MockS3API) WaitUntilJobCompleteWithContext(p0 aws.Context, p1 *s3.DescribeJobInput, p2 ...aws.WaiterOption) error {
+	if m.WaitUntilJobCompleteWithContextFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilJobCompleteWithContextFunc is not set")
+	}
+	return m.WaitUntilJobCompleteWithContextFunc(p0, p1, p2...)
+}
+
+func (m *MockS3API) WaitUntilObjectExists(p0 *s3.HeadObjectInput) error {
+	if m.WaitUntilObjectExistsFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilObjectExistsFunc is not set")
+	}
+	return m.WaitUntilObjectExistsFunc(p0)
+}
+
+func (m *MockS3API) WaitUntilObjectExistsWithContext(p0 aws.Context, p1 *s3.HeadObjectInput, p2 ...aws.WaiterOption) error {
+	if m.WaitUntilObjectExistsWithContextFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilObjectExistsWithContextFunc is not set")
+	}
+	return m.WaitUntilObjectExistsWithContextFunc(p0, p1, p2...)
+}
+
+func (m *MockS3API) WaitUntilObjectNotExists(p0 *s3.HeadObjectInput) error {
+	if m.WaitUntilObjectNotExistsFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilObjectNotExistsFunc is not set")
+	}
+	return m.WaitUntilObjectNotExistsFunc(p0)
+}
+
+func (m *MockS3API) WaitUntilObjectNotExistsWithContext(p0 aws.Context, p1 *s3.HeadObjectInput, p2 ...aws.WaiterOption) error {
+	if m.WaitUntilObjectNotExistsWithContextFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilObjectNotExistsWithContextFunc is not set")
+	}
+	return m.WaitUntilObjectNotExistsWithContextFunc(p0, p1, p2...)
+}
+
+func (m *MockS3API) WaitUntilRestoreCompleted(p0 *s3.HeadObjectInput) error {
+	if m.WaitUntilRestoreCompletedFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilRestoreCompletedFunc is not set")
+	}
+	return m.WaitUntilRestoreCompletedFunc(p0)
+}
+
+func (m *MockS3API) WaitUntilRestoreCompletedWithContext(p0 aws.Context, p1 *s3.HeadObjectInput, p2 ...aws.WaiterOption) error {
+	if m.WaitUntilRestoreCompletedWithContextFunc == nil {
+		panic("s3iface: MockS3API.WaitUntilRestoreCompletedWithContextFunc is not set")
+	}
+	return m.WaitUntilRestoreCompletedWithContextFunc(p0, p1, p2...)
+}
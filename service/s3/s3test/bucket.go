@@ -0,0 +1,564 @@
+package s3test
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Canned ACLs.
+const (
+	aclPrivate         = "private"
+	aclPublicRead      = "public-read"
+	aclPublicReadWrite = "public-read-write"
+)
+
+const allUsersURI = "http://acs.amazonaws.com/groups/global/AllUsers"
+
+// defaultMaxKeys is the number of entries a list returns by default, and at
+// most.
+const defaultMaxKeys = 1000
+
+var reBucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9\-]{1,61}[a-z0-9]$`)
+
+type bucket struct {
+	name    string
+	created time.Time
+	acl     string
+	tags    []tag
+	objects map[string]*object
+	uploads map[string]*upload
+}
+
+type tag struct {
+	Key   string
+	Value string
+}
+
+// CreateBucket creates a bucket, if it does not exist yet.
+func (s *Server) CreateBucket(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.buckets[name] == nil {
+		s.buckets[name] = newBucket(name, aclPrivate)
+	}
+}
+
+func newBucket(name, acl string) *bucket {
+	return &bucket{
+		name:    name,
+		created: time.Now(),
+		acl:     acl,
+		objects: map[string]*object{},
+		uploads: map[string]*upload{},
+	}
+}
+
+// getBucket returns the bucket r addresses, or writes a NoSuchBucket error.
+// It must be called with s.mu held.
+func (s *Server) getBucket(r *request) *bucket {
+	b := s.buckets[r.bucket]
+	if b == nil {
+		r.error("NoSuchBucket", http.StatusNotFound, "the specified bucket does not exist")
+	}
+	return b
+}
+
+type listBucketsResult struct {
+	XMLName xml.Name     `xml:"ListAllMyBucketsResult"`
+	Owner   owner        `xml:"Owner"`
+	Buckets []bucketInfo `xml:"Buckets>Bucket"`
+}
+
+type owner struct {
+	ID          string
+	DisplayName string
+}
+
+type bucketInfo struct {
+	Name         string
+	CreationDate string
+}
+
+func (s *Server) listBuckets(r *request) {
+	s.mu.Lock()
+	result := listBucketsResult{Owner: owner{ownerID, ownerID}}
+	for _, b := range s.buckets {
+		result.Buckets = append(result.Buckets, bucketInfo{Name: b.name, CreationDate: formatTime(b.created)})
+	}
+	s.mu.Unlock()
+	sort.Slice(result.Buckets, func(i, j int) bool {
+		return result.Buckets[i].Name < result.Buckets[j].Name
+	})
+	r.writeXML(result)
+}
+
+func (s *Server) serveBucket(r *request) {
+	switch {
+	case r.Method == http.MethodPut && len(r.query) == 0:
+		s.createBucket(r)
+	case r.Method == http.MethodPost && hasQuery(r.query, "delete"):
+		s.deleteObjects(r)
+	case hasQuery(r.query, "acl"):
+		s.serveACL(r, r.Method == http.MethodPut)
+	case hasQuery(r.query, "tagging"):
+		s.serveTagging(r)
+	case r.Method == http.MethodGet && hasQuery(r.query, "uploads"):
+		s.listMultipartUploads(r)
+	case r.Method == http.MethodGet:
+		s.listObjects(r)
+	case r.Method == http.MethodHead:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.getBucket(r) != nil {
+			r.w.WriteHeader(http.StatusOK)
+		}
+	case r.Method == http.MethodDelete && len(r.query) == 0:
+		s.deleteBucket(r)
+	default:
+		r.error("NotImplemented", http.StatusNotImplemented, "the server does not implement this operation")
+	}
+}
+
+func (s *Server) createBucket(r *request) {
+	if _, ok := r.readBody(); !ok {
+		return
+	}
+	acl, ok := cannedACL(r)
+	if !ok {
+		return
+	}
+	if !reBucketName.MatchString(r.bucket) {
+		r.error("InvalidBucketName", http.StatusBadRequest, "the specified bucket is not valid")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.buckets[r.bucket] != nil {
+		r.error("BucketAlreadyExists", http.StatusConflict, "the requested bucket name is not available")
+		return
+	}
+	if acl == "" {
+		acl = aclPrivate
+	}
+	s.buckets[r.bucket] = newBucket(r.bucket, acl)
+	r.w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteBucket(r *request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.getBucket(r)
+	if b == nil {
+		return
+	}
+	if len(b.objects) > 0 || len(b.uploads) > 0 {
+		r.error("BucketNotEmpty", http.StatusConflict, "the bucket you tried to delete is not empty")
+		return
+	}
+	delete(s.buckets, r.bucket)
+	r.noContent()
+}
+
+type deleteRequest struct {
+	Quiet   bool
+	Objects []struct {
+		Key string
+	} `xml:"Object"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name `xml:"DeleteResult"`
+	Deleted []deletedObject
+}
+
+type deletedObject struct {
+	Key string
+}
+
+func (s *Server) deleteObjects(r *request) {
+	var in deleteRequest
+	if !r.readXML(&in) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.getBucket(r)
+	if b == nil {
+		return
+	}
+	var result deleteResult
+	for _, obj := range in.Objects {
+		delete(b.objects, obj.Key)
+		if !in.Quiet {
+			result.Deleted = append(result.Deleted, deletedObject{obj.Key})
+		}
+	}
+	r.writeXML(result)
+}
+
+// listEntry is a key or a common prefix of a list.
+type listEntry struct {
+	key    string
+	prefix bool
+}
+
+// list returns up to maxKeys keys and common prefixes of b after marker, and
+// if more follow.
+func (b *bucket) list(prefix, delimiter, marker string, maxKeys int) ([]listEntry, bool) {
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var entries []listEntry
+	for _, key := range keys {
+		entry := listEntry{key: key}
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				entry = listEntry{key: key[:len(prefix)+i+len(delimiter)], prefix: true}
+			}
+		}
+		if entry.key <= marker || (len(entries) > 0 && entries[len(entries)-1] == entry) {
+			continue
+		}
+		if len(entries) == maxKeys {
+			return entries, true
+		}
+		entries = append(entries, entry)
+	}
+	return entries, false
+}
+
+type listObjectsResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string
+	Prefix                string
+	Marker                string `xml:",omitempty"`
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	NextMarker            string `xml:",omitempty"`
+	NextContinuationToken string `xml:",omitempty"`
+	KeyCount              *int   `xml:",omitempty"`
+	MaxKeys               int
+	Delimiter             string `xml:",omitempty"`
+	EncodingType          string `xml:",omitempty"`
+	IsTruncated           bool
+	Contents              []objectInfo
+	CommonPrefixes        []commonPrefix
+}
+
+type objectInfo struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+	StorageClass string
+	Owner        *owner `xml:",omitempty"`
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+func (s *Server) listObjects(r *request) {
+	v2 := r.query.Get("list-type") == "2"
+	maxKeys, ok := intQuery(r, "max-keys", defaultMaxKeys)
+	if !ok {
+		return
+	}
+	if maxKeys > defaultMaxKeys {
+		maxKeys = defaultMaxKeys
+	}
+	result := listObjectsResult{
+		Name:         r.bucket,
+		Prefix:       r.query.Get("prefix"),
+		Delimiter:    r.query.Get("delimiter"),
+		MaxKeys:      maxKeys,
+		EncodingType: r.query.Get("encoding-type"),
+	}
+	marker := r.query.Get("marker")
+	if v2 {
+		result.StartAfter = r.query.Get("start-after")
+		result.ContinuationToken = r.query.Get("continuation-token")
+		marker = result.StartAfter
+		if result.ContinuationToken != "" {
+			token, err := base64.StdEncoding.DecodeString(result.ContinuationToken)
+			if err != nil {
+				r.error("InvalidArgument", http.StatusBadRequest, "the continuation token provided is incorrect")
+				return
+			}
+			marker = string(token)
+		}
+	} else {
+		result.Marker = marker
+	}
+
+	s.mu.Lock()
+	b := s.getBucket(r)
+	if b == nil {
+		s.mu.Unlock()
+		return
+	}
+	entries, truncated := b.list(result.Prefix, result.Delimiter, marker, maxKeys)
+	encode := func(s string) string { return s }
+	if result.EncodingType == "url" {
+		encode = url.QueryEscape
+	}
+	for _, entry := range entries {
+		if entry.prefix {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{encode(entry.key)})
+			continue
+		}
+		obj := b.objects[entry.key]
+		info := objectInfo{
+			Key:          encode(entry.key),
+			LastModified: formatTime(obj.lastModified),
+			ETag:         obj.etag,
+			Size:         len(obj.data),
+			StorageClass: obj.storageClass(),
+		}
+		if !v2 || r.query.Get("fetch-owner") == "true" {
+			info.Owner = &owner{ownerID, ownerID}
+		}
+		result.Contents = append(result.Contents, info)
+	}
+	s.mu.Unlock()
+
+	result.IsTruncated = truncated
+	if truncated {
+		last := entries[len(entries)-1].key
+		if v2 {
+			result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(last))
+		} else {
+			result.NextMarker = encode(last)
+		}
+	}
+	if v2 {
+		count := len(entries)
+		result.KeyCount = &count
+	}
+	r.writeXML(result)
+}
+
+type accessControlPolicy struct {
+	XMLName           xml.Name `xml:"AccessControlPolicy"`
+	Owner             owner
+	AccessControlList []grant `xml:"AccessControlList>Grant"`
+}
+
+type grant struct {
+	Grantee    grantee
+	Permission string
+}
+
+type grantee struct {
+	XMLNSXSI    string `xml:"xmlns:xsi,attr,omitempty"`
+	Type        string `xml:"xsi:type,attr,omitempty"`
+	ID          string `xml:",omitempty"`
+	DisplayName string `xml:",omitempty"`
+	URI         string `xml:",omitempty"`
+}
+
+// serveACL gets or sets the canned ACL of the bucket or the object r
+// addresses.
+func (s *Server) serveACL(r *request, put bool) {
+	var acl string
+	if put {
+		data, ok := r.readBody()
+		if !ok {
+			return
+		}
+		if acl, ok = cannedACL(r); !ok {
+			return
+		}
+		if acl == "" && len(data) > 0 {
+			var policy struct {
+				Grants []struct {
+					Grantee struct {
+						URI string
+					}
+					Permission string
+				} `xml:"AccessControlList>Grant"`
+			}
+			if err := xml.Unmarshal(data, &policy); err != nil {
+				r.error("MalformedXML", http.StatusBadRequest, "the XML you provided was not well-formed")
+				return
+			}
+			var read, write bool
+			for _, g := range policy.Grants {
+				if g.Grantee.URI == allUsersURI {
+					read = read || g.Permission == "READ"
+					write = write || g.Permission == "WRITE"
+				}
+			}
+			acl = aclForPermissions(read, write)
+		}
+		if acl == "" {
+			acl = aclPrivate
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.getBucket(r)
+	if b == nil {
+		return
+	}
+	target := &b.acl
+	if r.key != "" {
+		obj := s.getObject(r, b)
+		if obj == nil {
+			return
+		}
+		target = &obj.acl
+	}
+	if put {
+		*target = acl
+		r.w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	policy := accessControlPolicy{
+		Owner: owner{ownerID, ownerID},
+		AccessControlList: []grant{{
+			Grantee:    grantee{XMLNSXSI: xmlnsXSI, Type: "CanonicalUser", ID: ownerID, DisplayName: ownerID},
+			Permission: "FULL_CONTROL",
+		}},
+	}
+	current := *target
+	if current == "" {
+		current = b.acl
+	}
+	if current == aclPublicRead || current == aclPublicReadWrite {
+		policy.AccessControlList = append(policy.AccessControlList, grant{
+			Grantee:    grantee{XMLNSXSI: xmlnsXSI, Type: "Group", URI: allUsersURI},
+			Permission: "READ",
+		})
+	}
+	if current == aclPublicReadWrite {
+		policy.AccessControlList = append(policy.AccessControlList, grant{
+			Grantee:    grantee{XMLNSXSI: xmlnsXSI, Type: "Group", URI: allUsersURI},
+			Permission: "WRITE",
+		})
+	}
+	r.writeXML(policy)
+}
+
+const xmlnsXSI = "http://www.w3.org/2001/XMLSchema-instance"
+
+func aclForPermissions(read, write bool) string {
+	switch {
+	case read && write:
+		return aclPublicReadWrite
+	case read:
+		return aclPublicRead
+	}
+	return aclPrivate
+}
+
+// cannedACL returns the canned ACL set by the x-amz-acl header of r, or
+// writes an InvalidArgument error for an unknown one.
+func cannedACL(r *request) (string, bool) {
+	acl := r.Header.Get("X-Amz-Acl")
+	switch acl {
+	case "", aclPrivate, aclPublicRead, aclPublicReadWrite:
+		return acl, true
+	}
+	r.error("InvalidArgument", http.StatusBadRequest, "the canned ACL "+acl+" is not valid")
+	return "", false
+}
+
+type tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	TagSet  []tag    `xml:"TagSet>Tag"`
+}
+
+// serveTagging gets, sets or deletes the tags of the bucket or the object r
+// addresses.
+func (s *Server) serveTagging(r *request) {
+	var in tagging
+	if r.Method == http.MethodPut && !r.readXML(&in) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.getBucket(r)
+	if b == nil {
+		return
+	}
+	target := &b.tags
+	if r.key != "" {
+		obj := s.getObject(r, b)
+		if obj == nil {
+			return
+		}
+		target = &obj.tags
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.key == "" && len(*target) == 0 {
+			r.error("NoSuchTagSet", http.StatusNotFound, "there is no tag set associated with the bucket")
+			return
+		}
+		r.writeXML(tagging{TagSet: *target})
+	case http.MethodPut:
+		*target = in.TagSet
+		r.w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		*target = nil
+		r.noContent()
+	default:
+		r.error("MethodNotAllowed", http.StatusMethodNotAllowed, "the specified method is not allowed against this resource")
+	}
+}
+
+// parseTagging parses the tags of the x-amz-tagging header, a URL encoded
+// query string.
+func parseTagging(header string) ([]tag, bool) {
+	values, err := url.ParseQuery(header)
+	if err != nil {
+		return nil, false
+	}
+	var tags []tag
+	for key, vals := range values {
+		tags = append(tags, tag{Key: key, Value: vals[0]})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+	return tags, true
+}
+
+// hasQuery returns if the query string has the parameter name, possibly
+// without value.
+func hasQuery(query url.Values, name string) bool {
+	_, ok := query[name]
+	return ok
+}
+
+// intQuery returns the integer query parameter name of r, or writes an
+// InvalidArgument error if it is not a non negative integer.
+func intQuery(r *request, name string, def int) (int, bool) {
+	v := r.query.Get(name)
+	if v == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		r.error("InvalidArgument", http.StatusBadRequest, "the value of "+name+" is not valid")
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,396 @@
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxPartNumber is the largest part number of a multipart upload.
+const maxPartNumber = 10000
+
+type upload struct {
+	id        string
+	key       string
+	initiated time.Time
+	object    *object // metadata of the object the upload completes
+	parts     map[int]*part
+}
+
+type part struct {
+	data         []byte
+	etag         string
+	lastModified time.Time
+}
+
+// getUpload returns the upload of the uploadId of r, or writes a NoSuchUpload
+// error. It must be called with s.mu held.
+func (s *Server) getUpload(r *request) (*bucket, *upload) {
+	b := s.getBucket(r)
+	if b == nil {
+		return nil, nil
+	}
+	u := b.uploads[r.query.Get("uploadId")]
+	if u == nil || u.key != r.key {
+		r.error("NoSuchUpload", http.StatusNotFound, "the specified multipart upload does not exist")
+		return nil, nil
+	}
+	return b, u
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Bucket   string
+	Key      string
+	UploadID string `xml:"UploadId"`
+}
+
+func (s *Server) createMultipartUpload(r *request) {
+	if _, ok := r.readBody(); !ok {
+		return
+	}
+	obj, ok := newObject(r, nil)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	b := s.getBucket(r)
+	if b == nil {
+		s.mu.Unlock()
+		return
+	}
+	s.uploadID++
+	sum := md5.Sum([]byte(fmt.Sprintf("%s/%s/%d", r.bucket, r.key, s.uploadID)))
+	u := &upload{
+		id:        hex.EncodeToString(sum[:]),
+		key:       r.key,
+		initiated: time.Now(),
+		object:    obj,
+		parts:     map[int]*part{},
+	}
+	b.uploads[u.id] = u
+	s.mu.Unlock()
+
+	setSSEHeaders(r.w.Header(), obj.sseAlgorithm, obj.sseKeyMD5, obj.header.Get("X-Amz-Server-Side-Encryption"))
+	r.writeXML(initiateMultipartUploadResult{Bucket: r.bucket, Key: r.key, UploadID: u.id})
+}
+
+type copyPartResult struct {
+	XMLName           xml.Name `xml:"CopyPartResult"`
+	LastModified      string
+	ETag              string
+	ChecksumCRC64ECMA string
+}
+
+// uploadPart serves UploadPart, and UploadPartCopy when the request has a
+// copy source.
+func (s *Server) uploadPart(r *request) {
+	number, err := strconv.Atoi(r.query.Get("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		r.error("InvalidArgument", http.StatusBadRequest, "part number must be an integer between 1 and 10000")
+		return
+	}
+	data, ok := r.readBody()
+	if !ok {
+		return
+	}
+	copied := r.Header.Get("X-Amz-Copy-Source") != ""
+
+	s.mu.Lock()
+	_, u := s.getUpload(r)
+	if u == nil {
+		s.mu.Unlock()
+		return
+	}
+	if !checkCustomerKey(r, u.object, sseCustomerPrefix) {
+		s.mu.Unlock()
+		return
+	}
+	if copied {
+		src, ok := s.copySource(r)
+		if !ok {
+			s.mu.Unlock()
+			return
+		}
+		data = src.data
+		if spec := r.Header.Get("X-Amz-Copy-Source-Range"); spec != "" {
+			start, end, ok := parseRange(spec, int64(len(data)))
+			if !ok || start < 0 {
+				s.mu.Unlock()
+				r.error("InvalidArgument", http.StatusBadRequest, "the x-amz-copy-source-range is not valid")
+				return
+			}
+			data = data[start : end+1]
+		}
+	}
+	p := &part{data: data, etag: etag(data), lastModified: time.Now()}
+	u.parts[number] = p
+	o := *u.object
+	s.mu.Unlock()
+
+	h := r.w.Header()
+	crc := checksum(data)
+	h.Set("X-Amz-Checksum-Crc64ecma", crc)
+	setSSEHeaders(h, o.sseAlgorithm, o.sseKeyMD5, o.header.Get("X-Amz-Server-Side-Encryption"))
+	if copied {
+		r.writeXML(copyPartResult{LastModified: formatTime(p.lastModified), ETag: p.etag, ChecksumCRC64ECMA: crc})
+		return
+	}
+	h.Set("ETag", p.etag)
+	r.w.WriteHeader(http.StatusOK)
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int
+		ETag       string
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName           xml.Name `xml:"CompleteMultipartUploadResult"`
+	Location          string
+	Bucket            string
+	Key               string
+	ETag              string
+	ChecksumCRC64ECMA string
+}
+
+func (s *Server) completeMultipartUpload(r *request) {
+	var in completeMultipartUpload
+	if !r.readXML(&in) {
+		return
+	}
+	if len(in.Parts) == 0 {
+		r.error("MalformedXML", http.StatusBadRequest, "the XML you provided was not well-formed")
+		return
+	}
+
+	s.mu.Lock()
+	b, u := s.getUpload(r)
+	if u == nil {
+		s.mu.Unlock()
+		return
+	}
+	var data []byte
+	var md5s []byte
+	for i, p := range in.Parts {
+		if i > 0 && p.PartNumber <= in.Parts[i-1].PartNumber {
+			s.mu.Unlock()
+			r.error("InvalidPartOrder", http.StatusBadRequest, "the list of parts was not in ascending order")
+			return
+		}
+		uploaded := u.parts[p.PartNumber]
+		if uploaded == nil || strings.Trim(p.ETag, `"`) != strings.Trim(uploaded.etag, `"`) {
+			s.mu.Unlock()
+			r.error("InvalidPart", http.StatusBadRequest, fmt.Sprintf("part %d could not be found", p.PartNumber))
+			return
+		}
+		if i < len(in.Parts)-1 && int64(len(uploaded.data)) < s.cfg.MinPartSize {
+			s.mu.Unlock()
+			r.error("EntityTooSmall", http.StatusBadRequest, "your proposed upload is smaller than the minimum allowed object size")
+			return
+		}
+		data = append(data, uploaded.data...)
+		sum, _ := hex.DecodeString(strings.Trim(uploaded.etag, `"`))
+		md5s = append(md5s, sum...)
+	}
+	obj := u.object
+	obj.data = data
+	sum := md5.Sum(md5s)
+	obj.etag = fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(sum[:]), len(in.Parts))
+	obj.lastModified = time.Now()
+	b.objects[r.key] = obj
+	delete(b.uploads, u.id)
+	s.mu.Unlock()
+
+	crc := checksum(data)
+	h := r.w.Header()
+	h.Set("X-Amz-Checksum-Crc64ecma", crc)
+	setSSEHeaders(h, obj.sseAlgorithm, obj.sseKeyMD5, obj.header.Get("X-Amz-Server-Side-Encryption"))
+	r.writeXML(completeMultipartUploadResult{
+		Location:          fmt.Sprintf("http://%s/%s/%s", r.Host, r.bucket, r.key),
+		Bucket:            r.bucket,
+		Key:               r.key,
+		ETag:              obj.etag,
+		ChecksumCRC64ECMA: crc,
+	})
+}
+
+func (s *Server) abortMultipartUpload(r *request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, u := s.getUpload(r)
+	if u == nil {
+		return
+	}
+	delete(b.uploads, u.id)
+	r.noContent()
+}
+
+type listPartsResult struct {
+	XMLName              xml.Name `xml:"ListPartsResult"`
+	Bucket               string
+	Key                  string
+	UploadID             string `xml:"UploadId"`
+	Initiator            owner
+	Owner                owner
+	StorageClass         string
+	PartNumberMarker     int
+	NextPartNumberMarker int
+	MaxParts             int
+	IsTruncated          bool
+	Parts                []partInfo `xml:"Part"`
+}
+
+type partInfo struct {
+	PartNumber        int
+	LastModified      string
+	ETag              string
+	Size              int
+	ChecksumCRC64ECMA string
+}
+
+func (s *Server) listParts(r *request) {
+	marker, ok := intQuery(r, "part-number-marker", 0)
+	if !ok {
+		return
+	}
+	maxParts, ok := intQuery(r, "max-parts", defaultMaxKeys)
+	if !ok {
+		return
+	}
+	if maxParts > defaultMaxKeys {
+		maxParts = defaultMaxKeys
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, u := s.getUpload(r)
+	if u == nil {
+		return
+	}
+	var numbers []int
+	for number := range u.parts {
+		if number > marker {
+			numbers = append(numbers, number)
+		}
+	}
+	sort.Ints(numbers)
+
+	result := listPartsResult{
+		Bucket:           r.bucket,
+		Key:              r.key,
+		UploadID:         u.id,
+		Initiator:        owner{ownerID, ownerID},
+		Owner:            owner{ownerID, ownerID},
+		StorageClass:     u.object.storageClass(),
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+	if len(numbers) > maxParts {
+		numbers = numbers[:maxParts]
+		result.IsTruncated = true
+	}
+	for _, number := range numbers {
+		p := u.parts[number]
+		result.Parts = append(result.Parts, partInfo{
+			PartNumber:        number,
+			LastModified:      formatTime(p.lastModified),
+			ETag:              p.etag,
+			Size:              len(p.data),
+			ChecksumCRC64ECMA: checksum(p.data),
+		})
+		result.NextPartNumberMarker = number
+	}
+	r.writeXML(result)
+}
+
+type listMultipartUploadsResult struct {
+	XMLName            xml.Name `xml:"ListMultipartUploadsResult"`
+	Bucket             string
+	KeyMarker          string
+	UploadIDMarker     string `xml:"UploadIdMarker"`
+	NextKeyMarker      string
+	NextUploadIDMarker string `xml:"NextUploadIdMarker"`
+	Prefix             string
+	MaxUploads         int
+	IsTruncated        bool
+	Uploads            []uploadInfo `xml:"Upload"`
+}
+
+type uploadInfo struct {
+	Key          string
+	UploadID     string `xml:"UploadId"`
+	Initiator    owner
+	Owner        owner
+	StorageClass string
+	Initiated    string
+}
+
+func (s *Server) listMultipartUploads(r *request) {
+	maxUploads, ok := intQuery(r, "max-uploads", defaultMaxKeys)
+	if !ok {
+		return
+	}
+	if maxUploads > defaultMaxKeys {
+		maxUploads = defaultMaxKeys
+	}
+	result := listMultipartUploadsResult{
+		Bucket:         r.bucket,
+		KeyMarker:      r.query.Get("key-marker"),
+		UploadIDMarker: r.query.Get("upload-id-marker"),
+		Prefix:         r.query.Get("prefix"),
+		MaxUploads:     maxUploads,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.getBucket(r)
+	if b == nil {
+		return
+	}
+	var uploads []*upload
+	for _, u := range b.uploads {
+		if !strings.HasPrefix(u.key, result.Prefix) {
+			continue
+		}
+		if u.key < result.KeyMarker || (u.key == result.KeyMarker && (result.UploadIDMarker == "" || u.id <= result.UploadIDMarker)) {
+			continue
+		}
+		uploads = append(uploads, u)
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].key != uploads[j].key {
+			return uploads[i].key < uploads[j].key
+		}
+		return uploads[i].id < uploads[j].id
+	})
+	if len(uploads) > maxUploads {
+		uploads = uploads[:maxUploads]
+		result.IsTruncated = true
+	}
+	for _, u := range uploads {
+		result.Uploads = append(result.Uploads, uploadInfo{
+			Key:          u.key,
+			UploadID:     u.id,
+			Initiator:    owner{ownerID, ownerID},
+			Owner:        owner{ownerID, ownerID},
+			StorageClass: u.object.storageClass(),
+			Initiated:    formatTime(u.initiated),
+		})
+	}
+	if result.IsTruncated {
+		last := uploads[len(uploads)-1]
+		result.NextKeyMarker = last.key
+		result.NextUploadIDMarker = last.id
+	}
+	r.writeXML(result)
+}
@@ -0,0 +1,589 @@
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// storedHeaders are the request headers stored with an object, and returned
+// when it is read.
+var storedHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"Expires",
+	"X-Amz-Server-Side-Encryption",
+	"X-Amz-Storage-Class",
+}
+
+const (
+	sseCustomerPrefix     = "X-Amz-Server-Side-Encryption-Customer-"
+	copySSECustomerPrefix = "X-Amz-Copy-Source-Server-Side-Encryption-Customer-"
+)
+
+type object struct {
+	data         []byte
+	etag         string
+	lastModified time.Time
+	header       http.Header
+	acl          string
+	tags         []tag
+	appendable   bool
+	sseAlgorithm string
+	sseKeyMD5    string
+}
+
+func (o *object) storageClass() string {
+	if class := o.header.Get("X-Amz-Storage-Class"); class != "" {
+		return class
+	}
+	return "STANDARD"
+}
+
+// PutObject stores an object, creating its bucket if it does not exist yet.
+func (s *Server) PutObject(bucketName, key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucketName]
+	if b == nil {
+		b = newBucket(bucketName, aclPrivate)
+		s.buckets[bucketName] = b
+	}
+	b.objects[key] = &object{
+		data:         append([]byte(nil), data...),
+		etag:         etag(data),
+		lastModified: time.Now(),
+		header:       http.Header{"Content-Type": {"application/octet-stream"}},
+	}
+}
+
+// Object returns the data of an object, and if it exists.
+func (s *Server) Object(bucketName, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b := s.buckets[bucketName]; b != nil {
+		if obj := b.objects[key]; obj != nil {
+			return append([]byte(nil), obj.data...), true
+		}
+	}
+	return nil, false
+}
+
+// getObject returns the object r addresses in b, or writes a NoSuchKey error.
+// It must be called with s.mu held.
+func (s *Server) getObject(r *request, b *bucket) *object {
+	obj := b.objects[r.key]
+	if obj == nil {
+		r.error("NoSuchKey", http.StatusNotFound, "the specified key does not exist")
+	}
+	return obj
+}
+
+func (s *Server) serveObject(r *request) {
+	switch r.Method {
+	case http.MethodPut:
+		switch {
+		case hasQuery(r.query, "partNumber") && hasQuery(r.query, "uploadId"):
+			s.uploadPart(r)
+		case hasQuery(r.query, "acl"):
+			s.serveACL(r, true)
+		case hasQuery(r.query, "tagging"):
+			s.serveTagging(r)
+		case r.Header.Get("X-Amz-Copy-Source") != "":
+			s.copyObject(r)
+		default:
+			s.putObject(r)
+		}
+		return
+	case http.MethodGet, http.MethodHead:
+		switch {
+		case hasQuery(r.query, "uploadId") && r.Method == http.MethodGet:
+			s.listParts(r)
+		case hasQuery(r.query, "acl"):
+			s.serveACL(r, false)
+		case hasQuery(r.query, "tagging"):
+			s.serveTagging(r)
+		default:
+			s.readObject(r)
+		}
+		return
+	case http.MethodDelete:
+		switch {
+		case hasQuery(r.query, "uploadId"):
+			s.abortMultipartUpload(r)
+		case hasQuery(r.query, "tagging"):
+			s.serveTagging(r)
+		default:
+			s.deleteObject(r)
+		}
+		return
+	case http.MethodPost:
+		switch {
+		case hasQuery(r.query, "uploads"):
+			s.createMultipartUpload(r)
+			return
+		case hasQuery(r.query, "uploadId"):
+			s.completeMultipartUpload(r)
+			return
+		case hasQuery(r.query, "append"):
+			s.appendObject(r)
+			return
+		}
+	}
+	r.error("NotImplemented", http.StatusNotImplemented, "the server does not implement this operation")
+}
+
+// newObject returns an object of data with the metadata, the ACL, the tags
+// and the SSE-C key of the headers of r.
+func newObject(r *request, data []byte) (*object, bool) {
+	obj := &object{
+		data:         data,
+		etag:         etag(data),
+		lastModified: time.Now(),
+		header:       http.Header{},
+	}
+	copyHeaders(obj.header, r.Header)
+	if obj.header.Get("Content-Type") == "" {
+		obj.header.Set("Content-Type", "application/octet-stream")
+	}
+
+	var ok bool
+	if obj.acl, ok = cannedACL(r); !ok {
+		return nil, false
+	}
+	if header := r.Header.Get("X-Amz-Tagging"); header != "" {
+		if obj.tags, ok = parseTagging(header); !ok {
+			r.error("InvalidArgument", http.StatusBadRequest, "the header x-amz-tagging is not valid")
+			return nil, false
+		}
+	}
+	if obj.sseAlgorithm, obj.sseKeyMD5, ok = customerKey(r, sseCustomerPrefix); !ok {
+		return nil, false
+	}
+	return obj, true
+}
+
+// copyHeaders copies the stored headers and the user metadata of src to dst.
+func copyHeaders(dst, src http.Header) {
+	for _, name := range storedHeaders {
+		if v := src.Get(name); v != "" {
+			dst.Set(name, v)
+		}
+	}
+	for name, v := range src {
+		if strings.HasPrefix(name, "X-Amz-Meta-") {
+			dst[name] = append([]string(nil), v...)
+		}
+	}
+}
+
+// customerKey returns the algorithm and the key MD5 of the SSE-C headers of r
+// starting with prefix. It writes an InvalidArgument error if the key does not
+// match its MD5.
+func customerKey(r *request, prefix string) (string, string, bool) {
+	algorithm := r.Header.Get(prefix + "Algorithm")
+	key := r.Header.Get(prefix + "Key")
+	keyMD5 := r.Header.Get(prefix + "Key-Md5")
+	if algorithm == "" && key == "" && keyMD5 == "" {
+		return "", "", true
+	}
+	raw, err := base64.StdEncoding.DecodeString(key)
+	sum := md5.Sum(raw)
+	if algorithm != "AES256" || err != nil || len(raw) != 32 || keyMD5 != base64.StdEncoding.EncodeToString(sum[:]) {
+		r.error("InvalidArgument", http.StatusBadRequest, "the SSE-C headers are not valid")
+		return "", "", false
+	}
+	return algorithm, keyMD5, true
+}
+
+// checkCustomerKey checks that r provides the SSE-C key obj was stored with,
+// in the headers starting with prefix.
+func checkCustomerKey(r *request, obj *object, prefix string) bool {
+	_, keyMD5, ok := customerKey(r, prefix)
+	switch {
+	case !ok:
+		return false
+	case obj.sseKeyMD5 == "":
+		return true
+	case keyMD5 == "":
+		r.error("InvalidRequest", http.StatusBadRequest, "the object was stored using SSE-C, the key must be provided to access it")
+		return false
+	case keyMD5 != obj.sseKeyMD5:
+		r.error("AccessDenied", http.StatusForbidden, "the provided SSE-C key does not match the key of the object")
+		return false
+	}
+	return true
+}
+
+// setSSEHeaders echoes the server side encryption of an object or a part.
+func setSSEHeaders(h http.Header, algorithm, keyMD5, sse string) {
+	if algorithm != "" {
+		h.Set(sseCustomerPrefix+"Algorithm", algorithm)
+		h.Set(sseCustomerPrefix+"Key-Md5", keyMD5)
+	}
+	if sse != "" {
+		h.Set("X-Amz-Server-Side-Encryption", sse)
+	}
+}
+
+func (s *Server) putObject(r *request) {
+	data, ok := r.readBody()
+	if !ok {
+		return
+	}
+	obj, ok := newObject(r, data)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	b := s.getBucket(r)
+	if b != nil {
+		b.objects[r.key] = obj
+	}
+	s.mu.Unlock()
+	if b == nil {
+		return
+	}
+
+	h := r.w.Header()
+	h.Set("ETag", obj.etag)
+	h.Set("X-Amz-Checksum-Crc64ecma", checksum(data))
+	setSSEHeaders(h, obj.sseAlgorithm, obj.sseKeyMD5, obj.header.Get("X-Amz-Server-Side-Encryption"))
+	r.w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteObject(r *request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.getBucket(r)
+	if b == nil {
+		return
+	}
+	delete(b.objects, r.key)
+	r.noContent()
+}
+
+// responseOverrides are the query parameters overriding the headers of a
+// GetObject response.
+var responseOverrides = map[string]string{
+	"response-cache-control":       "Cache-Control",
+	"response-content-disposition": "Content-Disposition",
+	"response-content-encoding":    "Content-Encoding",
+	"response-content-language":    "Content-Language",
+	"response-content-type":        "Content-Type",
+	"response-expires":             "Expires",
+}
+
+// readObject serves GetObject and HeadObject, with a range and conditions.
+func (s *Server) readObject(r *request) {
+	s.mu.Lock()
+	b := s.getBucket(r)
+	var obj *object
+	if b != nil {
+		obj = s.getObject(r, b)
+	}
+	var o object
+	if obj != nil {
+		o = *obj
+	}
+	s.mu.Unlock()
+	if obj == nil || !checkCustomerKey(r, &o, sseCustomerPrefix) {
+		return
+	}
+
+	if status := checkConditions(r.Header, "", o.etag, o.lastModified); status != 0 {
+		if status == http.StatusNotModified {
+			r.w.Header().Set("ETag", o.etag)
+			r.w.WriteHeader(status)
+			return
+		}
+		r.error("PreconditionFailed", status, "at least one of the preconditions you specified did not hold")
+		return
+	}
+
+	h := r.w.Header()
+	for name, v := range o.header {
+		h[name] = v
+	}
+	for param, name := range responseOverrides {
+		if v := r.query.Get(param); v != "" {
+			h.Set(name, v)
+		}
+	}
+	h.Set("ETag", o.etag)
+	h.Set("Last-Modified", o.lastModified.UTC().Format(http.TimeFormat))
+	h.Set("Accept-Ranges", "bytes")
+	h.Set("X-Amz-Checksum-Crc64ecma", checksum(o.data))
+	if o.appendable {
+		h.Set("X-Amz-Object-Type", "Appendable")
+		h.Set("X-Amz-Next-Append-Position", strconv.Itoa(len(o.data)))
+	}
+	if len(o.tags) > 0 {
+		h.Set("X-Amz-Tagging-Count", strconv.Itoa(len(o.tags)))
+	}
+	setSSEHeaders(h, o.sseAlgorithm, o.sseKeyMD5, "")
+
+	data := o.data
+	status := http.StatusOK
+	if spec := r.Header.Get("Range"); spec != "" {
+		start, end, ok := parseRange(spec, int64(len(o.data)))
+		if !ok {
+			h.Set("Content-Range", "bytes */"+strconv.Itoa(len(o.data)))
+			r.error("InvalidRange", http.StatusRequestedRangeNotSatisfiable, "the requested range is not satisfiable")
+			return
+		}
+		if start >= 0 {
+			data = o.data[start : end+1]
+			status = http.StatusPartialContent
+			h.Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end, 10)+"/"+strconv.Itoa(len(o.data)))
+		}
+	}
+	h.Set("Content-Length", strconv.Itoa(len(data)))
+	r.w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		r.w.Write(data)
+	}
+}
+
+// checkConditions returns the status of a request whose conditional headers,
+// with the given prefix, do not hold for an object of the ETag and the
+// modification time, or 0.
+func checkConditions(h http.Header, prefix, etag string, modified time.Time) int {
+	failed := http.StatusPreconditionFailed
+	notModified := http.StatusNotModified
+	if prefix != "" {
+		notModified = failed
+	}
+	modified = modified.Truncate(time.Second)
+	if v := h.Get(prefix + "If-Match"); v != "" && !etagMatch(v, etag) {
+		return failed
+	}
+	if v := h.Get(prefix + "If-Unmodified-Since"); v != "" && h.Get(prefix+"If-Match") == "" {
+		if t, err := http.ParseTime(v); err == nil && modified.After(t) {
+			return failed
+		}
+	}
+	if v := h.Get(prefix + "If-None-Match"); v != "" {
+		if etagMatch(v, etag) {
+			return notModified
+		}
+	} else if v := h.Get(prefix + "If-Modified-Since"); v != "" {
+		if t, err := http.ParseTime(v); err == nil && !modified.After(t) {
+			return notModified
+		}
+	}
+	return 0
+}
+
+// etagMatch returns if the ETag is one of the list of ETags of a conditional
+// header.
+func etagMatch(list, etag string) bool {
+	for _, v := range strings.Split(list, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.Trim(v, `"`) == strings.Trim(etag, `"`) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRange parses a Range header of a single range, and returns the first
+// and the last byte it selects in an object of the given size. It returns a
+// negative start for the syntactically invalid ranges, which are ignored the
+// way KS3 does, and false for the unsatisfiable ones.
+func parseRange(spec string, size int64) (int64, int64, bool) {
+	if !strings.HasPrefix(spec, "bytes=") || strings.Contains(spec, ",") {
+		return -1, -1, true
+	}
+	spec = strings.TrimSpace(strings.TrimPrefix(spec, "bytes="))
+	i := strings.Index(spec, "-")
+	if i < 0 {
+		return -1, -1, true
+	}
+	first, last := spec[:i], spec[i+1:]
+	if first == "" {
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil {
+			return -1, -1, true
+		}
+		if n == 0 || size == 0 {
+			return 0, 0, false
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, size - 1, true
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return -1, -1, true
+	}
+	end := size - 1
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return -1, -1, true
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	if start >= size {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// copySource returns the source object of a copy, which the conditions and
+// the SSE-C key of the copy apply to. It must be called with s.mu held.
+func (s *Server) copySource(r *request) (*object, bool) {
+	source := strings.TrimPrefix(r.Header.Get("X-Amz-Copy-Source"), "/")
+	i := strings.Index(source, "/")
+	if i < 0 {
+		r.error("InvalidArgument", http.StatusBadRequest, "the copy source must be /bucket/key")
+		return nil, false
+	}
+	key, err := url.QueryUnescape(source[i+1:])
+	if err != nil {
+		r.error("InvalidArgument", http.StatusBadRequest, "the copy source is not valid")
+		return nil, false
+	}
+	b := s.buckets[source[:i]]
+	if b == nil {
+		r.error("NoSuchBucket", http.StatusNotFound, "the source bucket does not exist")
+		return nil, false
+	}
+	obj := b.objects[key]
+	if obj == nil {
+		r.error("NoSuchKey", http.StatusNotFound, "the source key does not exist")
+		return nil, false
+	}
+	if status := checkConditions(r.Header, "X-Amz-Copy-Source-", obj.etag, obj.lastModified); status != 0 {
+		r.error("PreconditionFailed", status, "at least one of the preconditions you specified did not hold")
+		return nil, false
+	}
+	if !checkCustomerKey(r, obj, copySSECustomerPrefix) {
+		return nil, false
+	}
+	return obj, true
+}
+
+type copyObjectResult struct {
+	XMLName           xml.Name `xml:"CopyObjectResult"`
+	LastModified      string
+	ETag              string
+	ChecksumCRC64ECMA string
+}
+
+func (s *Server) copyObject(r *request) {
+	if _, ok := r.readBody(); !ok {
+		return
+	}
+	dst, ok := newObject(r, nil)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	b := s.getBucket(r)
+	if b == nil {
+		s.mu.Unlock()
+		return
+	}
+	src, ok := s.copySource(r)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	dst.data = src.data
+	dst.etag = src.etag
+	dst.appendable = false
+	if r.Header.Get("X-Amz-Metadata-Directive") != "REPLACE" {
+		dst.header = http.Header{}
+		copyHeaders(dst.header, src.header)
+	}
+	if r.Header.Get("X-Amz-Tagging-Directive") != "REPLACE" {
+		dst.tags = append([]tag(nil), src.tags...)
+	}
+	b.objects[r.key] = dst
+	s.mu.Unlock()
+
+	crc := checksum(dst.data)
+	r.w.Header().Set("X-Amz-Checksum-Crc64ecma", crc)
+	setSSEHeaders(r.w.Header(), dst.sseAlgorithm, dst.sseKeyMD5, dst.header.Get("X-Amz-Server-Side-Encryption"))
+	r.writeXML(copyObjectResult{
+		LastModified:      formatTime(dst.lastModified),
+		ETag:              dst.etag,
+		ChecksumCRC64ECMA: crc,
+	})
+}
+
+func (s *Server) appendObject(r *request) {
+	position, err := strconv.ParseInt(r.query.Get("position"), 10, 64)
+	if err != nil || position < 0 {
+		r.error("InvalidArgument", http.StatusBadRequest, "the position is not valid")
+		return
+	}
+	data, ok := r.readBody()
+	if !ok {
+		return
+	}
+	created, ok := newObject(r, data)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	b := s.getBucket(r)
+	if b == nil {
+		s.mu.Unlock()
+		return
+	}
+	obj := b.objects[r.key]
+	switch {
+	case obj == nil && position != 0,
+		obj != nil && obj.appendable && position != int64(len(obj.data)):
+		s.mu.Unlock()
+		r.w.Header().Set("X-Amz-Next-Append-Position", strconv.Itoa(len(objectData(obj))))
+		r.error("PositionNotEqualToLength", http.StatusConflict, "the position is not equal to the length of the object")
+		return
+	case obj != nil && !obj.appendable:
+		s.mu.Unlock()
+		r.error("ObjectNotAppendable", http.StatusConflict, "the object is not appendable")
+		return
+	case obj == nil:
+		obj = created
+		obj.appendable = true
+		b.objects[r.key] = obj
+	default:
+		all := make([]byte, 0, len(obj.data)+len(data))
+		obj.data = append(append(all, obj.data...), data...)
+		obj.etag = etag(obj.data)
+		obj.lastModified = time.Now()
+	}
+	o := *obj
+	s.mu.Unlock()
+
+	h := r.w.Header()
+	h.Set("ETag", o.etag)
+	h.Set("X-Amz-Next-Append-Position", strconv.Itoa(len(o.data)))
+	h.Set("X-Amz-Object-Type", "Appendable")
+	h.Set("X-Amz-Checksum-Crc64ecma", checksum(o.data))
+	setSSEHeaders(h, o.sseAlgorithm, o.sseKeyMD5, o.header.Get("X-Amz-Server-Side-Encryption"))
+	r.w.WriteHeader(http.StatusOK)
+}
+
+func objectData(obj *object) []byte {
+	if obj == nil {
+		return nil
+	}
+	return obj.data
+}
@@ -0,0 +1,465 @@
+// Package s3test provides an in-memory KS3 server for testing code which uses
+// the s3 client, without network access or an account.
+//
+// The server implements the part of the KS3 REST API the SDK speaks most:
+// buckets, objects with ranges and conditional requests, ListObjects and
+// ListObjectsV2, multipart uploads, copies, appendable objects, tagging and
+// canned ACLs. It returns the CRC64 checksums KS3 returns, echoes the SSE-C
+// headers, and checks the V2 and V4 signatures of the requests.
+//
+// Example:
+//
+//	server := s3test.NewServer(s3test.Config{})
+//	defer server.Close()
+//	server.CreateBucket("bucket")
+//	client := s3.New(server.ClientConfig())
+package s3test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/crc64"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/aws/verifier"
+)
+
+// Default credentials and region of the server.
+const (
+	DefaultAccessKeyID     = "AKID"
+	DefaultSecretAccessKey = "SECRET"
+	DefaultRegion          = "BEIJING"
+)
+
+// ownerID is the ID and display name of the owner of every bucket.
+const ownerID = "s3test"
+
+// Config 内存服务配置
+type Config struct {
+	AccessKeyID           string // 请求使用的AccessKeyID，默认为DefaultAccessKeyID
+	SecretAccessKey       string // 请求使用的SecretAccessKey，默认为DefaultSecretAccessKey
+	Region                string // 地域，默认为DefaultRegion
+	DisableSignatureCheck bool   // 不校验请求的签名，默认为false
+	MinPartSize           int64  // 合并分块时除最后一块外分块的最小大小，为0时不限制
+}
+
+// A Fault describes an error injected into the requests it matches, before
+// they are handled.
+type Fault struct {
+	Match           func(r *http.Request) bool // 匹配注入的请求，为空时匹配所有请求
+	Count           int                        // 注入的次数，为0时不限次数
+	Delay           time.Duration              // 处理请求前等待的时长
+	StatusCode      int                        // 返回错误的状态码，为0时仅等待Delay或按CloseConnection、Truncate处理
+	Code            string                     // 返回的错误码，默认为InternalError
+	CloseConnection bool                       // 不返回响应直接关闭连接
+	Truncate        bool                       // 正常处理请求，但响应体只返回一半后关闭连接
+}
+
+// A Server is an in-memory KS3 server listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	cfg      Config
+	host     string
+	verifier *verifier.Verifier
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	faults    []*Fault
+	requestID int64
+	uploadID  int64
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer(cfg Config) *Server {
+	if cfg.AccessKeyID == "" {
+		cfg.AccessKeyID = DefaultAccessKeyID
+	}
+	if cfg.SecretAccessKey == "" {
+		cfg.SecretAccessKey = DefaultSecretAccessKey
+	}
+	if cfg.Region == "" {
+		cfg.Region = DefaultRegion
+	}
+
+	s := &Server{
+		cfg:     cfg,
+		buckets: map[string]*bucket{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.host = strings.TrimPrefix(s.URL, "http://")
+	if host, _, err := net.SplitHostPort(s.host); err == nil {
+		s.host = host
+	}
+	s.verifier = verifier.New(s.lookup, verifier.Config{Endpoints: []string{s.host}})
+	return s
+}
+
+// ClientConfig returns the configuration of a client sending its requests to
+// the server with the credentials of the server.
+func (s *Server) ClientConfig() *aws.Config {
+	return &aws.Config{
+		Region:           s.cfg.Region,
+		Endpoint:         s.URL,
+		S3ForcePathStyle: true,
+		Credentials:      credentials.NewStaticCredentials(s.cfg.AccessKeyID, s.cfg.SecretAccessKey, ""),
+	}
+}
+
+// InjectFault adds a fault injected into the requests it matches. The faults
+// are matched in the order they were added.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes the faults which were not used up.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+func (s *Server) lookup(accessKeyID string) (credentials.Value, error) {
+	if accessKeyID != s.cfg.AccessKeyID {
+		return credentials.Value{}, errors.New("unknown access key ID")
+	}
+	return credentials.Value{AccessKeyID: s.cfg.AccessKeyID, SecretAccessKey: s.cfg.SecretAccessKey}, nil
+}
+
+// takeFault returns the first fault matching r, and uses it up once.
+func (s *Server) takeFault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.faults {
+		if f.Match != nil && !f.Match(r) {
+			continue
+		}
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		fault := *f
+		return &fault
+	}
+	return nil
+}
+
+// A request is a request being handled, with the bucket and the key it
+// addresses.
+type request struct {
+	*http.Request
+	w      http.ResponseWriter
+	id     string
+	bucket string
+	key    string
+	query  url.Values
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requestID++
+	id := fmt.Sprintf("%016x", s.requestID)
+	s.mu.Unlock()
+	w.Header().Set("X-Kss-Request-Id", id)
+
+	if f := s.takeFault(r); f != nil {
+		if f.Delay > 0 {
+			select {
+			case <-time.After(f.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		switch {
+		case f.CloseConnection:
+			closeConnection(w)
+			return
+		case f.StatusCode != 0:
+			code := f.Code
+			if code == "" {
+				code = "InternalError"
+			}
+			req := &request{Request: r, w: w, id: id}
+			req.bucket, req.key = s.route(r)
+			req.error(code, f.StatusCode, "injected fault")
+			return
+		case f.Truncate:
+			tw := &truncateWriter{ResponseWriter: w}
+			defer tw.abort()
+			w = tw
+		}
+	}
+
+	req := &request{Request: r, w: w, id: id, query: r.URL.Query()}
+	req.bucket, req.key = s.route(r)
+	if !s.authenticate(req) {
+		return
+	}
+
+	switch {
+	case req.bucket == "":
+		if r.Method != http.MethodGet {
+			req.error("MethodNotAllowed", http.StatusMethodNotAllowed, "the specified method is not allowed against this resource")
+			return
+		}
+		s.listBuckets(req)
+	case req.key == "":
+		s.serveBucket(req)
+	default:
+		s.serveObject(req)
+	}
+}
+
+// route returns the bucket and the key r addresses, in the host or in the
+// path.
+func (s *Server) route(r *http.Request) (string, string) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if strings.HasSuffix(host, "."+s.host) {
+		return strings.TrimSuffix(host, "."+s.host), path
+	}
+	i := strings.Index(path, "/")
+	if i < 0 {
+		return path, ""
+	}
+	return path[:i], path[i+1:]
+}
+
+// authenticate checks the signature of a signed request, and the ACL of the
+// resource an anonymous request is made on. It writes the error response of
+// the requests it rejects.
+func (s *Server) authenticate(r *request) bool {
+	if s.cfg.DisableSignatureCheck {
+		return true
+	}
+	if verifier.Signed(r.Request) {
+		if _, err := s.verifier.Verify(r.Request); err != nil {
+			r.fail(err)
+			return false
+		}
+		return true
+	}
+	if s.allowAnonymous(r) {
+		return true
+	}
+	r.error("AccessDenied", http.StatusForbidden, "anonymous access is forbidden for this operation")
+	return false
+}
+
+// allowAnonymous returns if the canned ACLs of the bucket and the object
+// addressed by r let anyone make r.
+func (s *Server) allowAnonymous(r *request) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[r.bucket]
+	if b == nil || r.key == "" || len(r.query) > 0 {
+		return false
+	}
+	acl := b.acl
+	if obj := b.objects[r.key]; obj != nil && obj.acl != "" {
+		acl = obj.acl
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return acl == aclPublicRead || acl == aclPublicReadWrite
+	case http.MethodPut, http.MethodDelete:
+		return b.acl == aclPublicReadWrite
+	}
+	return false
+}
+
+// readBody reads the body of r. It writes the error response of the bodies
+// whose signature or Content-MD5 does not match.
+func (r *request) readBody() ([]byte, bool) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		var aerr awserr.Error
+		if !errors.As(err, &aerr) {
+			aerr = awserr.New("IncompleteBody", err.Error(), nil)
+		}
+		status := http.StatusBadRequest
+		if aerr.Code() == "SignatureDoesNotMatch" {
+			status = http.StatusForbidden
+		}
+		r.error(aerr.Code(), status, aerr.Message())
+		return nil, false
+	}
+	if md5sum := r.Header.Get("Content-MD5"); md5sum != "" {
+		sum := md5.Sum(data)
+		if md5sum != base64.StdEncoding.EncodeToString(sum[:]) {
+			r.error("InvalidDigest", http.StatusBadRequest, "the Content-MD5 you specified did not match what we received")
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+// readXML reads the body of r into v.
+func (r *request) readXML(v interface{}) bool {
+	data, ok := r.readBody()
+	if !ok {
+		return false
+	}
+	if err := xml.Unmarshal(data, v); err != nil {
+		r.error("MalformedXML", http.StatusBadRequest, "the XML you provided was not well-formed")
+		return false
+	}
+	return true
+}
+
+// writeXML writes v as the body of a successful response.
+func (r *request) writeXML(v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		r.error("InternalError", http.StatusInternalServerError, err.Error())
+		return
+	}
+	r.w.Header().Set("Content-Type", "application/xml")
+	r.w.Header().Set("Content-Length", strconv.Itoa(len(xml.Header)+len(data)))
+	r.w.WriteHeader(http.StatusOK)
+	io.WriteString(r.w, xml.Header)
+	r.w.Write(data)
+}
+
+// noContent writes a successful response without body.
+func (r *request) noContent() {
+	r.w.WriteHeader(http.StatusNoContent)
+}
+
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string
+	Message   string
+	Resource  string
+	RequestID string `xml:"RequestId"`
+	HostID    string `xml:"HostId"`
+}
+
+// error writes an error response. The responses to HEAD requests have no
+// body.
+func (r *request) error(code string, statusCode int, message string) {
+	if r.Method == http.MethodHead {
+		r.w.WriteHeader(statusCode)
+		return
+	}
+	resource := "/" + r.bucket
+	if r.key != "" {
+		resource += "/" + r.key
+	}
+	data, _ := xml.Marshal(errorResponse{
+		Code:      code,
+		Message:   message,
+		Resource:  resource,
+		RequestID: r.id,
+		HostID:    ownerID,
+	})
+	r.w.Header().Set("Content-Type", "application/xml")
+	r.w.Header().Set("Content-Length", strconv.Itoa(len(xml.Header)+len(data)))
+	r.w.WriteHeader(statusCode)
+	io.WriteString(r.w, xml.Header)
+	r.w.Write(data)
+}
+
+// fail writes the error response of an awserr.RequestFailure.
+func (r *request) fail(err error) {
+	var failure awserr.RequestFailure
+	if errors.As(err, &failure) {
+		r.error(failure.Code(), failure.StatusCode(), failure.Message())
+		return
+	}
+	r.error("InternalError", http.StatusInternalServerError, err.Error())
+}
+
+// closeConnection closes the connection of a request without responding.
+func closeConnection(w http.ResponseWriter) {
+	if hj, ok := w.(http.Hijacker); ok {
+		if conn, _, err := hj.Hijack(); err == nil {
+			conn.Close()
+			return
+		}
+	}
+	panic(http.ErrAbortHandler)
+}
+
+// A truncateWriter writes the first half of the body of a response, and
+// aborts the connection once the handler returns.
+type truncateWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+	limit       int64
+	truncated   bool
+}
+
+func (w *truncateWriter) WriteHeader(statusCode int) {
+	length, _ := strconv.ParseInt(w.Header().Get("Content-Length"), 10, 64)
+	w.limit = length / 2
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *truncateWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	n := len(p)
+	if int64(len(p)) > w.limit {
+		p = p[:w.limit]
+		w.truncated = true
+	}
+	w.limit -= int64(len(p))
+	if _, err := w.ResponseWriter.Write(p); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (w *truncateWriter) abort() {
+	if w.truncated {
+		if f, ok := w.ResponseWriter.(http.Flusher); ok {
+			f.Flush()
+		}
+		panic(http.ErrAbortHandler)
+	}
+}
+
+var crcTable = crc64.MakeTable(crc64.ECMA)
+
+// checksum returns the CRC64 of data, formatted the way KS3 returns it.
+func checksum(data []byte) string {
+	return strconv.FormatUint(crc64.Checksum(data, crcTable), 10)
+}
+
+// etag returns the quoted MD5 of data.
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// formatTime formats t the way the timestamps of XML bodies are.
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
@@ -0,0 +1,592 @@
+package s3test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/awserr"
+	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
+	"github.com/ks3sdklib/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClient(server *Server, signerVersion string) *s3.S3 {
+	cfg := server.ClientConfig()
+	cfg.SignerVersion = signerVersion
+	cfg.CrcCheckEnabled = true
+	cfg.MaxRetries = 1
+	return s3.New(cfg)
+}
+
+func assertCode(t *testing.T, err error, code awserr.ErrorCode, statusCode int) {
+	if assert.True(t, errors.Is(err, code), "%v", err) {
+		var reqErr *awserr.RequestError
+		if assert.True(t, errors.As(err, &reqErr)) {
+			assert.Equal(t, statusCode, reqErr.StatusCode())
+		}
+	}
+}
+
+func TestObject(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+
+	for _, signer := range []string{"V2", "V4"} {
+		client := newClient(server, signer)
+		bucket := aws.String("bucket-" + strings.ToLower(signer))
+		_, err := client.CreateBucket(&s3.CreateBucketInput{Bucket: bucket})
+		assert.NoError(t, err, signer)
+
+		put, err := client.PutObject(&s3.PutObjectInput{
+			Bucket:      bucket,
+			Key:         aws.String("dir/hello world.txt"),
+			Body:        bytes.NewReader([]byte("hello, world")),
+			ContentType: aws.String("text/plain"),
+			Metadata:    map[string]*string{"Owner": aws.String("alice")},
+		})
+		if assert.NoError(t, err, signer) {
+			assert.Equal(t, `"e4d7f1b4ed2e42d15898f4b27b019da4"`, aws.ToString(put.ETag))
+		}
+
+		get, err := client.GetObject(&s3.GetObjectInput{
+			Bucket: bucket,
+			Key:    aws.String("dir/hello world.txt"),
+			Range:  aws.String("bytes=7-"),
+		})
+		if assert.NoError(t, err, signer) {
+			data, _ := ioutil.ReadAll(get.Body)
+			get.Body.Close()
+			assert.Equal(t, "world", string(data))
+			assert.Equal(t, "bytes 7-11/12", aws.ToString(get.ContentRange))
+			assert.Equal(t, "text/plain", aws.ToString(get.ContentType))
+			assert.Equal(t, "alice", aws.ToString(get.Metadata["X-Amz-Meta-Owner"]))
+		}
+
+		_, err = client.GetObject(&s3.GetObjectInput{
+			Bucket: bucket,
+			Key:    aws.String("dir/hello world.txt"),
+			Range:  aws.String("bytes=100-"),
+		})
+		assertCode(t, err, s3.ErrInvalidRange, http.StatusRequestedRangeNotSatisfiable)
+
+		_, err = client.GetObject(&s3.GetObjectInput{
+			Bucket:  bucket,
+			Key:     aws.String("dir/hello world.txt"),
+			IfMatch: aws.String(`"0123"`),
+		})
+		assertCode(t, err, s3.ErrPreconditionFailed, http.StatusPreconditionFailed)
+
+		_, err = client.DeleteObject(&s3.DeleteObjectInput{Bucket: bucket, Key: aws.String("dir/hello world.txt")})
+		assert.NoError(t, err, signer)
+		_, err = client.HeadObject(&s3.HeadObjectInput{Bucket: bucket, Key: aws.String("dir/hello world.txt")})
+		assertCode(t, err, s3.ErrNoSuchKey, http.StatusNotFound)
+		_, err = client.GetObject(&s3.GetObjectInput{Bucket: bucket, Key: aws.String("dir/hello world.txt")})
+		assertCode(t, err, s3.ErrNoSuchKey, http.StatusNotFound)
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	for _, key := range []string{"a", "b/1", "b/2", "c", "d/1", "e"} {
+		server.PutObject("bucket", key, []byte(key))
+	}
+	client := newClient(server, "V4")
+
+	var keys, prefixes []string
+	p := client.NewListObjectsPaginator(&s3.ListObjectsInput{
+		Bucket:    aws.String("bucket"),
+		Delimiter: aws.String("/"),
+	}, aws.WithPageSize(2))
+	for p.HasMorePages() {
+		page, err := p.Next(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+		for _, prefix := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.ToString(prefix.Prefix))
+		}
+	}
+	assert.Equal(t, []string{"a", "c", "e"}, keys)
+	assert.Equal(t, []string{"b/", "d/"}, prefixes)
+
+	keys = nil
+	p2 := client.NewListObjectsV2Paginator(&s3.ListObjectsV2Input{
+		Bucket: aws.String("bucket"),
+		Prefix: aws.String("b/"),
+	}, aws.WithPageSize(1))
+	for p2.HasMorePages() {
+		page, err := p2.Next(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, int64(1), aws.ToLong(page.KeyCount))
+		keys = append(keys, aws.ToString(page.Contents[0].Key))
+	}
+	assert.Equal(t, []string{"b/1", "b/2"}, keys)
+
+	_, err := client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("bucket")})
+	assertCode(t, err, s3.ErrBucketNotEmpty, http.StatusConflict)
+	_, err = client.ListObjects(&s3.ListObjectsInput{Bucket: aws.String("missing")})
+	assertCode(t, err, s3.ErrNoSuchBucket, http.StatusNotFound)
+}
+
+func TestMultipartUpload(t *testing.T) {
+	server := NewServer(Config{MinPartSize: s3.MinPartSize})
+	defer server.Close()
+	server.CreateBucket("bucket")
+	client := newClient(server, "V4")
+
+	data := bytes.Repeat([]byte("0123456789"), 25*1024)
+	file := filepath.Join(t.TempDir(), "data")
+	assert.NoError(t, ioutil.WriteFile(file, data, 0644))
+
+	_, err := client.UploadFile(&s3.UploadFileInput{
+		Bucket:           aws.String("bucket"),
+		Key:              aws.String("big"),
+		UploadFile:       aws.String(file),
+		PartSize:         aws.Long(s3.MinPartSize),
+		EnableCheckpoint: aws.Boolean(true),
+		CheckpointDir:    aws.String(t.TempDir()),
+	})
+	assert.NoError(t, err)
+	stored, ok := server.Object("bucket", "big")
+	assert.True(t, ok)
+	assert.Equal(t, data, stored)
+
+	download := filepath.Join(t.TempDir(), "download")
+	_, err = client.DownloadFile(&s3.DownloadFileInput{
+		Bucket:       aws.String("bucket"),
+		Key:          aws.String("big"),
+		DownloadFile: aws.String(download),
+		PartSize:     aws.Long(s3.MinPartSize),
+	})
+	assert.NoError(t, err)
+	downloaded, _ := ioutil.ReadFile(download)
+	assert.Equal(t, data, downloaded)
+
+	_, err = client.CopyFile(&s3.CopyFileInput{
+		Bucket:       aws.String("bucket"),
+		Key:          aws.String("copy"),
+		SourceBucket: aws.String("bucket"),
+		SourceKey:    aws.String("big"),
+		PartSize:     aws.Long(s3.MinPartSize),
+	})
+	assert.NoError(t, err)
+	copied, _ := server.Object("bucket", "copy")
+	assert.Equal(t, data, copied)
+
+	create, err := client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("parts"),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	var parts []*s3.CompletedPart
+	for i := int64(1); i <= 2; i++ {
+		part, err := client.UploadPart(&s3.UploadPartInput{
+			Bucket:     aws.String("bucket"),
+			Key:        aws.String("parts"),
+			UploadID:   create.UploadID,
+			PartNumber: aws.Long(i),
+			Body:       bytes.NewReader([]byte("small")),
+		})
+		assert.NoError(t, err)
+		parts = append(parts, &s3.CompletedPart{PartNumber: aws.Long(i), ETag: part.ETag})
+	}
+	listed, err := client.ListParts(&s3.ListPartsInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("parts"),
+		UploadID: create.UploadID,
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, listed.Parts, 2)
+	}
+	_, err = client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String("bucket"),
+		Key:             aws.String("parts"),
+		UploadID:        create.UploadID,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	assertCode(t, err, s3.ErrEntityTooSmall, http.StatusBadRequest)
+
+	_, err = client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("parts"),
+		UploadID: create.UploadID,
+	})
+	assert.NoError(t, err)
+	_, err = client.ListParts(&s3.ListPartsInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("parts"),
+		UploadID: create.UploadID,
+	})
+	assertCode(t, err, s3.ErrNoSuchUpload, http.StatusNotFound)
+}
+
+// countingFetcher fetches the parts of data, counting the bytes read from them.
+type countingFetcher struct {
+	data []byte
+	mu   sync.Mutex
+	read int
+}
+
+func (f *countingFetcher) Fetch(objectRange []int64) (io.ReadSeeker, error) {
+	return &countingPart{ReadSeeker: bytes.NewReader(f.data[objectRange[0] : objectRange[1]+1]), f: f}, nil
+}
+
+type countingPart struct {
+	io.ReadSeeker
+	f *countingFetcher
+}
+
+func (p *countingPart) Read(b []byte) (int, error) {
+	n, err := p.ReadSeeker.Read(b)
+	p.f.mu.Lock()
+	p.f.read += n
+	p.f.mu.Unlock()
+	return n, err
+}
+
+func TestUploadFileReadsPartsOnce(t *testing.T) {
+	server := NewServer(Config{MinPartSize: s3.MinPartSize})
+	defer server.Close()
+	server.CreateBucket("bucket")
+	client := newClient(server, "V4")
+
+	data := bytes.Repeat([]byte("0123456789"), 25*1024)
+	fetcher := &countingFetcher{data: data}
+	var partFetcher s3.FilePartFetcher = fetcher
+	_, err := client.UploadFile(&s3.UploadFileInput{
+		Bucket:          aws.String("bucket"),
+		Key:             aws.String("big"),
+		FilePartFetcher: &partFetcher,
+		FileSize:        aws.Long(int64(len(data))),
+		PartSize:        aws.Long(s3.MinPartSize),
+	})
+	assert.NoError(t, err)
+	stored, _ := server.Object("bucket", "big")
+	assert.Equal(t, data, stored)
+	assert.Equal(t, len(data), fetcher.read, "the parts are hashed and sent from a single read")
+}
+
+func TestAppendTaggingACL(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.CreateBucket("bucket")
+	client := newClient(server, "V2")
+
+	var position int64
+	for _, data := range []string{"hello", ", world"} {
+		out, err := client.AppendObject(&s3.AppendObjectInput{
+			Bucket:   aws.String("bucket"),
+			Key:      aws.String("log"),
+			Position: aws.Long(position),
+			Body:     strings.NewReader(data),
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		position = aws.ToLong(out.NextAppendPosition)
+	}
+	assert.Equal(t, int64(12), position)
+	stored, _ := server.Object("bucket", "log")
+	assert.Equal(t, "hello, world", string(stored))
+	_, err := client.AppendObject(&s3.AppendObjectInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("log"),
+		Position: aws.Long(5),
+		Body:     strings.NewReader("!"),
+	})
+	var reqErr *awserr.RequestError
+	if assert.True(t, errors.As(err, &reqErr)) {
+		assert.Equal(t, "PositionNotEqualToLength", reqErr.Code())
+	}
+
+	_, err = client.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("log"),
+		Tagging: &s3.Tagging{TagSet: []*s3.Tag{
+			{Key: aws.String("env"), Value: aws.String("test")},
+		}},
+	})
+	assert.NoError(t, err)
+	tags, err := client.GetObjectTagging(&s3.GetObjectTaggingInput{Bucket: aws.String("bucket"), Key: aws.String("log")})
+	if assert.NoError(t, err) && assert.Len(t, tags.Tagging.TagSet, 1) {
+		assert.Equal(t, "env", aws.ToString(tags.Tagging.TagSet[0].Key))
+		assert.Equal(t, "test", aws.ToString(tags.Tagging.TagSet[0].Value))
+	}
+
+	anonymous := s3.New(&aws.Config{
+		Region:           DefaultRegion,
+		Endpoint:         server.URL,
+		S3ForcePathStyle: true,
+		Credentials:      credentials.AnonymousCredentials,
+	})
+	_, err = anonymous.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("log")})
+	assertCode(t, err, s3.ErrAccessDenied, http.StatusForbidden)
+
+	_, err = client.PutObjectACL(&s3.PutObjectACLInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("log"),
+		ACL:    aws.String(s3.ACLPublicRead),
+	})
+	assert.NoError(t, err)
+	acl, err := client.GetObjectACL(&s3.GetObjectACLInput{Bucket: aws.String("bucket"), Key: aws.String("log")})
+	if assert.NoError(t, err) {
+		assert.Equal(t, s3.ACLPublicRead, s3.GetCannedACL(acl.Grants))
+	}
+	_, err = anonymous.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("log")})
+	assert.NoError(t, err)
+}
+
+func TestSSECustomerKey(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.CreateBucket("bucket")
+	client := newClient(server, "V4")
+
+	key := bytes.Repeat([]byte("k"), 32)
+	sum := md5.Sum(key)
+	keyMD5 := base64.StdEncoding.EncodeToString(sum[:])
+	put, err := client.PutObject(&s3.PutObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("secret"),
+		Body:                 strings.NewReader("data"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String(base64.StdEncoding.EncodeToString(key)),
+		SSECustomerKeyMD5:    aws.String(keyMD5),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, keyMD5, aws.ToString(put.SSECustomerKeyMD5))
+	}
+
+	_, err = client.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("secret")})
+	assertCode(t, err, awserr.ErrorCode("InvalidRequest"), http.StatusBadRequest)
+	get, err := client.GetObject(&s3.GetObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("secret"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String(base64.StdEncoding.EncodeToString(key)),
+		SSECustomerKeyMD5:    aws.String(keyMD5),
+	})
+	if assert.NoError(t, err) {
+		get.Body.Close()
+		assert.Equal(t, "AES256", aws.ToString(get.SSECustomerAlgorithm))
+		assert.Equal(t, keyMD5, aws.ToString(get.SSECustomerKeyMD5))
+	}
+}
+
+func TestSignature(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.CreateBucket("bucket")
+
+	for _, signer := range []string{"V2", "V4"} {
+		cfg := server.ClientConfig()
+		cfg.SignerVersion = signer
+		cfg.Credentials = credentials.NewStaticCredentials(DefaultAccessKeyID, "WRONG", "")
+		_, err := s3.New(cfg).PutObject(&s3.PutObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   strings.NewReader("data"),
+		})
+		assertCode(t, err, s3.ErrSignatureDoesNotMatch, http.StatusForbidden)
+
+		cfg.Credentials = credentials.NewStaticCredentials("OTHER", DefaultSecretAccessKey, "")
+		_, err = s3.New(cfg).ListBuckets(nil)
+		assertCode(t, err, s3.ErrInvalidAccessKeyID, http.StatusForbidden)
+	}
+
+	client := newClient(server, "V2")
+	url, err := client.GeneratePresignedUrl(&s3.GeneratePresignedUrlInput{
+		Bucket:     aws.String("bucket"),
+		Key:        aws.String("key"),
+		HTTPMethod: s3.PUT,
+		Expires:    3600,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	req, _ := http.NewRequest(http.MethodPut, url, strings.NewReader("presigned"))
+	resp, err := http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	stored, _ := server.Object("bucket", "key")
+	assert.Equal(t, "presigned", string(stored))
+}
+
+func TestPresignedUrlInput(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.CreateBucket("bucket")
+
+	for _, signer := range []string{"V2", "V4"} {
+		client := newClient(server, signer)
+		url := client.GeneratePresignedUrlInput(&s3.GeneratePresignedUrlInput{
+			Bucket:     aws.String("bucket"),
+			Key:        aws.String("key"),
+			HTTPMethod: s3.PUT,
+			Expires:    3600,
+		})
+		req, _ := http.NewRequest(http.MethodPut, url, strings.NewReader("presigned "+signer))
+		resp, err := http.DefaultClient.Do(req)
+		if assert.NoError(t, err, signer) {
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode, signer)
+		}
+		stored, _ := server.Object("bucket", "key")
+		assert.Equal(t, "presigned "+signer, string(stored))
+
+		url = client.GeneratePresignedUrlInput(&s3.GeneratePresignedUrlInput{
+			Bucket:     aws.String("bucket"),
+			Key:        aws.String("key"),
+			HTTPMethod: s3.GET,
+		})
+		assert.Empty(t, url, "%s: an expiry is required", signer)
+	}
+}
+
+func TestPresignPostObjectSessionToken(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+
+	for signer, policyField := range map[string]string{"V2": "Policy", "V4": "policy"} {
+		cfg := server.ClientConfig()
+		cfg.SignerVersion = signer
+		cfg.Credentials = credentials.NewStaticCredentials("AKID", "SECRET", "TOKEN")
+		post, err := s3.New(cfg).PresignPostObject(&s3.PresignPostObjectInput{
+			Bucket:  aws.String("bucket"),
+			Key:     aws.String("key"),
+			Expires: 3600,
+		})
+		if !assert.NoError(t, err, signer) {
+			continue
+		}
+		assert.Equal(t, "TOKEN", post.Fields["x-amz-security-token"], signer)
+
+		document, err := base64.StdEncoding.DecodeString(post.Fields[policyField])
+		assert.NoError(t, err, signer)
+		var policy struct {
+			Conditions []interface{} `json:"conditions"`
+		}
+		assert.NoError(t, json.Unmarshal(document, &policy), signer)
+		assert.Contains(t, policy.Conditions, map[string]interface{}{"x-amz-security-token": "TOKEN"}, signer)
+	}
+}
+
+func TestStreamBodyV2(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.CreateBucket("bucket")
+	client := newClient(server, "V2")
+
+	_, err := client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   aws.StreamBody(strings.NewReader("streamed")),
+	})
+	if assert.Error(t, err) {
+		assert.Equal(t, aws.ErrCodeMissingContentLength, err.(awserr.Error).Code())
+	}
+	_, ok := server.Object("bucket", "key")
+	assert.False(t, ok, "the request is not sent")
+
+	_, err = client.PutObject(&s3.PutObjectInput{
+		Bucket:        aws.String("bucket"),
+		Key:           aws.String("key"),
+		Body:          aws.StreamBody(strings.NewReader("streamed")),
+		ContentLength: aws.Long(8),
+	})
+	if assert.NoError(t, err) {
+		stored, _ := server.Object("bucket", "key")
+		assert.Equal(t, "streamed", string(stored))
+	}
+}
+
+func TestWaitUntilRestoreCompleted(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.PutObject("bucket", "key", []byte("data"))
+	client := newClient(server, "V4")
+
+	start := time.Now()
+	err := client.WaitUntilRestoreCompleted(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if assert.Error(t, err) {
+		assert.Equal(t, aws.ErrCodeResourceNotReady, err.(awserr.Error).Code())
+	}
+	assert.True(t, time.Since(start) < 5*time.Second, "an object without x-amz-restore fails at once")
+}
+
+func TestFaults(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	server.PutObject("bucket", "key", bytes.Repeat([]byte("x"), 1024))
+	client := newClient(server, "V4")
+
+	server.InjectFault(Fault{StatusCode: http.StatusServiceUnavailable, Code: "SlowDown", Count: 1})
+	_, err := client.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err, "the retry succeeds")
+
+	server.InjectFault(Fault{
+		Match:      func(r *http.Request) bool { return r.Method == http.MethodPut },
+		StatusCode: http.StatusInternalServerError,
+	})
+	_, err = client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("other"),
+		Body:   strings.NewReader("data"),
+	})
+	assertCode(t, err, s3.ErrInternalError, http.StatusInternalServerError)
+	server.ClearFaults()
+
+	server.InjectFault(Fault{CloseConnection: true})
+	_, err = client.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.Error(t, err)
+	server.ClearFaults()
+
+	server.InjectFault(Fault{Truncate: true, Count: 1})
+	get, err := client.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	if assert.NoError(t, err) {
+		data, err := ioutil.ReadAll(get.Body)
+		get.Body.Close()
+		assert.Error(t, err)
+		assert.Len(t, data, 512)
+	}
+	_, err = client.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err)
+}
+
+func TestHandlerNames(t *testing.T) {
+	server := NewServer(Config{})
+	defer server.Close()
+	client := newClient(server, "V2")
+
+	req, _ := client.GetBucketLocationRequest(&s3.GetBucketLocationInput{Bucket: aws.String("bucket")})
+	assert.Equal(t, s3.GetBucketLocationHandler.Name, req.Handlers.Unmarshal.Names()[0])
+
+	req, _ = client.PutObjectRequest(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.True(t, req.Handlers.CheckCrc64.Remove(s3.CheckUploadCrc64Handler.Name))
+
+	req, _ = client.QueryKs3DataRequest(&s3.QueryKs3DataInput{})
+	assert.Equal(t, []string{"open_api.Sign"}, req.Handlers.Sign.Names())
+}
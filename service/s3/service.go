@@ -32,15 +32,15 @@ func New(config *aws.Config) *S3 {
 
 	// Handlers
 	if service.Config.SignerVersion == "V4" || service.Config.SignerVersion == "V4_UNSIGNED_PAYLOAD_SIGNER" {
-		service.Handlers.Sign.PushBack(v4.Sign)
+		service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	} else {
-		service.Handlers.Sign.PushBack(v2.Sign)
+		service.Handlers.Sign.PushBackNamed(v2.SignRequestHandler)
 	}
 
-	service.Handlers.Build.PushBack(body.Build)
-	service.Handlers.Unmarshal.PushBack(body.UnmarshalBody)
-	service.Handlers.UnmarshalMeta.PushBack(body.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(body.UnmarshalError)
+	service.Handlers.Build.PushBackNamed(body.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(body.UnmarshalBodyHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(body.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(body.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
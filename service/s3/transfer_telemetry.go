@@ -0,0 +1,58 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/aws/telemetry"
+)
+
+// startTransferSpan starts the span of a transfer of the uploader,
+// downloader or copier. The spans of its parts, and of the operations it
+// makes, are started from the returned context and are its children.
+func (c *S3) startTransferSpan(ctx context.Context, name string, input interface{}) (context.Context, telemetry.Span) {
+	tracer := c.Config.Tracer
+	if tracer == nil {
+		tracer = telemetry.NopTracer{}
+	}
+
+	var bucket, key *string
+	switch in := input.(type) {
+	case *UploadFileInput:
+		if in != nil {
+			bucket, key = in.Bucket, in.Key
+		}
+	case *DownloadFileInput:
+		if in != nil {
+			bucket, key = in.Bucket, in.Key
+		}
+	case *CopyFileInput:
+		if in != nil {
+			bucket, key = in.Bucket, in.Key
+		}
+	}
+	return tracer.Start(ctx, name, telemetry.SpanKindInternal,
+		telemetry.String(telemetry.AttrBucket, aws.ToString(bucket)),
+		telemetry.String(telemetry.AttrKey, aws.ToString(key)))
+}
+
+// startPartSpan starts the span of a part of a transfer.
+func (c *S3) startPartSpan(ctx context.Context, partNumber int64, partSize int64) (context.Context, telemetry.Span) {
+	tracer := c.Config.Tracer
+	if tracer == nil {
+		tracer = telemetry.NopTracer{}
+	}
+	return tracer.Start(ctx, "Part", telemetry.SpanKindInternal,
+		telemetry.Int64(telemetry.AttrPartNumber, partNumber),
+		telemetry.Int64(telemetry.AttrTransferPartSize, partSize))
+}
+
+// endSpan ends the span of a transfer or a part, with the error it failed
+// with if any.
+func endSpan(span telemetry.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(telemetry.StatusError, err.Error())
+	}
+	span.End()
+}
@@ -1,7 +1,10 @@
 package s3
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/ks3sdklib/aws-sdk-go/aws"
@@ -138,7 +141,10 @@ func (c *S3) UploadFile(request *UploadFileInput) (*UploadFileOutput, error) {
 }
 
 func (c *S3) UploadFileWithContext(ctx context.Context, request *UploadFileInput) (*UploadFileOutput, error) {
-	return newUploader(c, ctx, request).uploadFile()
+	ctx, span := c.startTransferSpan(ctx, "s3.UploadFile", request)
+	resp, err := newUploader(c, ctx, request).uploadFile()
+	endSpan(span, err)
+	return resp, err
 }
 
 type Uploader struct {
@@ -424,7 +430,9 @@ func (u *Uploader) runTask(tasks <-chan UploadPartTask, wg *sync.WaitGroup) {
 			return
 		}
 
-		partETag, err := u.uploadPart(task)
+		ctx, span := u.client.startPartSpan(u.context, task.partNumber, task.actualPartSize)
+		partETag, err := u.uploadPart(ctx, task)
+		endSpan(span, err)
 		if err != nil {
 			u.setError(err)
 			return
@@ -434,7 +442,7 @@ func (u *Uploader) runTask(tasks <-chan UploadPartTask, wg *sync.WaitGroup) {
 	}
 }
 
-func (u *Uploader) uploadPart(task UploadPartTask) (CompletedPart, error) {
+func (u *Uploader) uploadPart(ctx context.Context, task UploadPartTask) (CompletedPart, error) {
 	request := u.uploadFileRequest
 	ucp := u.uploadCheckpoint
 	offset := task.offset
@@ -457,7 +465,16 @@ func (u *Uploader) uploadPart(task UploadPartTask) (CompletedPart, error) {
 		}
 	}
 
-	resp, err := u.client.UploadPartWithContext(u.context, &UploadPartInput{
+	var payloadHash string
+	if u.hashesPart(actualPartSize) {
+		var err error
+		reader, payloadHash, err = readHashedPart(reader, actualPartSize)
+		if err != nil {
+			return partETag, err
+		}
+	}
+
+	req, resp := u.client.UploadPartRequest(&UploadPartInput{
 		Bucket:               aws.String(ucp.BucketName),
 		Key:                  aws.String(ucp.ObjectKey),
 		UploadID:             aws.String(ucp.UploadId),
@@ -469,7 +486,11 @@ func (u *Uploader) uploadPart(task UploadPartTask) (CompletedPart, error) {
 		SSECustomerKeyMD5:    request.SSECustomerKeyMD5,
 		TrafficLimit:         request.TrafficLimit,
 	})
-
+	req.SetContext(ctx)
+	if payloadHash != "" && req.Build() == nil {
+		req.SetPayloadHash(payloadHash)
+	}
+	err := req.Send()
 	if err != nil {
 		return partETag, err
 	}
@@ -482,6 +503,33 @@ func (u *Uploader) uploadPart(task UploadPartTask) (CompletedPart, error) {
 	return partETag, nil
 }
 
+// maxHashedPartSize bounds the size of the parts read into memory by
+// hashesPart.
+const maxHashedPartSize = 64 * 1024 * 1024
+
+// hashesPart returns if a part of partSize is read into memory and hashed in
+// the same pass, so that signing it with V4 does not read it once more.
+// Larger parts are hashed by the signer before being sent, unless they are
+// signed chunk by chunk over Config.StreamingSignThreshold.
+func (u *Uploader) hashesPart(partSize int64) bool {
+	cfg := u.client.Config
+	if cfg.SignerVersion != "V4" || partSize > maxHashedPartSize {
+		return false
+	}
+	return cfg.StreamingSignThreshold <= 0 || partSize < cfg.StreamingSignThreshold
+}
+
+// readHashedPart reads the part of size bytes into memory, and returns it
+// with its hex encoded SHA-256 digest.
+func readHashedPart(reader io.Reader, size int64) (io.ReadSeeker, string, error) {
+	data := make([]byte, size)
+	hash := sha256.New()
+	if _, err := io.ReadFull(io.TeeReader(reader, hash), data); err != nil {
+		return nil, "", err
+	}
+	return bytes.NewReader(data), hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func (u *Uploader) updatePart(partETag CompletedPart) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
@@ -0,0 +1,130 @@
+package s3
+
+import (
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+)
+
+// WaitUntilObjectExists 等待对象存在，默认每5s至30s查询一次，最多查询20次
+func (c *S3) WaitUntilObjectExists(input *HeadObjectInput) error {
+	return c.WaitUntilObjectExistsWithContext(aws.BackgroundContext(), input)
+}
+
+func (c *S3) WaitUntilObjectExistsWithContext(ctx aws.Context, input *HeadObjectInput, opts ...aws.WaiterOption) error {
+	w := aws.Waiter{
+		Name:        "WaitUntilObjectExists",
+		MaxAttempts: 20,
+		MinDelay:    5 * time.Second,
+		MaxDelay:    30 * time.Second,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: aws.SuccessWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 200},
+			{State: aws.RetryWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 404},
+		},
+		NewRequest: func() (*aws.Request, error) {
+			req, _ := c.HeadObjectRequest(input)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+	return w.WaitWithContext(ctx)
+}
+
+// WaitUntilObjectNotExists 等待对象被删除，默认每5s至30s查询一次，最多查询20次
+func (c *S3) WaitUntilObjectNotExists(input *HeadObjectInput) error {
+	return c.WaitUntilObjectNotExistsWithContext(aws.BackgroundContext(), input)
+}
+
+func (c *S3) WaitUntilObjectNotExistsWithContext(ctx aws.Context, input *HeadObjectInput, opts ...aws.WaiterOption) error {
+	w := aws.Waiter{
+		Name:        "WaitUntilObjectNotExists",
+		MaxAttempts: 20,
+		MinDelay:    5 * time.Second,
+		MaxDelay:    30 * time.Second,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: aws.SuccessWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 404},
+			{State: aws.RetryWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 200},
+		},
+		NewRequest: func() (*aws.Request, error) {
+			req, _ := c.HeadObjectRequest(input)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+	return w.WaitWithContext(ctx)
+}
+
+// WaitUntilBucketExists 等待存储空间存在，默认每5s至30s查询一次，最多查询20次。
+// 存储空间存在但无访问权限时同样视为存在
+func (c *S3) WaitUntilBucketExists(input *HeadBucketInput) error {
+	return c.WaitUntilBucketExistsWithContext(aws.BackgroundContext(), input)
+}
+
+func (c *S3) WaitUntilBucketExistsWithContext(ctx aws.Context, input *HeadBucketInput, opts ...aws.WaiterOption) error {
+	w := aws.Waiter{
+		Name:        "WaitUntilBucketExists",
+		MaxAttempts: 20,
+		MinDelay:    5 * time.Second,
+		MaxDelay:    30 * time.Second,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: aws.SuccessWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 200},
+			{State: aws.SuccessWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 403},
+			{State: aws.RetryWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 404},
+		},
+		NewRequest: func() (*aws.Request, error) {
+			req, _ := c.HeadBucketRequest(input)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+	return w.WaitWithContext(ctx)
+}
+
+// WaitUntilRestoreCompleted 等待归档对象解冻完成，默认每10s至5min查询一次，最多查询200次。
+// 对象不存在，或对象没有x-amz-restore头（未发起解冻请求或不是归档对象）时立即返回错误
+func (c *S3) WaitUntilRestoreCompleted(input *HeadObjectInput) error {
+	return c.WaitUntilRestoreCompletedWithContext(aws.BackgroundContext(), input)
+}
+
+func (c *S3) WaitUntilRestoreCompletedWithContext(ctx aws.Context, input *HeadObjectInput, opts ...aws.WaiterOption) error {
+	w := aws.Waiter{
+		Name:        "WaitUntilRestoreCompleted",
+		MaxAttempts: 200,
+		MinDelay:    10 * time.Second,
+		MaxDelay:    5 * time.Minute,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: aws.SuccessWaiterState, Matcher: aws.PathContainsWaiterMatch, Argument: "Restore", Expected: `ongoing-request="false"`},
+			{State: aws.FailureWaiterState, Matcher: aws.StatusWaiterMatch, Expected: 404},
+			{State: aws.FailureWaiterState, Matcher: aws.PathWaiterMatch, Argument: "Restore", Expected: nil},
+		},
+		NewRequest: func() (*aws.Request, error) {
+			req, _ := c.HeadObjectRequest(input)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+	return w.WaitWithContext(ctx)
+}
+
+// WaitUntilJobComplete 等待批量处理任务完成，默认每10s至2min查询一次，最多查询100次
+func (c *S3) WaitUntilJobComplete(input *DescribeJobInput) error {
+	return c.WaitUntilJobCompleteWithContext(aws.BackgroundContext(), input)
+}
+
+func (c *S3) WaitUntilJobCompleteWithContext(ctx aws.Context, input *DescribeJobInput, opts ...aws.WaiterOption) error {
+	w := aws.Waiter{
+		Name:        "WaitUntilJobComplete",
+		MaxAttempts: 100,
+		MinDelay:    10 * time.Second,
+		MaxDelay:    2 * time.Minute,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: aws.SuccessWaiterState, Matcher: aws.PathWaiterMatch, Argument: "DescribeJobResult.Status", Expected: "Complete"},
+		},
+		NewRequest: func() (*aws.Request, error) {
+			req, _ := c.DescribeJobRequest(input)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+	return w.WaitWithContext(ctx)
+}
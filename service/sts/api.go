@@ -0,0 +1,112 @@
+package sts
+
+import (
+	"time"
+
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+)
+
+var opAssumeRole *aws.Operation
+
+// AssumeRoleRequest generates a request for the AssumeRole operation.
+func (c *STS) AssumeRoleRequest(input *AssumeRoleInput) (req *aws.Request, output *AssumeRoleOutput) {
+	if opAssumeRole == nil {
+		opAssumeRole = &aws.Operation{
+			Name:       "AssumeRole",
+			HTTPMethod: "POST",
+			HTTPPath:   "/",
+		}
+	}
+
+	if input == nil {
+		input = &AssumeRoleInput{}
+	}
+	req = c.newRequest(opAssumeRole, input, output)
+	output = &AssumeRoleOutput{}
+	req.Data = output
+	return
+}
+
+// AssumeRole returns temporary credentials, with a session token, for the
+// role RoleArn. The role may belong to another account, which then grants
+// the caller the permission to assume it.
+func (c *STS) AssumeRole(input *AssumeRoleInput) (*AssumeRoleOutput, error) {
+	req, out := c.AssumeRoleRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+func (c *STS) AssumeRoleWithContext(ctx aws.Context, input *AssumeRoleInput) (*AssumeRoleOutput, error) {
+	req, out := c.AssumeRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+type AssumeRoleInput struct {
+	// 要扮演的角色，如：krn:ksc:iam::123456789012:role/ks3-access
+	RoleArn *string `type:"string" required:"true"`
+
+	// 会话名称，用于区分扮演同一角色的调用方
+	RoleSessionName *string `type:"string" required:"true"`
+
+	// 临时凭证的有效期，单位为秒
+	DurationSeconds *int64 `type:"integer"`
+
+	// 角色所属账号要求提供的外部ID，用于跨账号扮演角色
+	ExternalId *string `type:"string"`
+
+	// 进一步限制临时凭证权限的策略，JSON格式
+	Policy *string `type:"string"`
+
+	metadataAssumeRoleInput `json:"-" xml:"-"`
+}
+
+type metadataAssumeRoleInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type AssumeRoleOutput struct {
+	// 扮演角色后的身份
+	AssumedRoleUser *AssumedRoleUser `type:"structure"`
+
+	// 临时凭证
+	Credentials *Credentials `type:"structure"`
+
+	metadataAssumeRoleOutput `json:"-" xml:"-"`
+}
+
+type metadataAssumeRoleOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type AssumedRoleUser struct {
+	// 扮演角色后的身份ID
+	AssumedRoleId *string `type:"string"`
+
+	// 扮演角色后的身份
+	Arn *string `type:"string"`
+
+	metadataAssumedRoleUser `json:"-" xml:"-"`
+}
+
+type metadataAssumedRoleUser struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type Credentials struct {
+	AccessKeyId *string `type:"string"`
+
+	SecretAccessKey *string `type:"string"`
+
+	SessionToken *string `type:"string"`
+
+	// 临时凭证的过期时间
+	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	metadataCredentials `json:"-" xml:"-"`
+}
+
+type metadataCredentials struct {
+	SDKShapeTraits bool `type:"structure"`
+}
@@ -0,0 +1,55 @@
+// Package sts provides a client for the Security Token Service, which issues
+// temporary credentials for roles.
+package sts
+
+import (
+	"github.com/ks3sdklib/aws-sdk-go/aws"
+	"github.com/ks3sdklib/aws-sdk-go/internal/protocol/query"
+	"github.com/ks3sdklib/aws-sdk-go/internal/signer/v4"
+)
+
+// DefaultEndpoint is the endpoint of the service when the Config has none.
+const DefaultEndpoint = "sts.api.ksyun.com"
+
+// DefaultRegion is the region requests are signed for, unless the
+// EndpointResolver of the Config returns a SigningRegion. The Region of the
+// Config, which is usually the region of KS3, is not used.
+const DefaultRegion = "cn-beijing-6"
+
+// STS is a client for the Security Token Service.
+type STS struct {
+	*aws.Service
+}
+
+// New returns a new STS client. The requests are signed with V4 whatever
+// the SignerVersion of the Config, for DefaultRegion unless SigningRegion is
+// set.
+func New(config *aws.Config) *STS {
+	cfg := aws.DefaultConfig.Merge(config)
+	if cfg.Endpoint == "" && len(cfg.Endpoints) == 0 {
+		cfg.Endpoint = DefaultEndpoint
+	}
+	service := &aws.Service{
+		Config:      cfg,
+		ServiceName: "sts",
+		APIVersion:  "2015-11-01",
+	}
+	service.Initialize()
+	if service.SigningRegion == "" {
+		service.SigningRegion = DefaultRegion
+	}
+
+	// Handlers
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
+
+	return &STS{service}
+}
+
+// newRequest creates a new request for a STS operation.
+func (c *STS) newRequest(op *aws.Operation, params, data interface{}) *aws.Request {
+	return aws.NewRequest(c.Service, op, params, data)
+}
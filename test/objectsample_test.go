@@ -11,6 +11,7 @@ import (
 	"github.com/ks3sdklib/aws-sdk-go/service/s3/s3manager"
 	. "gopkg.in/check.v1"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
@@ -307,6 +308,40 @@ func (s *Ks3utilCommandSuite) TestGeneratePUTPresignedUrl(c *C) {
 	s.DeleteObject(key, c)
 }
 
+// TestPresignPostObject 生成表单字段，通过浏览器表单上传
+func (s *Ks3utilCommandSuite) TestPresignPostObject(c *C) {
+	text := "test content"
+	post, err := client.PresignPostObject(&s3.PresignPostObjectInput{
+		Bucket:  aws.String(bucket),             // 存储空间名称，必填
+		Key:     aws.String("post/${filename}"), // 对象的key，${filename}替换为上传的文件名，必填
+		Expires: 3600,                           // 表单的过期时间，必填
+		Policy: s3.NewPostPolicy().
+			SetContentLengthRange(1, 1024).    // 限制文件大小
+			SetSuccessActionStatus(201).       // 上传成功后返回201
+			SetContentTypeStartsWith("text/"), // 限制文件类型
+	})
+	c.Assert(err, IsNil)
+
+	// 构造表单，此处以Golang代码为例，文件字段必须位于其它字段之后
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	for name, value := range post.Fields {
+		c.Assert(form.WriteField(name, value), IsNil)
+	}
+	c.Assert(form.WriteField("Content-Type", "text/plain"), IsNil)
+	file, err := form.CreateFormFile("file", key)
+	c.Assert(err, IsNil)
+	_, err = file.Write([]byte(text))
+	c.Assert(err, IsNil)
+	c.Assert(form.Close(), IsNil)
+
+	resp, err := http.Post(post.URL, form.FormDataContentType(), &buf)
+	c.Assert(err, IsNil)
+	c.Assert(resp.StatusCode, Equals, http.StatusCreated)
+
+	s.DeleteObject("post/"+key, c)
+}
+
 // TestGetObjectAcl 获取对象Acl
 func (s *Ks3utilCommandSuite) TestGetObjectAcl(c *C) {
 	_, err := client.PutObject(&s3.PutObjectInput{